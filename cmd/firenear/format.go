@@ -0,0 +1,121 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/mr-tron/base58"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+)
+
+// parseCryptoHash accepts a 32 bytes hash either in base58, as displayed by NEAR explorers
+// and RPC, or in hex (optionally `0x` prefixed), as used for Firehose block IDs.
+func parseCryptoHash(in string) ([]byte, error) {
+	in = strings.TrimSpace(in)
+	hexInput := strings.TrimPrefix(in, "0x")
+	if len(hexInput) == 64 {
+		if out, err := hex.DecodeString(hexInput); err == nil {
+			return out, nil
+		}
+	}
+
+	out, err := base58.Decode(in)
+	if err != nil {
+		return nil, fmt.Errorf("hash %q is neither valid base58 nor hex", in)
+	}
+
+	if len(out) != 32 {
+		return nil, fmt.Errorf("hash %q decodes to %d bytes, expected 32", in, len(out))
+	}
+
+	return out, nil
+}
+
+// outcomeStatus returns a short human representation of the outcome status, the failure
+// kind being the ActionError kind or InvalidTxError name, ex: `failure: account_does_not_exist`.
+func outcomeStatus(outcome *pbnear.ExecutionOutcome) string {
+	switch status := outcome.GetStatus().(type) {
+	case *pbnear.ExecutionOutcome_SuccessValue:
+		return "success"
+	case *pbnear.ExecutionOutcome_SuccessReceiptId:
+		return "success (receipt " + status.SuccessReceiptId.GetId().AsBase58String() + ")"
+	case *pbnear.ExecutionOutcome_Failure:
+		return "failure: " + failureKind(status.Failure)
+	}
+
+	return "unknown"
+}
+
+// failureKind returns the name of the error variant set in the failure, `unknown` if none is.
+func failureKind(failure *pbnear.FailureExecutionStatus) string {
+	switch f := failure.GetFailure().(type) {
+	case *pbnear.FailureExecutionStatus_ActionError:
+		return actionErrorKind(f.ActionError)
+	case *pbnear.FailureExecutionStatus_InvalidTxError:
+		return f.InvalidTxError.String()
+	}
+
+	return "unknown"
+}
+
+// actionErrorKind returns the name of the ActionError kind, ex: `account_does_not_exist`.
+func actionErrorKind(actionError *pbnear.ActionError) string {
+	reflected := actionError.ProtoReflect()
+	if field := reflected.WhichOneof(reflected.Descriptor().Oneofs().ByName("kind")); field != nil {
+		return string(field.Name())
+	}
+
+	return "unknown"
+}
+
+var yoctoPerNEAR = new(big.Int).Exp(big.NewInt(10), big.NewInt(24), nil)
+
+// formatNEAR renders a yoctoNEAR amount in NEAR without losing precision, ex: `1.5 NEAR`
+func formatNEAR(amount *pbnear.BigInt) string {
+	return nearAmount(amount) + " NEAR"
+}
+
+// nearAmount returns the decimal representation in NEAR of a yoctoNEAR amount, ex: `1.5`
+func nearAmount(amount *pbnear.BigInt) string {
+	whole, fraction := new(big.Int).QuoRem(amount.AsBigInt(), yoctoPerNEAR, new(big.Int))
+	if fraction.Sign() == 0 {
+		return whole.String()
+	}
+
+	return whole.String() + "." + strings.TrimRight(fmt.Sprintf("%024s", fraction.String()), "0")
+}
+
+// actionKind returns the name of the action variant, ex: `function_call`
+func actionKind(action *pbnear.Action) string {
+	reflected := action.ProtoReflect()
+	if field := reflected.WhichOneof(reflected.Descriptor().Oneofs().ByName("action")); field != nil {
+		return string(field.Name())
+	}
+
+	return "unknown"
+}
+
+// actionSummary returns a one line description of the action and its main parameters
+func actionSummary(action *pbnear.Action) string {
+	switch a := action.Action.(type) {
+	case *pbnear.Action_FunctionCall:
+		return fmt.Sprintf("function_call %s (gas %d, deposit %s)", a.FunctionCall.MethodName, a.FunctionCall.Gas, formatNEAR(a.FunctionCall.Deposit))
+	case *pbnear.Action_Transfer:
+		return "transfer " + formatNEAR(a.Transfer.Deposit)
+	case *pbnear.Action_Stake:
+		return "stake " + formatNEAR(a.Stake.Stake)
+	case *pbnear.Action_DeleteAccount:
+		return "delete_account (beneficiary " + a.DeleteAccount.BeneficiaryId + ")"
+	case *pbnear.Action_Delegate:
+		delegate := a.Delegate.GetDelegateAction()
+		inner := make([]string, len(delegate.GetActions()))
+		for i, innerAction := range delegate.GetActions() {
+			inner[i] = actionSummary(innerAction)
+		}
+		return fmt.Sprintf("delegate %s -> %s [%s]", delegate.GetSenderId(), delegate.GetReceiverId(), strings.Join(inner, ", "))
+	}
+
+	return actionKind(action)
+}
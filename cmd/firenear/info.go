@@ -0,0 +1,43 @@
+package main
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/firehose-core/firehose/info"
+	wellknown "github.com/streamingfast/firehose-core/well-known"
+	pbfirehose "github.com/streamingfast/pbgo/sf/firehose/v2"
+)
+
+// readerNodeChainID is the chain ID of the genesis the reader node was bootstrapped with, it's
+// unset when no reader node is bootstrapped by this process.
+var readerNodeChainID atomic.Pointer[string]
+
+// nearInfoResponseFiller fills the info response like firehose-core does, using the chain ID
+// detected when bootstrapping the reader node to name the chain when it's not advertised.
+func nearInfoResponseFiller(firstStreamableBlock *pbbstream.Block, resp *pbfirehose.InfoResponse, validate bool) error {
+	if err := info.DefaultInfoResponseFiller(firstStreamableBlock, resp, validate); err != nil {
+		return err
+	}
+
+	chainID := readerNodeChainID.Load()
+	if chainID == nil {
+		return nil
+	}
+
+	chainName := "near-" + *chainID
+	if resp.ChainName == "" {
+		resp.ChainName = chainName
+		if chain := wellknown.WellKnownProtocols.ChainByName(chainName); chain != nil {
+			resp.ChainNameAliases = chain.Aliases
+		}
+		return nil
+	}
+
+	if validate && wellknown.WellKnownProtocols.ChainByName(chainName) != nil && resp.ChainName != chainName {
+		return fmt.Errorf("advertised chain name %q inconsistent with the reader node's genesis chain ID %q", resp.ChainName, *chainID)
+	}
+
+	return nil
+}
@@ -3,9 +3,9 @@ package main
 import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
 	firecore "github.com/streamingfast/firehose-core"
 	fhCmd "github.com/streamingfast/firehose-core/cmd"
-	"github.com/streamingfast/firehose-core/firehose/info"
 	"github.com/streamingfast/firehose-core/node-manager/mindreader"
 	"github.com/streamingfast/firehose-near/codec"
 	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
@@ -27,24 +27,52 @@ func main() {
 		BlockFactory: func() firecore.Block { return new(pbnear.Block) },
 
 		BlockIndexerFactories: map[string]firecore.BlockIndexerFactory[*pbnear.Block]{
-			transform.ReceiptAddressIndexShortName: transform.NewNearBlockIndexer,
+			// firehose-core supports a single indexer, the `trxid`, `acctclass` and `dlgtaddr` indexes are built in the same pass as `rcptaddr`
+			transform.ReceiptAddressIndexShortName: transform.NewMultiBlockIndexerFactory(
+				transform.NewNearBlockIndexer,
+				transform.NewNearTransactionIndexer,
+				transform.NewNearAccountClassIndexer,
+				transform.NewNearDelegateIndexer,
+			),
 		},
 
 		BlockTransformerFactories: map[protoreflect.FullName]firecore.BlockTransformerFactory{
 			transform.HeaderOnlyMessageName:    transform.NewHeaderOnlyTransformFactory,
+			transform.LightBlockMessageName:    transform.NewLightBlockTransformFactory,
 			transform.ReceiptFilterMessageName: transform.BasicReceiptFilterFactory,
+			transform.StripPayloadsMessageName: transform.NewStripPayloadsTransformFactory,
+			transform.ShardFilterMessageName:   transform.NewShardFilterTransformFactory,
 		},
 
 		ConsoleReaderFactory: func(lines chan string, blockEncoder firecore.BlockEncoder, logger *zap.Logger, tracer logging.Tracer) (mindreader.ConsolerReader, error) {
 			// FIXME: This was hardcoded also in the previous firehose-near version, Firehose will break if this is not available
-			return codec.NewConsoleReader(lines, firecore.NewBlockEncoder(), "http://localhost:3030")
+			rpcURL := "http://localhost:3030"
+
+			var opts []codec.ConsoleReaderOption
+			if viper.GetBool("reader-node-verify-block-hashes") {
+				opts = append(opts, codec.WithBlockHashVerification(&rpcBlockApproversSource{rpc: newHTTPNearRPC(rpcURL)}))
+			}
+
+			return codec.NewConsoleReader(lines, firecore.NewBlockEncoder(), rpcURL, opts...)
 		},
 
 		RegisterExtraStartFlags: func(flags *pflag.FlagSet) {
-			flags.String("reader-node-config-file", "", "Node configuration file, the file is copied inside the {data-dir}/reader/data folder Use {hostname} label to use short hostname in path")
-			flags.String("reader-node-genesis-file", "./genesis.json", "Node genesis file, the file is copied inside the {data-dir}/reader/data folder. Use {hostname} label to use short hostname in path")
-			flags.String("reader-node-key-file", "./node_key.json", "Node key configuration file, the file is copied inside the {data-dir}/reader/data folder. Use {hostname} label to use with short hostname in path")
+			flags.String("reader-node-config-file", "", "Node configuration file, the file is copied inside the {data-dir}/reader/data folder. Can contain reader node path placeholders, see --reader-node-role")
+			flags.String("reader-node-genesis-file", "./genesis.json", "Node genesis file, the file is copied inside the {data-dir}/reader/data folder. Can contain reader node path placeholders, see --reader-node-role")
+			flags.String("reader-node-key-file", "./node_key.json", "Node key configuration file, the file is copied inside the {data-dir}/reader/data folder. When empty, a node key is generated if the folder has none. Can contain reader node path placeholders, see --reader-node-role")
+			flags.String("reader-node-config-file-sha256", "", "Expected sha256 of the --reader-node-config-file content, the node refuses to start with another config file. Not checked when empty")
+			flags.String("reader-node-key-file-sha256", "", "Expected sha256 of the --reader-node-key-file content, the node refuses to start with another node key file. Not checked when empty")
+			flags.Bool("reader-node-auto-init", false, "When --reader-node-config-file is empty and the {data-dir}/reader/data folder has no config file, run '<reader-node-path> --home=<node-data-dir> init --chain-id=<reader-node-network>' to generate the node files, config patches being applied on top of the generated config")
+			flags.String("reader-node-init-arguments", "", "Extra arguments of the node 'init' command run by --reader-node-auto-init, ex: '--download-genesis --download-config'")
+			flags.String("reader-node-role", "reader", "Role of the node, the value of the {node-role} placeholder. Reader node file and path flags, as well as config patch string values, can contain the placeholders "+readerNodePlaceholdersDocumentation+", unknown placeholders being rejected")
+			flags.Bool("reader-node-verify-block-hashes", false, "Check that the hash of each block read from the node is the hash of its header fields, the reader fails on a mismatch. The number of block approvers of each epoch is fetched from the node's JSON-RPC. Only chains producing blocks with protocol versions before 63 can be verified: later headers hash the block body hash, which blocks do not carry, so the reader fails on the first such block (the case of current mainnet and testnet blocks). Blocks whose hash cannot be verified because too many approvals are missing are accepted, with a warning logged every 1000 of them")
 			flags.Bool("reader-node-overwrite-node-files", false, "Force download of node-key and config files even if they already exist on the machine.")
+			flags.StringSlice("reader-node-config-patch-files", nil, "JSON merge patch (RFC 7386) files, any dstore URL, applied in order on top of the node configuration file. Can contain reader node path placeholders, see --reader-node-role")
+			flags.StringSlice("reader-node-config-set", nil, "Node configuration settings applied on top of the node configuration file and patch files, each of the form <path>=<value> (ex: 'rpc.addr=127.0.0.1:3030', 'archive=true'), value being used as JSON when valid (use 'null' to remove the setting) and as a string otherwise. String values of patches can contain reader node path placeholders, see --reader-node-role")
+			flags.String("reader-node-network", "", "Expected chain ID of the node's genesis, ex: 'mainnet' or 'testnet', the node refuses to start if its genesis or config is for another network. Not checked when empty")
+			flags.String("reader-node-genesis-sha256", "", "Expected sha256 of the node's genesis file, the node refuses to start with another (stale) genesis, the copied --reader-node-genesis-file is checked before being installed. Not checked when empty")
+			flags.String("reader-node-data-snapshot-url", "", "Tar archive (optionally zstd, lz4 or gzip compressed) of a node data dir, any dstore URL, extracted in the {data-dir}/reader/data folder when it contains no node database. Can contain reader node path placeholders, see --reader-node-role")
+			flags.String("reader-node-data-snapshot-sha256", "", "Expected sha256 of the --reader-node-data-snapshot-url archive, when empty the '<url>.sha256' file is used if it exists")
 		},
 
 		ReaderNodeBootstrapperFactory: newReaderNodeBootstrapper,
@@ -54,18 +82,37 @@ func main() {
 
 			RegisterExtraCmd: func(chain *firecore.Chain[*pbnear.Block], toolsCmd *cobra.Command, zlog *zap.Logger, tracer logging.Tracer) error {
 				toolsCmd.AddCommand(newToolsGenerateNodeKeyCmd(chain))
+				toolsCmd.AddCommand(newToolsKeysCmd(chain))
+				toolsCmd.AddCommand(newToolsNodeConfigCmd(chain))
+				toolsCmd.AddCommand(newToolsTxLookupCmd(chain))
+				toolsCmd.AddCommand(newToolsAccountHistoryCmd(chain))
+				toolsCmd.AddCommand(newToolsStatsCmd(chain))
+				toolsCmd.AddCommand(newToolsCompareRPCCmd(chain))
+				toolsCmd.AddCommand(newToolsExportCmd(chain))
+				toolsCmd.AddCommand(newToolsRepairMergedBlocksCmd(chain))
+				toolsCmd.AddCommand(newToolsVerifyBlockHashesCmd(chain))
+				toolsCmd.AddCommand(newToolsVerifyFinalityCmd(chain))
+				toolsCmd.AddCommand(newToolsTraceTxCmd(chain))
+				toolsCmd.AddCommand(newToolsPrintNearCmd(chain))
+				toolsCmd.AddCommand(newToolsDecodeArgsCmd(chain))
 				return nil
 			},
 
 			TransformFlags: &firecore.TransformFlags{
 				Register: func(flags *pflag.FlagSet) {
 					flags.String("receipt-account-filters", "", "Comma-separated accounts to use as filter/index. If it contains a colon (:), it will be interpreted as <prefix>:<suffix> (each of which can be empty, ex: 'hello:' or ':world')")
+					flags.String("receipt-account-matchers", "", "Comma-separated account hierarchy matchers to use as filter/index, each of the form <kind>=<pattern> where kind is one of exact, subaccount-of, direct-child-of or glob (ex: 'subaccount-of=sweat,glob=app.*.near')")
+					flags.String("receipt-account-classes", "", "Comma-separated account class predicates to use as filter/index, each of the form <role>=<class> where role is one of receiver, signer or predecessor and class one of named, implicit or eth-implicit (ex: 'receiver=implicit,signer=eth-implicit')")
+					flags.Bool("receipt-unwrap-delegate-actions", false, "When set, receipt account filters and matchers also match the inner receiver of NEP-366 delegate actions (meta-transactions sent through a relayer)")
+					flags.String("receipt-delegate-senders", "", "Comma-separated accounts to use as filter/index on the inner sender of NEP-366 delegate actions, matching the meta-transactions they signed whatever the relayer")
+					flags.String("shard-ids", "", "Comma-separated shard IDs to keep, other shards are removed from the blocks")
+					flags.Bool("shard-filter-state-changes", false, "When used with --shard-ids, only keep state changes caused by a transaction or a receipt of the kept shards")
 				},
-				Parse: receiptAccountFiltersParser,
+				Parse: transformFlagsParser,
 			},
 		},
 
-		InfoResponseFiller: info.DefaultInfoResponseFiller,
+		InfoResponseFiller: nearInfoResponseFiller,
 	}
 
 	fhCmd.Main(chain)
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/streamingfast/bstream"
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/dstore"
+	"github.com/streamingfast/firehose-core/types"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+)
+
+// mergedBlocksBundleSize is the amount of block heights covered by a single merged blocks file
+const mergedBlocksBundleSize = 100
+
+func mergedBlocksBundleBase(blockNum uint64) uint64 {
+	return blockNum - (blockNum % mergedBlocksBundleSize)
+}
+
+// readMergedBlocksBundle calls fn for each block of the merged blocks file starting at
+// baseBlockNum, in the order they appear in the file.
+func readMergedBlocksBundle(ctx context.Context, store dstore.Store, baseBlockNum uint64, fn func(block *pbnear.Block) error) error {
+	return readMergedBlocksBundleRaw(ctx, store, baseBlockNum, func(blk *pbbstream.Block) error {
+		block := &pbnear.Block{}
+		if err := blk.Payload.UnmarshalTo(block); err != nil {
+			return fmt.Errorf("unmarshal block #%d: %w", blk.Number, err)
+		}
+
+		return fn(block)
+	})
+}
+
+// readMergedBlocksBundleRaw is readMergedBlocksBundle with the bstream blocks, their payload not
+// being decoded.
+func readMergedBlocksBundleRaw(ctx context.Context, store dstore.Store, baseBlockNum uint64, fn func(blk *pbbstream.Block) error) error {
+	filename := fmt.Sprintf("%010d", baseBlockNum)
+	reader, err := store.OpenObject(ctx, filename)
+	if err != nil {
+		return fmt.Errorf("open merged blocks file %q: %w", filename, err)
+	}
+	defer reader.Close()
+
+	blockReader, err := bstream.NewDBinBlockReader(reader)
+	if err != nil {
+		return fmt.Errorf("new block reader for %q: %w", filename, err)
+	}
+
+	for {
+		blk, err := blockReader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("read block from %q: %w", filename, err)
+		}
+
+		if err := fn(blk); err != nil {
+			return err
+		}
+	}
+}
+
+// readMergedBlock returns the block at blockNum, nil if the merged blocks file covering
+// blockNum does not contain it, which happens for skipped heights.
+func readMergedBlock(ctx context.Context, store dstore.Store, blockNum uint64) (out *pbnear.Block, err error) {
+	err = readMergedBlocksBundle(ctx, store, mergedBlocksBundleBase(blockNum), func(block *pbnear.Block) error {
+		if block.Num() == blockNum {
+			out = block
+		}
+		return nil
+	})
+	return
+}
+
+// readMergedBlocksRange calls fn for each block of [start, stop) in the order they appear in the
+// merged blocks files, a missing file being an error
+func readMergedBlocksRange(ctx context.Context, store dstore.Store, start, stop uint64, fn func(block *pbnear.Block) error) error {
+	for base := mergedBlocksBundleBase(start); base < stop; base += mergedBlocksBundleSize {
+		err := readMergedBlocksBundle(ctx, store, base, func(block *pbnear.Block) error {
+			if block.Num() < start || block.Num() >= stop {
+				return nil
+			}
+			return fn(block)
+		})
+		if err != nil {
+			return mergedBlocksRangeError(base, err)
+		}
+	}
+
+	return nil
+}
+
+// mergedBlocksRangeError turns the not found error of the merged blocks file starting at base,
+// read as part of a range, into an error telling that the range is not covered by the available
+// merged blocks, other errors being returned as is.
+func mergedBlocksRangeError(base uint64, err error) error {
+	if errors.Is(err, dstore.ErrNotFound) {
+		return fmt.Errorf("merged blocks file %010d not found, the range is not covered by the available merged blocks", base)
+	}
+	return err
+}
+
+// parseClosedBlockRange parses a `<start>:<stop>` block range argument made of absolute block
+// numbers, the stop block being exclusive.
+func parseClosedBlockRange(in string) (start, stop uint64, err error) {
+	blockRange, err := types.GetBlockRangeFromArg(in)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid range: %w", err)
+	}
+
+	if blockRange.Start < 0 || !blockRange.IsClosed() {
+		return 0, 0, fmt.Errorf("invalid range %q, expected <start>:<stop> with absolute block numbers", in)
+	}
+
+	return uint64(blockRange.Start), *blockRange.Stop, nil
+}
+
+// checkOutputFormat returns an error when format, the value of an `--output` flag, is not one of
+// the supported ones.
+func checkOutputFormat(format string, supported ...string) error {
+	for _, candidate := range supported {
+		if format == candidate {
+			return nil
+		}
+	}
+
+	quoted := make([]string, len(supported))
+	for i, candidate := range supported {
+		quoted[i] = "'" + candidate + "'"
+	}
+
+	expected := quoted[len(quoted)-1]
+	if len(quoted) > 1 {
+		expected = strings.Join(quoted[:len(quoted)-1], ", ") + " or " + expected
+	}
+
+	return fmt.Errorf("invalid output format %q, expected %s", format, expected)
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/dstore"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// newTestMergedBlocksStore returns a local merged blocks store holding the blocks, written to the
+// merged blocks files of their height in the order they are given
+func newTestMergedBlocksStore(t *testing.T, blocks ...*pbnear.Block) dstore.Store {
+	t.Helper()
+
+	store, err := dstore.NewDBinStore("file://" + t.TempDir())
+	require.NoError(t, err)
+
+	var bases []uint64
+	bundles := make(map[uint64][]*pbbstream.Block)
+	for _, block := range blocks {
+		payload, err := anypb.New(block)
+		require.NoError(t, err)
+
+		base := mergedBlocksBundleBase(block.Num())
+		if _, found := bundles[base]; !found {
+			bases = append(bases, base)
+		}
+		bundles[base] = append(bundles[base], &pbbstream.Block{Number: block.Num(), Id: block.ID(), ParentNum: block.Header.GetPrevHeight(), Payload: payload})
+	}
+
+	for _, base := range bases {
+		require.NoError(t, writeMergedBlocksBundle(context.Background(), store, base, bundles[base]))
+	}
+
+	return store
+}
+
+func TestReadMergedBlocksRange(t *testing.T) {
+	store := newTestMergedBlocksStore(t,
+		&pbnear.Block{Header: &pbnear.BlockHeader{Height: 98}},
+		&pbnear.Block{Header: &pbnear.BlockHeader{Height: 99}},
+		&pbnear.Block{Header: &pbnear.BlockHeader{Height: 100}},
+		&pbnear.Block{Header: &pbnear.BlockHeader{Height: 101}},
+	)
+
+	read := func(start, stop uint64) (heights []uint64, err error) {
+		err = readMergedBlocksRange(context.Background(), store, start, stop, func(block *pbnear.Block) error {
+			heights = append(heights, block.Num())
+			return nil
+		})
+		return
+	}
+
+	heights, err := read(99, 101)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{99, 100}, heights)
+
+	_, err = read(99, 201)
+	assert.ErrorContains(t, err, "merged blocks file 0000000200 not found")
+}
+
+func TestParseClosedBlockRange(t *testing.T) {
+	start, stop, err := parseClosedBlockRange("10:20")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), start)
+	assert.Equal(t, uint64(20), stop)
+
+	_, _, err = parseClosedBlockRange("10:")
+	assert.EqualError(t, err, `invalid range "10:", expected <start>:<stop> with absolute block numbers`)
+
+	_, _, err = parseClosedBlockRange("-10:20")
+	assert.EqualError(t, err, `invalid range "-10:20", expected <start>:<stop> with absolute block numbers`)
+
+	_, _, err = parseClosedBlockRange("a:b")
+	assert.ErrorContains(t, err, "invalid range: ")
+}
+
+func TestCheckOutputFormat(t *testing.T) {
+	assert.NoError(t, checkOutputFormat("jsonl", "text", "jsonl"))
+	assert.EqualError(t, checkOutputFormat("csv", "text"), `invalid output format "csv", expected 'text'`)
+	assert.EqualError(t, checkOutputFormat("csv", "text", "json"), `invalid output format "csv", expected 'text' or 'json'`)
+	assert.EqualError(t, checkOutputFormat("xml", "text", "jsonl", "csv"), `invalid output format "xml", expected 'text', 'jsonl' or 'csv'`)
+}
@@ -2,18 +2,22 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
 	"fmt"
 	"io"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"strings"
 	"time"
 
+	"github.com/kballard/go-shellquote"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
-	"github.com/streamingfast/dstore"
 	firecore "github.com/streamingfast/firehose-core"
 	"github.com/streamingfast/firehose-core/node-manager/operator"
+	"github.com/streamingfast/firehose-near/nodeconfig"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
 	"go.uber.org/zap"
 )
 
@@ -31,36 +35,134 @@ func newReaderNodeBootstrapper(
 		zap.String("config_file", viper.GetString("reader-node-config-file")),
 	)
 
-	configFile := replaceNodeRole(viper.GetString("reader-node-config-file"), hostname)
-	genesisFile := replaceNodeRole(viper.GetString("reader-node-genesis-file"), hostname)
-	nodeKeyFile := replaceHostname(viper.GetString("reader-node-key-file"), hostname)
-	nodeDataDir := replaceHostname(viper.GetString("reader-node-data-dir"), hostname)
+	template := &readerNodeTemplate{
+		hostname:  hostname,
+		nodeRole:  viper.GetString("reader-node-role"),
+		dataDir:   resolver("{data-dir}"),
+		lookupEnv: os.LookupEnv,
+	}
+
+	nodeDataDir, err := template.Expand(viper.GetString("reader-node-data-dir"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid --reader-node-data-dir: %w", err)
+	}
+	template.nodeDataDir = nodeDataDir
+
+	var configFile, genesisFile, nodeKeyFile, dataSnapshotURL string
+	for _, path := range []struct {
+		flag string
+		out  *string
+	}{
+		{"reader-node-config-file", &configFile},
+		{"reader-node-genesis-file", &genesisFile},
+		{"reader-node-key-file", &nodeKeyFile},
+		{"reader-node-data-snapshot-url", &dataSnapshotURL},
+	} {
+		if *path.out, err = template.Expand(viper.GetString(path.flag)); err != nil {
+			return nil, fmt.Errorf("invalid --%s: %w", path.flag, err)
+		}
+	}
+
+	configPatchFiles, err := template.ExpandAll(viper.GetStringSlice("reader-node-config-patch-files"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid --reader-node-config-patch-files: %w", err)
+	}
+
+	initArguments, err := shellquote.Split(viper.GetString("reader-node-init-arguments"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid --reader-node-init-arguments: %w", err)
+	}
+
 	overwriteNodeFiles := viper.GetBool("reader-node-overwrite-node-files")
 
+	var configSetPatches []nodeconfig.Patch
+	for _, setting := range viper.GetStringSlice("reader-node-config-set") {
+		patch, err := nodeconfig.ParseSetPatch(setting)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --reader-node-config-set: %w", err)
+		}
+		configSetPatches = append(configSetPatches, patch)
+	}
+
 	logger.Info("final node configuration",
 		zap.String("config_file", configFile),
 		zap.String("genesis_file", genesisFile),
 		zap.String("node_key_file", nodeKeyFile),
 		zap.String("node_data_dir", nodeDataDir),
+		zap.String("data_snapshot_url", dataSnapshotURL),
+		zap.Strings("config_patch_files", configPatchFiles),
+		zap.Strings("config_set", viper.GetStringSlice("reader-node-config-set")),
 	)
 
 	return &bootstrapper{
+		ctx: ctx,
+
 		configFile:  configFile,
 		genesisFile: genesisFile,
 		nodeKeyFile: nodeKeyFile,
 		nodeDataDir: nodeDataDir,
 
+		autoInit:      viper.GetBool("reader-node-auto-init"),
+		nodePath:      viper.GetString("reader-node-path"),
+		initArguments: initArguments,
+
+		configPatchFiles:   configPatchFiles,
+		configSetPatches:   configSetPatches,
+		expandPlaceholders: template.Expand,
+
+		expectedIdentity: nodeconfig.IdentityExpectations{
+			ChainID:       viper.GetString("reader-node-network"),
+			GenesisSHA256: viper.GetString("reader-node-genesis-sha256"),
+		},
+
+		dataSnapshotURL:    dataSnapshotURL,
+		dataSnapshotSHA256: viper.GetString("reader-node-data-snapshot-sha256"),
+
+		configFileSHA256:  viper.GetString("reader-node-config-file-sha256"),
+		nodeKeyFileSHA256: viper.GetString("reader-node-key-file-sha256"),
+
 		forceOverwrite: overwriteNodeFiles,
+		logger:         logger,
 	}, nil
 }
 
 type bootstrapper struct {
+	// ctx is the app's context, cancelled on shutdown. operator.Bootstrapper.Bootstrap does not
+	// receive one.
+	ctx context.Context
+
 	configFile  string
 	genesisFile string
 	nodeKeyFile string
 	nodeDataDir string
 
+	// autoInit runs the node binary's `init` command with initArguments when the node data dir has
+	// no config file and no config file is supplied
+	autoInit      bool
+	nodePath      string
+	initArguments []string
+
+	// configPatchFiles and configSetPatches are JSON merge patches applied, in this order, on top
+	// of the config file, their string values having their placeholders expanded
+	configPatchFiles   []string
+	configSetPatches   []nodeconfig.Patch
+	expandPlaceholders func(in string) (string, error)
+
+	expectedIdentity nodeconfig.IdentityExpectations
+
+	dataSnapshotURL    string
+	dataSnapshotSHA256 string
+
+	// configFileSHA256 and nodeKeyFileSHA256 pin the sha256 of the config and node key files, the
+	// genesis file being pinned by the expected identity
+	configFileSHA256  string
+	nodeKeyFileSHA256 string
+
 	forceOverwrite bool
+	logger         *zap.Logger
+
+	// changes are the node data dir files changed by the current bootstrap
+	changes []*installedFile
 }
 
 func (b *bootstrapper) Bootstrap() error {
@@ -68,9 +170,12 @@ func (b *bootstrapper) Bootstrap() error {
 	genesisFileInDataDir := filepath.Join(b.nodeDataDir, "genesis.json")
 	nodeKeyFileInDataDir := filepath.Join(b.nodeDataDir, "node_key.json")
 
-	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Minute)
+	ctx, cancel := context.WithTimeout(b.ctx, 15*time.Minute)
 	defer cancel()
 
+	b.changes = nil
+	defer b.logChanges()
+
 	if err := os.MkdirAll(b.nodeDataDir, os.ModePerm); err != nil {
 		return fmt.Errorf("create all dirs of %q: %w", b.nodeDataDir, err)
 	}
@@ -79,20 +184,38 @@ func (b *bootstrapper) Bootstrap() error {
 	if err != nil {
 		return err
 	}
-	if !exists || b.forceOverwrite {
-		if b.configFile != "" {
-			if err := copyFile(ctx, b.configFile, configFileInDataDir); err != nil {
-				return fmt.Errorf("unable to copy config file %q to %q: %w", b.configFile, configFileInDataDir, err)
+	if !exists && b.configFile == "" {
+		if !b.autoInit {
+			return fmt.Errorf("node data dir %q has no config file, set --reader-node-config-file or use --reader-node-auto-init to initialize the node", b.nodeDataDir)
+		}
+
+		if err := b.initNode(ctx); err != nil {
+			return err
+		}
+
+		for _, path := range []string{configFileInDataDir, genesisFileInDataDir, nodeKeyFileInDataDir} {
+			if exists, _ := fileExists(path); exists {
+				b.changes = append(b.changes, &installedFile{Path: path, Source: "node init", Action: "created"})
 			}
 		}
 	}
 
+	if err := b.installConfig(ctx, configFileInDataDir); err != nil {
+		return err
+	}
+
 	exists, err = fileExists(nodeKeyFileInDataDir)
 	if err != nil {
 		return err
 	}
-	if !exists || b.forceOverwrite {
-		if err := copyFile(ctx, b.nodeKeyFile, nodeKeyFileInDataDir); err != nil {
+	switch {
+	case !exists && b.nodeKeyFile == "":
+		if err := b.generateNodeKey(nodeKeyFileInDataDir); err != nil {
+			return err
+		}
+
+	case (!exists || b.forceOverwrite) && b.nodeKeyFile != "":
+		if err := b.installRemoteFile(ctx, b.nodeKeyFile, nodeKeyFileInDataDir, b.nodeKeyFileSHA256, 0600); err != nil {
 			return fmt.Errorf("unable to copy node key file %q to %q: %w", b.nodeKeyFile, nodeKeyFileInDataDir, err)
 		}
 	}
@@ -102,56 +225,260 @@ func (b *bootstrapper) Bootstrap() error {
 		return err
 	}
 	if !exists {
-		if err := copyFile(ctx, b.genesisFile, genesisFileInDataDir); err != nil {
+		if err := b.installRemoteFile(ctx, b.genesisFile, genesisFileInDataDir, b.expectedIdentity.GenesisSHA256, 0644); err != nil {
 			return fmt.Errorf("unable to copy genesis file %q to %q: %w", b.genesisFile, genesisFileInDataDir, err)
 		}
 	}
 
+	if err := b.checkNetworkIdentity(genesisFileInDataDir, configFileInDataDir); err != nil {
+		return err
+	}
+
+	if b.dataSnapshotURL != "" {
+		hasDatabase, err := hasNodeDatabase(b.nodeDataDir)
+		if err != nil {
+			return err
+		}
+
+		if !hasDatabase {
+			restorer := &snapshotRestorer{url: b.dataSnapshotURL, sha256: b.dataSnapshotSHA256, nodeDataDir: b.nodeDataDir, logger: b.logger}
+
+			// Restoring a snapshot takes a lot longer than copying node files, it's not bound by
+			// their timeout but still stops on shutdown
+			if err := restorer.Restore(b.ctx); err != nil {
+				return fmt.Errorf("unable to restore data snapshot %q: %w", b.dataSnapshotURL, err)
+			}
+		}
+	}
+
 	return nil
 }
 
-func fileExists(file string) (bool, error) {
-	stat, err := os.Stat(file)
-	if os.IsNotExist(err) {
-		return false, nil
+// logChanges logs the node data dir files changed by the bootstrap, including on failure
+func (b *bootstrapper) logChanges() {
+	if len(b.changes) == 0 {
+		b.logger.Info("bootstrap summary, no node file changed", zap.String("node_data_dir", b.nodeDataDir))
+		return
 	}
 
+	changes := make([]string, len(b.changes))
+	for i, change := range b.changes {
+		changes[i] = change.String()
+	}
+	b.logger.Info("bootstrap summary", zap.String("node_data_dir", b.nodeDataDir), zap.Strings("changes", changes))
+}
+
+// initNode runs the node binary's `init` command to generate the node files in the node data dir
+func (b *bootstrapper) initNode(ctx context.Context) error {
+	if b.expectedIdentity.ChainID == "" {
+		return fmt.Errorf("--reader-node-network is required to initialize the node with --reader-node-auto-init")
+	}
+
+	args := append([]string{"--home=" + b.nodeDataDir, "init", "--chain-id=" + b.expectedIdentity.ChainID}, b.initArguments...)
+	b.logger.Info("initializing node", zap.String("node_path", b.nodePath), zap.Strings("arguments", args))
+
+	output, err := exec.CommandContext(ctx, b.nodePath, args...).CombinedOutput()
 	if err != nil {
-		return false, err
+		return fmt.Errorf("node init %q failed: %w, output:\n%s", strings.Join(append([]string{b.nodePath}, args...), " "), err, output)
 	}
 
-	return !stat.IsDir(), nil
+	b.logger.Info("node initialized", zap.String("node_data_dir", b.nodeDataDir), zap.String("output", string(output)))
+	return nil
 }
 
-func copyFile(ctx context.Context, in, out string) error {
-	reader, _, _, err := dstore.OpenObject(ctx, in)
+func (b *bootstrapper) generateNodeKey(nodeKeyFileInDataDir string) error {
+	secretKey, err := pbnear.GenerateSecretKey(pbnear.CurveKind_ED25519, rand.Reader)
 	if err != nil {
-		return fmt.Errorf("unable : %w", err)
+		return err
+	}
+
+	if err := writeKeyFile(nodeKeyFileInDataDir, "node", secretKey, false); err != nil {
+		return fmt.Errorf("unable to write generated node key: %w", err)
 	}
 
-	writer, err := os.Create(out)
+	b.changes = append(b.changes, &installedFile{Path: nodeKeyFileInDataDir, Source: "key generator", Action: "generated"})
+	b.logger.Info("generated node key", zap.String("node_key_file", nodeKeyFileInDataDir), zap.String("public_key", secretKey.PublicKey().AsKeyString()))
+	return nil
+}
+
+// installConfig installs the config file in the node data dir when it's missing (or when
+// overwriting is forced) and applies the config patches, if any, on top of it. Patches are applied
+// at each bootstrap, to the installed config when the config file is not installed again, which
+// is fine since applying a merge patch twice has the same effect as applying it once.
+func (b *bootstrapper) installConfig(ctx context.Context, configFileInDataDir string) error {
+	exists, err := fileExists(configFileInDataDir)
 	if err != nil {
-		return fmt.Errorf("create file: %w", err)
+		return err
 	}
 
-	if _, err := io.Copy(writer, reader); err != nil {
-		// Do our best to delete the file if an error occurred while copying
-		_ = os.Remove(out)
+	install := (!exists || b.forceOverwrite) && b.configFile != ""
+	if len(b.configPatchFiles) == 0 && len(b.configSetPatches) == 0 {
+		if install {
+			if err := b.installRemoteFile(ctx, b.configFile, configFileInDataDir, b.configFileSHA256, 0644); err != nil {
+				return fmt.Errorf("unable to copy config file %q to %q: %w", b.configFile, configFileInDataDir, err)
+			}
+		}
+		return nil
+	}
+
+	var installed nodeconfig.Config
+	if exists {
+		content, err := os.ReadFile(configFileInDataDir)
+		if err != nil {
+			return fmt.Errorf("read installed config file: %w", err)
+		}
+
+		if installed, err = nodeconfig.Parse(content); err != nil {
+			return fmt.Errorf("installed config file %q: %w", configFileInDataDir, err)
+		}
+	}
+
+	// The source config is always read so that the logged changes are the ones from it
+	var source nodeconfig.Config
+	if b.configFile != "" {
+		content, err := b.readRemoteFile(ctx, b.configFile)
+		if err != nil {
+			return fmt.Errorf("read config file %q: %w", b.configFile, err)
+		}
+
+		if err := verifySHA256(b.configFile, content, b.configFileSHA256); err != nil {
+			return err
+		}
+
+		if source, err = nodeconfig.Parse(content); err != nil {
+			return fmt.Errorf("config file %q: %w", b.configFile, err)
+		}
+	}
+
+	base := installed
+	switch {
+	case install:
+		base = source
+	case !exists:
+		return fmt.Errorf("config patches cannot be applied, there is no config file to patch, set --reader-node-config-file")
+	case source == nil:
+		source = installed
+	}
+
+	patches, err := b.configPatches(ctx)
+	if err != nil {
+		return err
+	}
+
+	effective := base
+	for _, patch := range patches {
+		effective = effective.Apply(patch)
+	}
+
+	var changes []string
+	for _, change := range source.Diff(effective) {
+		changes = append(changes, change.String())
+	}
+	b.logger.Info("effective node config", zap.String("config_file", configFileInDataDir), zap.String("source_config_file", b.configFile), zap.Strings("changes", changes))
+
+	if exists && len(installed.Diff(effective)) == 0 {
+		return nil
+	}
 
-		return fmt.Errorf("copy content: %w", err)
+	content, err := effective.Marshal()
+	if err != nil {
+		return err
+	}
+
+	if err := b.installContent(configFileInDataDir, "config patches", content, 0644); err != nil {
+		return fmt.Errorf("write config file %q: %w", configFileInDataDir, err)
 	}
 
 	return nil
 }
 
-func replaceHostname(in, hostname string) string {
-	return strings.Replace(in, "{hostname}", hostname, -1)
+func (b *bootstrapper) configPatches(ctx context.Context) (out []nodeconfig.Patch, err error) {
+	for _, file := range b.configPatchFiles {
+		content, err := b.readRemoteFile(ctx, file)
+		if err != nil {
+			return nil, fmt.Errorf("read config patch file %q: %w", file, err)
+		}
+
+		patch, err := nodeconfig.ParsePatch(content)
+		if err != nil {
+			return nil, fmt.Errorf("config patch file %q: %w", file, err)
+		}
+
+		out = append(out, patch)
+	}
+
+	out = append(out, b.configSetPatches...)
+
+	for i, patch := range out {
+		if out[i], err = patch.ExpandStrings(b.expandPlaceholders); err != nil {
+			return nil, fmt.Errorf("expand config patch placeholders: %w", err)
+		}
+	}
+
+	return out, nil
+}
+
+// checkNetworkIdentity verifies that the installed genesis and config are for the expected network
+// and records the genesis chain ID.
+func (b *bootstrapper) checkNetworkIdentity(genesisFile, configFile string) error {
+	file, err := os.Open(genesisFile)
+	if err != nil {
+		return fmt.Errorf("open genesis file: %w", err)
+	}
+	defer file.Close()
+
+	genesis, err := nodeconfig.ReadGenesis(file)
+	if err != nil {
+		return fmt.Errorf("genesis file %q: %w", genesisFile, err)
+	}
+
+	// Hashing the genesis means reading it fully, which is only done when it is pinned
+	if b.expectedIdentity.GenesisSHA256 != "" {
+		if _, err := file.Seek(0, io.SeekStart); err != nil {
+			return fmt.Errorf("seek genesis file: %w", err)
+		}
+
+		if genesis.SHA256, err = nodeconfig.GenesisSHA256(file); err != nil {
+			return fmt.Errorf("genesis file %q: %w", genesisFile, err)
+		}
+	}
+
+	var config nodeconfig.Config
+	if content, err := os.ReadFile(configFile); err == nil {
+		if config, err = nodeconfig.Parse(content); err != nil {
+			return fmt.Errorf("config file %q: %w", configFile, err)
+		}
+	} else if !os.IsNotExist(err) {
+		return fmt.Errorf("read config file: %w", err)
+	}
+
+	if err := nodeconfig.CheckIdentity(genesis, config, b.expectedIdentity); err != nil {
+		return fmt.Errorf("node files network identity: %w", err)
+	}
+
+	fields := []zap.Field{
+		zap.String("chain_id", genesis.ChainID),
+		zap.Uint64("genesis_height", genesis.GenesisHeight),
+		zap.Uint64("genesis_protocol_version", genesis.ProtocolVersion),
+	}
+	if genesis.SHA256 != "" {
+		fields = append(fields, zap.String("genesis_sha256", genesis.SHA256))
+	}
+	b.logger.Info("node network identity", fields...)
+
+	readerNodeChainID.Store(&genesis.ChainID)
+	return nil
 }
 
-func replaceNodeRole(in, hostname string) string {
-	if strings.HasPrefix(hostname, "extractor-") {
-		return strings.Replace(in, "{node-role}", "reader", -1) // todo(colin): this is not right. fix this in gcp
+func fileExists(file string) (bool, error) {
+	stat, err := os.Stat(file)
+	if os.IsNotExist(err) {
+		return false, nil
 	}
 
-	return in
+	if err != nil {
+		return false, err
+	}
+
+	return !stat.IsDir(), nil
 }
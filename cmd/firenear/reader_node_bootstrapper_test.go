@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/streamingfast/firehose-near/nodeconfig"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// stubNodeInit mimics `neard --home=<dir> init --chain-id=<chain>` by writing a config and a
+// genesis file in the home directory, recording its arguments in `init_args`.
+const stubNodeInit = `#!/bin/sh
+set -e
+
+home=
+chain_id=
+for arg in "$@"; do
+  case "$arg" in
+    --home=*) home="${arg#--home=}";;
+    --chain-id=*) chain_id="${arg#--chain-id=}";;
+    --fail) echo "init failed on purpose"; exit 1;;
+  esac
+done
+
+mkdir -p "$home"
+echo "$@" > "$home/init_args"
+echo '{"rpc": {"addr": "0.0.0.0:3030"}, "archive": false, "tracked_shards": []}' > "$home/config.json"
+echo "{\"chain_id\": \"$chain_id\", \"genesis_height\": 42}" > "$home/genesis.json"
+`
+
+func newTestBootstrapper(t *testing.T, initArguments ...string) *bootstrapper {
+	t.Helper()
+
+	nodePath := filepath.Join(t.TempDir(), "neard")
+	require.NoError(t, os.WriteFile(nodePath, []byte(stubNodeInit), 0755))
+
+	setArchive, err := nodeconfig.ParseSetPatch("archive=true")
+	require.NoError(t, err)
+
+	setTrackedShards, err := nodeconfig.ParseSetPatch("tracked_shards=[0]")
+	require.NoError(t, err)
+
+	nodeDataDir := filepath.Join(t.TempDir(), "reader", "data")
+	return &bootstrapper{
+		ctx:                context.Background(),
+		nodeDataDir:        nodeDataDir,
+		autoInit:           true,
+		nodePath:           nodePath,
+		initArguments:      initArguments,
+		configSetPatches:   []nodeconfig.Patch{setArchive, setTrackedShards},
+		expandPlaceholders: (&readerNodeTemplate{nodeDataDir: nodeDataDir}).Expand,
+		expectedIdentity:   nodeconfig.IdentityExpectations{ChainID: "testnet"},
+		logger:             zap.NewNop(),
+	}
+}
+
+func TestBootstrapper_AutoInit(t *testing.T) {
+	b := newTestBootstrapper(t, "--download-genesis")
+	require.NoError(t, b.Bootstrap())
+
+	initArgs, err := os.ReadFile(filepath.Join(b.nodeDataDir, "init_args"))
+	require.NoError(t, err)
+	assert.Equal(t, "--home="+b.nodeDataDir+" init --chain-id=testnet --download-genesis\n", string(initArgs))
+
+	content, err := os.ReadFile(filepath.Join(b.nodeDataDir, "config.json"))
+	require.NoError(t, err)
+
+	config, err := nodeconfig.Parse(content)
+	require.NoError(t, err)
+
+	archive, _ := config.Lookup("archive")
+	assert.Equal(t, true, archive)
+
+	trackedShards, _ := config.Lookup("tracked_shards")
+	assert.Equal(t, []interface{}{0.0}, trackedShards)
+
+	nodeKeyFile := filepath.Join(b.nodeDataDir, "node_key.json")
+	stat, err := os.Stat(nodeKeyFile)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), stat.Mode().Perm())
+
+	content, err = os.ReadFile(nodeKeyFile)
+	require.NoError(t, err)
+
+	key := keyFile{}
+	require.NoError(t, json.Unmarshal(content, &key))
+
+	secretKey, err := pbnear.ParseSecretKey(key.SecretKey)
+	require.NoError(t, err)
+	assert.Equal(t, secretKey.PublicKey().AsKeyString(), key.PublicKey)
+
+	// A second bootstrap neither initializes the node again nor regenerates its key
+	require.NoError(t, os.Remove(filepath.Join(b.nodeDataDir, "init_args")))
+	require.NoError(t, b.Bootstrap())
+
+	_, err = os.Stat(filepath.Join(b.nodeDataDir, "init_args"))
+	assert.True(t, os.IsNotExist(err))
+
+	unchanged, err := os.ReadFile(nodeKeyFile)
+	require.NoError(t, err)
+	assert.Equal(t, content, unchanged)
+}
+
+func TestBootstrapper_AutoInitErrors(t *testing.T) {
+	b := newTestBootstrapper(t, "--fail")
+	assert.ErrorContains(t, b.Bootstrap(), "init failed on purpose")
+
+	b = newTestBootstrapper(t)
+	b.expectedIdentity.ChainID = ""
+	assert.EqualError(t, b.Bootstrap(), "--reader-node-network is required to initialize the node with --reader-node-auto-init")
+
+	b = newTestBootstrapper(t)
+	b.autoInit = false
+	assert.ErrorContains(t, b.Bootstrap(), "has no config file, set --reader-node-config-file or use --reader-node-auto-init")
+}
+
+func TestBootstrapper_InstallFiles(t *testing.T) {
+	sourceDir := t.TempDir()
+	configFile := filepath.Join(sourceDir, "config.json")
+	require.NoError(t, os.WriteFile(configFile, []byte(`{"rpc": {"addr": "127.0.0.1:3030"}}`), 0644))
+
+	genesisFile := filepath.Join(sourceDir, "genesis.json")
+	require.NoError(t, os.WriteFile(genesisFile, []byte(`{"chain_id": "testnet", "genesis_height": 42}`), 0644))
+
+	nodeKey, err := pbnear.GenerateSecretKey(pbnear.CurveKind_ED25519, rand.Reader)
+	require.NoError(t, err)
+
+	nodeKeyFile := filepath.Join(sourceDir, "node_key.json")
+	require.NoError(t, writeKeyFile(nodeKeyFile, "node", nodeKey, true))
+	require.NoError(t, os.Chmod(nodeKeyFile, 0644))
+
+	nodeKeySHA256, err := fileSHA256(nodeKeyFile)
+	require.NoError(t, err)
+
+	b := newTestBootstrapper(t)
+	b.autoInit = false
+	b.configFile = configFile
+	b.nodeKeyFile = nodeKeyFile
+	b.nodeKeyFileSHA256 = nodeKeySHA256
+	b.genesisFile = genesisFile
+	b.expectedIdentity.GenesisSHA256 = "00" + nodeKeySHA256[2:]
+	assert.ErrorContains(t, b.Bootstrap(), "but "+b.expectedIdentity.GenesisSHA256+" is pinned")
+	assert.Equal(t, []string{"created config.json", "created node_key.json"}, changedFiles(b))
+
+	_, err = os.Stat(filepath.Join(b.nodeDataDir, "genesis.json"))
+	assert.True(t, os.IsNotExist(err))
+
+	b.expectedIdentity.GenesisSHA256 = ""
+	require.NoError(t, b.Bootstrap())
+	assert.Equal(t, []string{"created genesis.json"}, changedFiles(b))
+
+	// The node key is owner only even when its source is not
+	stat, err := os.Stat(filepath.Join(b.nodeDataDir, "node_key.json"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), stat.Mode().Perm())
+
+	entries, err := os.ReadDir(b.nodeDataDir)
+	require.NoError(t, err)
+	for _, entry := range entries {
+		assert.NotContains(t, entry.Name(), ".tmp-")
+	}
+
+	// Installing again the same content changes nothing
+	b.forceOverwrite = true
+	require.NoError(t, b.Bootstrap())
+	assert.Empty(t, b.changes)
+
+	// The installed genesis is only hashed when pinned
+	genesisSHA256, err := fileSHA256(genesisFile)
+	require.NoError(t, err)
+
+	b.forceOverwrite = false
+	b.expectedIdentity.GenesisSHA256 = genesisSHA256
+	require.NoError(t, b.Bootstrap())
+
+	b.expectedIdentity.GenesisSHA256 = "00" + genesisSHA256[2:]
+	assert.ErrorContains(t, b.Bootstrap(), "the genesis file is probably stale")
+}
+
+func changedFiles(b *bootstrapper) (out []string) {
+	for _, change := range b.changes {
+		out = append(out, change.Action+" "+filepath.Base(change.Path))
+	}
+	return
+}
@@ -0,0 +1,217 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/streamingfast/dstore"
+	"go.uber.org/zap"
+)
+
+const (
+	installReadAttempts   = 3
+	installReadRetryDelay = 2 * time.Second
+)
+
+// installedFile is a node data dir file changed by a bootstrap
+type installedFile struct {
+	Path   string
+	Source string
+	// Action is one of `created`, `updated` or `generated`
+	Action string
+	SHA256 string
+}
+
+func (f *installedFile) String() string {
+	if f.SHA256 == "" {
+		return fmt.Sprintf("%s %s (from %s)", f.Action, f.Path, f.Source)
+	}
+	return fmt.Sprintf("%s %s (from %s, sha256 %s)", f.Action, f.Path, f.Source, f.SHA256)
+}
+
+// installRemoteFile installs the content of the dstore URL at path, see installFile. Reading the
+// URL is retried on failure.
+func (b *bootstrapper) installRemoteFile(ctx context.Context, url, path, expectedSHA256 string, perm os.FileMode) error {
+	var err error
+	for attempt := 1; attempt <= installReadAttempts; attempt++ {
+		err = b.installFile(path, url, expectedSHA256, perm, func() (io.ReadCloser, error) {
+			reader, _, _, err := dstore.OpenObject(ctx, url)
+			return reader, err
+		})
+		if err == nil || !isRetryableInstallError(err) || attempt == installReadAttempts {
+			break
+		}
+
+		b.logger.Warn("reading node file failed, retrying", zap.String("url", url), zap.Int("attempt", attempt), zap.Error(err))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(installReadRetryDelay * time.Duration(attempt)):
+		}
+	}
+
+	return err
+}
+
+// readRemoteFile reads the content of the dstore URL, retrying on failure
+func (b *bootstrapper) readRemoteFile(ctx context.Context, url string) (content []byte, err error) {
+	for attempt := 1; attempt <= installReadAttempts; attempt++ {
+		if content, err = dstore.ReadObject(ctx, url); err == nil || !isRetryableInstallError(err) || attempt == installReadAttempts {
+			break
+		}
+
+		b.logger.Warn("reading node file failed, retrying", zap.String("url", url), zap.Int("attempt", attempt), zap.Error(err))
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(installReadRetryDelay * time.Duration(attempt)):
+		}
+	}
+
+	return content, err
+}
+
+// installContent installs the content at path, see installFile
+func (b *bootstrapper) installContent(path, source string, content []byte, perm os.FileMode) error {
+	return b.installFile(path, source, "", perm, func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(content)), nil
+	})
+}
+
+// checksumMismatchError is not retried, reading the source again would give the same content
+type checksumMismatchError struct {
+	source   string
+	expected string
+	actual   string
+}
+
+func (e *checksumMismatchError) Error() string {
+	return fmt.Sprintf("%s has sha256 %s but %s is pinned", e.source, e.actual, e.expected)
+}
+
+func isRetryableInstallError(err error) bool {
+	var mismatch *checksumMismatchError
+	return !errors.As(err, &mismatch) && !errors.Is(err, dstore.ErrNotFound)
+}
+
+// verifySHA256 checks that the content read from source has the expected sha256, when set
+func verifySHA256(source string, content []byte, expectedSHA256 string) error {
+	if expectedSHA256 == "" {
+		return nil
+	}
+
+	checksum := sha256.Sum256(content)
+	if actual := hex.EncodeToString(checksum[:]); !strings.EqualFold(actual, expectedSHA256) {
+		return &checksumMismatchError{source: source, expected: expectedSHA256, actual: actual}
+	}
+
+	return nil
+}
+
+// installFile atomically installs the content returned by open at path: the content is written
+// to a temporary file of the same directory which is synced, given perm permissions and renamed
+// to path. The content sha256 must be expectedSHA256 when set. A path already having the content
+// is left untouched, otherwise it's recorded in the bootstrap changes.
+func (b *bootstrapper) installFile(path, source, expectedSHA256 string, perm os.FileMode, open func() (io.ReadCloser, error)) error {
+	reader, err := open()
+	if err != nil {
+		return fmt.Errorf("open %s: %w", source, err)
+	}
+	defer reader.Close()
+
+	tempFile, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("create temporary file: %w", err)
+	}
+	tempPath := tempFile.Name()
+
+	installed := false
+	defer func() {
+		if !installed {
+			tempFile.Close()
+			_ = os.Remove(tempPath)
+		}
+	}()
+
+	checksum := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(tempFile, checksum), reader); err != nil {
+		return fmt.Errorf("read %s: %w", source, err)
+	}
+
+	actualSHA256 := hex.EncodeToString(checksum.Sum(nil))
+	if expectedSHA256 != "" && !strings.EqualFold(expectedSHA256, actualSHA256) {
+		return &checksumMismatchError{source: source, expected: expectedSHA256, actual: actualSHA256}
+	}
+
+	previousSHA256, err := fileSHA256(path)
+	if err != nil {
+		return err
+	}
+
+	if previousSHA256 == actualSHA256 {
+		// Content is already installed, permissions are still enforced
+		if err := os.Chmod(path, perm); err != nil {
+			return fmt.Errorf("change permissions of %q: %w", path, err)
+		}
+		return nil
+	}
+
+	if err := tempFile.Chmod(perm); err != nil {
+		return fmt.Errorf("change permissions of temporary file: %w", err)
+	}
+
+	if err := tempFile.Sync(); err != nil {
+		return fmt.Errorf("sync temporary file: %w", err)
+	}
+
+	if err := tempFile.Close(); err != nil {
+		return fmt.Errorf("close temporary file: %w", err)
+	}
+
+	if err := os.Rename(tempPath, path); err != nil {
+		return fmt.Errorf("rename temporary file to %q: %w", path, err)
+	}
+	installed = true
+
+	// Syncing the directory makes the rename itself durable
+	if dir, err := os.Open(filepath.Dir(path)); err == nil {
+		_ = dir.Sync()
+		dir.Close()
+	}
+
+	action := "updated"
+	if previousSHA256 == "" {
+		action = "created"
+	}
+	b.changes = append(b.changes, &installedFile{Path: path, Source: source, Action: action, SHA256: actualSHA256})
+
+	return nil
+}
+
+// fileSHA256 returns the hex encoded sha256 of the file content, empty if the file does not exist
+func fileSHA256(path string) (string, error) {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("open %q: %w", path, err)
+	}
+	defer file.Close()
+
+	checksum := sha256.New()
+	if _, err := io.Copy(checksum, file); err != nil {
+		return "", fmt.Errorf("read %q: %w", path, err)
+	}
+
+	return hex.EncodeToString(checksum.Sum(nil)), nil
+}
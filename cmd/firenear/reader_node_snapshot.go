@@ -0,0 +1,465 @@
+package main
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+	"github.com/streamingfast/dstore"
+	"github.com/streamingfast/firehose-near/nodeconfig"
+	"go.uber.org/zap"
+)
+
+// snapshotProgressFile records, inside the node data dir, the progress of an interrupted
+// snapshot extraction so that it can be resumed.
+const snapshotProgressFile = ".firenear-snapshot-progress.json"
+
+const (
+	snapshotProgressLogInterval  = 30 * time.Second
+	snapshotProgressSaveInterval = 5 * time.Second
+)
+
+var (
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+	lz4Magic  = []byte{0x04, 0x22, 0x4d, 0x18}
+	gzipMagic = []byte{0x1f, 0x8b}
+)
+
+// snapshotRestorer seeds the node data dir with a tar archive (optionally zstd, lz4 or gzip
+// compressed) of a node's data dir, its entries being extracted relative to the node data dir.
+//
+// dstore has no ranged reads, so a resumed restoration streams the archive from its start again
+// but skips writing the entries already fully extracted.
+type snapshotRestorer struct {
+	url string
+	// sha256 is the expected hex encoded sha256 of the archive, when empty the `<url>.sha256`
+	// checksum file is used if it exists
+	sha256      string
+	nodeDataDir string
+	logger      *zap.Logger
+}
+
+type snapshotProgress struct {
+	URL string `json:"url"`
+	// Entries is the count of tar entries fully extracted
+	Entries uint64 `json:"entries"`
+	// Created are the topmost paths created by the extraction, the ones existing before it (like
+	// the node files installed by the bootstrapper) not included. They are removed if the archive
+	// turns out to be corrupted.
+	Created []string `json:"created"`
+}
+
+// hasNodeDatabase returns whether the node data dir contains a node database, an interrupted
+// snapshot restoration not counting as one.
+func hasNodeDatabase(nodeDataDir string) (bool, error) {
+	if exists, err := fileExists(filepath.Join(nodeDataDir, snapshotProgressFile)); err != nil || exists {
+		return false, err
+	}
+
+	entries, err := os.ReadDir(nodeDatabaseDir(nodeDataDir))
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("read node database dir: %w", err)
+	}
+
+	return len(entries) > 0, nil
+}
+
+// nodeDatabaseDir returns the node's database directory, `store.path` of the node data dir's
+// `config.json`, `data` by default.
+func nodeDatabaseDir(nodeDataDir string) string {
+	path := "data"
+	if content, err := os.ReadFile(filepath.Join(nodeDataDir, "config.json")); err == nil {
+		if config, err := nodeconfig.Parse(content); err == nil {
+			if value, found := config.Lookup("store.path"); found {
+				if storePath, ok := value.(string); ok && storePath != "" {
+					path = storePath
+				}
+			}
+		}
+	}
+
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(nodeDataDir, path)
+}
+
+func (r *snapshotRestorer) Restore(ctx context.Context) error {
+	progress, err := r.loadProgress()
+	if err != nil {
+		return err
+	}
+
+	if progress.Entries > 0 {
+		r.logger.Info("resuming interrupted snapshot restoration", zap.String("url", r.url), zap.Uint64("extracted_entries", progress.Entries))
+	} else {
+		r.logger.Info("restoring node data dir from snapshot", zap.String("url", r.url), zap.String("node_data_dir", r.nodeDataDir))
+	}
+
+	expectedChecksum, err := r.expectedChecksum(ctx)
+	if err != nil {
+		return err
+	}
+
+	reader, _, _, err := dstore.OpenObject(ctx, r.url)
+	if err != nil {
+		return fmt.Errorf("open snapshot %q: %w", r.url, err)
+	}
+	defer reader.Close()
+
+	counter := &countingReader{reader: reader}
+	checksum := sha256.New()
+
+	archive, err := decompressedReader(io.TeeReader(counter, checksum))
+	if err != nil {
+		return err
+	}
+	defer archive.Close()
+
+	if err := r.extract(ctx, tar.NewReader(archive), progress, counter); err != nil {
+		return err
+	}
+
+	// Reads the padding after the end-of-archive marker so that it's part of the checksum
+	if _, err := io.Copy(io.Discard, archive); err != nil {
+		return fmt.Errorf("read snapshot: %w", err)
+	}
+
+	if err := r.verifyChecksum(checksum, expectedChecksum, progress); err != nil {
+		return err
+	}
+
+	if err := os.Remove(filepath.Join(r.nodeDataDir, snapshotProgressFile)); err != nil {
+		return fmt.Errorf("remove snapshot progress file: %w", err)
+	}
+
+	r.logger.Info("restored node data dir from snapshot", zap.String("url", r.url), zap.Uint64("entries", progress.Entries), zap.Uint64("bytes", counter.count))
+	return nil
+}
+
+func (r *snapshotRestorer) extract(ctx context.Context, archive *tar.Reader, progress *snapshotProgress, counter *countingReader) error {
+	start := time.Now()
+	lastLog := start
+	lastSave := start
+	resumeAfter := progress.Entries
+
+	for entry := uint64(0); ; entry++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		header, err := archive.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("read snapshot entry: %w", err)
+		}
+
+		if entry < resumeAfter {
+			continue
+		}
+
+		if err := r.extractEntry(header, archive, progress); err != nil {
+			return err
+		}
+		progress.Entries = entry + 1
+
+		if now := time.Now(); now.Sub(lastSave) >= snapshotProgressSaveInterval {
+			if err := r.saveProgress(progress); err != nil {
+				return err
+			}
+			lastSave = now
+		}
+
+		if now := time.Now(); now.Sub(lastLog) >= snapshotProgressLogInterval {
+			elapsed := now.Sub(start)
+			r.logger.Info("restoring snapshot",
+				zap.Uint64("entries", progress.Entries),
+				zap.Uint64("read_bytes", counter.count),
+				zap.String("rate", fmt.Sprintf("%.2f MiB/s", float64(counter.count)/elapsed.Seconds()/(1024*1024))),
+				zap.Duration("elapsed", elapsed),
+			)
+			lastLog = now
+		}
+	}
+
+	return r.saveProgress(progress)
+}
+
+func (r *snapshotRestorer) extractEntry(header *tar.Header, content io.Reader, progress *snapshotProgress) error {
+	name := filepath.Clean(filepath.FromSlash(header.Name))
+	if name == "." {
+		return nil
+	}
+
+	if filepath.IsAbs(name) || name == ".." || strings.HasPrefix(name, ".."+string(filepath.Separator)) {
+		return fmt.Errorf("snapshot entry %q is outside of the node data dir", header.Name)
+	}
+
+	if err := r.checkNoSymlinkParent(name); err != nil {
+		return err
+	}
+
+	if err := r.recordCreatedPath(name, progress); err != nil {
+		return err
+	}
+
+	path := filepath.Join(r.nodeDataDir, name)
+	switch header.Typeflag {
+	case tar.TypeDir:
+		if err := os.MkdirAll(path, 0755); err != nil {
+			return fmt.Errorf("create snapshot dir %q: %w", name, err)
+		}
+
+	case tar.TypeReg:
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return fmt.Errorf("create snapshot dir of %q: %w", name, err)
+		}
+
+		file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, os.FileMode(header.Mode).Perm())
+		if err != nil {
+			return fmt.Errorf("create snapshot file %q: %w", name, err)
+		}
+
+		if _, err := io.Copy(file, content); err != nil {
+			file.Close()
+			return fmt.Errorf("extract snapshot file %q: %w", name, err)
+		}
+
+		if err := file.Close(); err != nil {
+			return fmt.Errorf("close snapshot file %q: %w", name, err)
+		}
+
+	case tar.TypeSymlink:
+		target := header.Linkname
+		if !filepath.IsAbs(target) {
+			target = filepath.Join(filepath.Dir(name), target)
+		}
+		if target = filepath.Clean(target); filepath.IsAbs(target) || target == ".." || strings.HasPrefix(target, ".."+string(filepath.Separator)) {
+			return fmt.Errorf("snapshot symlink %q points outside of the node data dir", header.Name)
+		}
+
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return fmt.Errorf("create snapshot dir of %q: %w", name, err)
+		}
+
+		// The link may already exist when resuming
+		_ = os.Remove(path)
+		if err := os.Symlink(header.Linkname, path); err != nil {
+			return fmt.Errorf("create snapshot symlink %q: %w", name, err)
+		}
+
+	default:
+		r.logger.Debug("skipping unsupported snapshot entry", zap.String("name", header.Name), zap.Uint8("type", header.Typeflag))
+	}
+
+	return nil
+}
+
+// checkNoSymlinkParent rejects entries whose parent directories include a symlink, as writing
+// through one could escape the node data dir even when each symlink's target is inside of it.
+func (r *snapshotRestorer) checkNoSymlinkParent(name string) error {
+	for parent := filepath.Dir(name); parent != "."; parent = filepath.Dir(parent) {
+		info, err := os.Lstat(filepath.Join(r.nodeDataDir, parent))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("stat snapshot dir %q: %w", parent, err)
+		}
+
+		if info.Mode()&os.ModeSymlink != 0 {
+			return fmt.Errorf("snapshot entry %q is inside symlink %q", name, parent)
+		}
+	}
+
+	return nil
+}
+
+// recordCreatedPath records the topmost path of the entry not existing yet in the node data dir,
+// if any, before anything is written so that it can be cleaned up.
+func (r *snapshotRestorer) recordCreatedPath(name string, progress *snapshotProgress) error {
+	parts := strings.Split(name, string(filepath.Separator))
+	for i := range parts {
+		path := filepath.Join(parts[:i+1]...)
+		if containsString(progress.Created, path) {
+			return nil
+		}
+
+		if _, err := os.Lstat(filepath.Join(r.nodeDataDir, path)); os.IsNotExist(err) {
+			progress.Created = append(progress.Created, path)
+			return r.saveProgress(progress)
+		} else if err != nil {
+			return fmt.Errorf("stat snapshot path %q: %w", path, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *snapshotRestorer) expectedChecksum(ctx context.Context) (string, error) {
+	if r.sha256 != "" {
+		return strings.ToLower(r.sha256), nil
+	}
+
+	content, err := dstore.ReadObject(ctx, r.url+".sha256")
+	if err != nil {
+		if errors.Is(err, dstore.ErrNotFound) {
+			r.logger.Warn("snapshot checksum file not found, snapshot integrity will not be verified", zap.String("checksum_url", r.url+".sha256"))
+			return "", nil
+		}
+		return "", fmt.Errorf("read snapshot checksum file: %w", err)
+	}
+
+	// `sha256sum` format, the checksum followed by the file name
+	fields := strings.Fields(string(content))
+	if len(fields) == 0 {
+		return "", fmt.Errorf("snapshot checksum file %q is empty", r.url+".sha256")
+	}
+
+	return strings.ToLower(fields[0]), nil
+}
+
+func (r *snapshotRestorer) verifyChecksum(checksum hash.Hash, expected string, progress *snapshotProgress) error {
+	if expected == "" {
+		return nil
+	}
+
+	actual := hex.EncodeToString(checksum.Sum(nil))
+	if actual == expected {
+		r.logger.Info("snapshot checksum verified", zap.String("sha256", actual))
+		return nil
+	}
+
+	for _, path := range progress.Created {
+		if err := os.RemoveAll(filepath.Join(r.nodeDataDir, path)); err != nil {
+			r.logger.Warn("unable to remove corrupted snapshot content", zap.String("path", path), zap.Error(err))
+		}
+	}
+	_ = os.Remove(filepath.Join(r.nodeDataDir, snapshotProgressFile))
+
+	return fmt.Errorf("snapshot %q sha256 checksum mismatch, expected %s but got %s, the extracted content has been removed", r.url, expected, actual)
+}
+
+func (r *snapshotRestorer) loadProgress() (*snapshotProgress, error) {
+	progress := &snapshotProgress{URL: r.url}
+
+	content, err := os.ReadFile(filepath.Join(r.nodeDataDir, snapshotProgressFile))
+	if os.IsNotExist(err) {
+		return progress, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read snapshot progress file: %w", err)
+	}
+
+	previous := &snapshotProgress{}
+	if err := json.Unmarshal(content, previous); err != nil {
+		return nil, fmt.Errorf("unmarshal snapshot progress file: %w", err)
+	}
+
+	if previous.URL != r.url {
+		return nil, fmt.Errorf("node data dir contains an interrupted restoration of snapshot %q which is not %q, clean up the node data dir first", previous.URL, r.url)
+	}
+
+	return previous, nil
+}
+
+func (r *snapshotRestorer) saveProgress(progress *snapshotProgress) error {
+	content, err := json.Marshal(progress)
+	if err != nil {
+		return fmt.Errorf("marshal snapshot progress: %w", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(r.nodeDataDir, snapshotProgressFile), content, 0644); err != nil {
+		return fmt.Errorf("write snapshot progress file: %w", err)
+	}
+
+	return nil
+}
+
+// decompressedReader detects the compression of the archive from its magic bytes
+func decompressedReader(in io.Reader) (io.ReadCloser, error) {
+	buffered := bufio.NewReader(in)
+	magic, err := buffered.Peek(4)
+	if err != nil && err != io.EOF {
+		return nil, fmt.Errorf("read snapshot: %w", err)
+	}
+
+	switch {
+	case bytes.HasPrefix(magic, zstdMagic):
+		decoder, err := zstd.NewReader(buffered)
+		if err != nil {
+			return nil, fmt.Errorf("new zstd reader: %w", err)
+		}
+		return decoder.IOReadCloser(), nil
+
+	case bytes.HasPrefix(magic, lz4Magic):
+		// The lz4 reader fails when read again after it returned io.EOF, which the tar reader does
+		return io.NopCloser(&stickyEOFReader{reader: lz4.NewReader(buffered)}), nil
+
+	case bytes.HasPrefix(magic, gzipMagic):
+		decoder, err := gzip.NewReader(buffered)
+		if err != nil {
+			return nil, fmt.Errorf("new gzip reader: %w", err)
+		}
+		return decoder, nil
+	}
+
+	return io.NopCloser(buffered), nil
+}
+
+type stickyEOFReader struct {
+	reader io.Reader
+	eof    bool
+}
+
+func (r *stickyEOFReader) Read(p []byte) (int, error) {
+	if r.eof {
+		return 0, io.EOF
+	}
+
+	n, err := r.reader.Read(p)
+	if err == io.EOF {
+		r.eof = true
+	}
+	return n, err
+}
+
+type countingReader struct {
+	reader io.Reader
+	count  uint64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.count += uint64(n)
+	return n, err
+}
+
+func containsString(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,238 @@
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type testTarEntry struct {
+	name     string
+	typeflag byte
+	content  string
+	linkname string
+}
+
+func tarDir(name string) testTarEntry {
+	return testTarEntry{name: name, typeflag: tar.TypeDir}
+}
+
+func tarFile(name, content string) testTarEntry {
+	return testTarEntry{name: name, typeflag: tar.TypeReg, content: content}
+}
+
+func tarSymlink(name, linkname string) testTarEntry {
+	return testTarEntry{name: name, typeflag: tar.TypeSymlink, linkname: linkname}
+}
+
+func buildTestTar(t *testing.T, entries ...testTarEntry) []byte {
+	t.Helper()
+
+	buf := &bytes.Buffer{}
+	writer := tar.NewWriter(buf)
+	for _, entry := range entries {
+		require.NoError(t, writer.WriteHeader(&tar.Header{Name: entry.name, Typeflag: entry.typeflag, Linkname: entry.linkname, Mode: 0644, Size: int64(len(entry.content))}))
+		_, err := writer.Write([]byte(entry.content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+
+	return buf.Bytes()
+}
+
+func compressTestArchive(t *testing.T, compression string, in []byte) []byte {
+	t.Helper()
+
+	buf := &bytes.Buffer{}
+	var writer io.WriteCloser
+	switch compression {
+	case "none":
+		return in
+	case "gzip":
+		writer = gzip.NewWriter(buf)
+	case "zstd":
+		encoder, err := zstd.NewWriter(buf)
+		require.NoError(t, err)
+		writer = encoder
+	case "lz4":
+		writer = lz4.NewWriter(buf)
+	default:
+		t.Fatalf("unknown compression %q", compression)
+	}
+
+	_, err := writer.Write(in)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	return buf.Bytes()
+}
+
+// newTestSnapshotRestorer writes the archive in a temporary dir and returns a restorer of it
+// into a node data dir inside of another temporary dir, the checksum of the archive being set
+// unless it's empty
+func newTestSnapshotRestorer(t *testing.T, archive []byte, checksum string) *snapshotRestorer {
+	t.Helper()
+
+	archivePath := filepath.Join(t.TempDir(), "snapshot.tar")
+	require.NoError(t, os.WriteFile(archivePath, archive, 0644))
+
+	nodeDataDir := filepath.Join(t.TempDir(), "node")
+	require.NoError(t, os.MkdirAll(nodeDataDir, 0755))
+
+	return &snapshotRestorer{url: "file://" + archivePath, sha256: checksum, nodeDataDir: nodeDataDir, logger: zap.NewNop()}
+}
+
+func sha256Hex(in []byte) string {
+	sum := sha256.Sum256(in)
+	return hex.EncodeToString(sum[:])
+}
+
+func readTestFile(t *testing.T, path string) string {
+	t.Helper()
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return string(content)
+}
+
+func TestSnapshotRestorer_Restore(t *testing.T) {
+	archive := buildTestTar(t,
+		tarDir("./"),
+		tarDir("data/"),
+		tarFile("data/CURRENT", "MANIFEST-000001"),
+		tarFile("data/000001.sst", "sst"),
+		tarSymlink("data/LATEST", "CURRENT"),
+		tarFile("node_state.json", "{}"),
+	)
+
+	for _, compression := range []string{"none", "gzip", "zstd", "lz4"} {
+		t.Run(compression, func(t *testing.T) {
+			compressed := compressTestArchive(t, compression, archive)
+			restorer := newTestSnapshotRestorer(t, compressed, sha256Hex(compressed))
+
+			require.NoError(t, restorer.Restore(context.Background()))
+
+			assert.Equal(t, "MANIFEST-000001", readTestFile(t, filepath.Join(restorer.nodeDataDir, "data", "CURRENT")))
+			assert.Equal(t, "sst", readTestFile(t, filepath.Join(restorer.nodeDataDir, "data", "000001.sst")))
+			assert.Equal(t, "{}", readTestFile(t, filepath.Join(restorer.nodeDataDir, "node_state.json")))
+
+			target, err := os.Readlink(filepath.Join(restorer.nodeDataDir, "data", "LATEST"))
+			require.NoError(t, err)
+			assert.Equal(t, "CURRENT", target)
+
+			assert.NoFileExists(t, filepath.Join(restorer.nodeDataDir, snapshotProgressFile))
+
+			hasDatabase, err := hasNodeDatabase(restorer.nodeDataDir)
+			require.NoError(t, err)
+			assert.True(t, hasDatabase)
+		})
+	}
+}
+
+func TestSnapshotRestorer_ChecksumFile(t *testing.T) {
+	archive := buildTestTar(t, tarFile("data/CURRENT", "MANIFEST-000001"))
+
+	restorer := newTestSnapshotRestorer(t, archive, "")
+	require.NoError(t, os.WriteFile(filepath.FromSlash(restorer.url[len("file://"):])+".sha256", []byte(sha256Hex(archive)+"  snapshot.tar\n"), 0644))
+	require.NoError(t, restorer.Restore(context.Background()))
+
+	restorer = newTestSnapshotRestorer(t, archive, "")
+	require.NoError(t, os.WriteFile(filepath.FromSlash(restorer.url[len("file://"):])+".sha256", []byte(sha256Hex(nil)+"  snapshot.tar\n"), 0644))
+	assert.ErrorContains(t, restorer.Restore(context.Background()), "sha256 checksum mismatch")
+}
+
+func TestSnapshotRestorer_EscapingEntries(t *testing.T) {
+	tests := []struct {
+		name        string
+		entries     []testTarEntry
+		expectedErr string
+	}{
+		{"parent dir entry", []testTarEntry{tarFile("../evil", "x")}, "is outside of the node data dir"},
+		{"nested parent dir entry", []testTarEntry{tarFile("data/../../evil", "x")}, "is outside of the node data dir"},
+		{"absolute entry", []testTarEntry{tarFile("/evil", "x")}, "is outside of the node data dir"},
+		{"symlink to parent dir", []testTarEntry{tarSymlink("evil", "..")}, "points outside of the node data dir"},
+		{"nested symlink to parent dir", []testTarEntry{tarDir("data/"), tarSymlink("data/evil", "../../evil")}, "points outside of the node data dir"},
+		{"absolute symlink", []testTarEntry{tarSymlink("evil", "/etc/passwd")}, "points outside of the node data dir"},
+		{"write through symlink", []testTarEntry{tarSymlink("self", "."), tarFile("self/evil", "x")}, `is inside symlink "self"`},
+		{"symlink through symlink", []testTarEntry{tarSymlink("self", "."), tarSymlink("self/evil", "..")}, `is inside symlink "self"`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			restorer := newTestSnapshotRestorer(t, buildTestTar(t, test.entries...), "")
+
+			assert.ErrorContains(t, restorer.Restore(context.Background()), test.expectedErr)
+			assert.NoFileExists(t, filepath.Join(filepath.Dir(restorer.nodeDataDir), "evil"))
+		})
+	}
+}
+
+func TestSnapshotRestorer_Resume(t *testing.T) {
+	archive := buildTestTar(t,
+		tarDir("data/"),
+		tarFile("data/000001.sst", "first"),
+		tarFile("data/000002.sst", "second"),
+	)
+	restorer := newTestSnapshotRestorer(t, archive, sha256Hex(archive))
+
+	// The first two entries were extracted before the interruption, the content of the already
+	// extracted file is altered to check that it's not written again
+	require.NoError(t, os.MkdirAll(filepath.Join(restorer.nodeDataDir, "data"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(restorer.nodeDataDir, "data", "000001.sst"), []byte("already extracted"), 0644))
+	require.NoError(t, restorer.saveProgress(&snapshotProgress{URL: restorer.url, Entries: 2, Created: []string{"data"}}))
+
+	hasDatabase, err := hasNodeDatabase(restorer.nodeDataDir)
+	require.NoError(t, err)
+	assert.False(t, hasDatabase, "an interrupted restoration is not a node database")
+
+	require.NoError(t, restorer.Restore(context.Background()))
+	assert.Equal(t, "already extracted", readTestFile(t, filepath.Join(restorer.nodeDataDir, "data", "000001.sst")))
+	assert.Equal(t, "second", readTestFile(t, filepath.Join(restorer.nodeDataDir, "data", "000002.sst")))
+	assert.NoFileExists(t, filepath.Join(restorer.nodeDataDir, snapshotProgressFile))
+
+	// The restoration of another snapshot is not resumed
+	other := newTestSnapshotRestorer(t, archive, "")
+	require.NoError(t, restorer.saveProgress(&snapshotProgress{URL: restorer.url, Entries: 1}))
+	other.nodeDataDir = restorer.nodeDataDir
+	assert.ErrorContains(t, other.Restore(context.Background()), "interrupted restoration of snapshot")
+}
+
+func TestSnapshotRestorer_ChecksumMismatch(t *testing.T) {
+	archive := buildTestTar(t,
+		tarFile("config.json", `{"from":"snapshot"}`),
+		tarDir("data/"),
+		tarDir("data/db/"),
+		tarFile("data/db/000001.sst", "sst"),
+		tarFile("snapshots/latest", "x"),
+	)
+	restorer := newTestSnapshotRestorer(t, archive, sha256Hex([]byte("another archive")))
+
+	// Files installed by the bootstrapper and an existing dir are kept
+	require.NoError(t, os.WriteFile(filepath.Join(restorer.nodeDataDir, "config.json"), []byte("{}"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(restorer.nodeDataDir, "node_key.json"), []byte("{}"), 0600))
+	require.NoError(t, os.MkdirAll(filepath.Join(restorer.nodeDataDir, "data"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(restorer.nodeDataDir, "data", "LOCK"), nil, 0644))
+
+	err := restorer.Restore(context.Background())
+	assert.ErrorContains(t, err, "sha256 checksum mismatch")
+
+	assert.FileExists(t, filepath.Join(restorer.nodeDataDir, "config.json"))
+	assert.FileExists(t, filepath.Join(restorer.nodeDataDir, "node_key.json"))
+	assert.FileExists(t, filepath.Join(restorer.nodeDataDir, "data", "LOCK"))
+	assert.NoDirExists(t, filepath.Join(restorer.nodeDataDir, "data", "db"))
+	assert.NoDirExists(t, filepath.Join(restorer.nodeDataDir, "snapshots"))
+	assert.NoFileExists(t, filepath.Join(restorer.nodeDataDir, snapshotProgressFile))
+}
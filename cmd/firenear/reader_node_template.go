@@ -0,0 +1,94 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// readerNodePlaceholderRegex matches placeholders and the `{{` and `}}` escapes of literal braces
+var readerNodePlaceholderRegex = regexp.MustCompile(`\{\{|\}\}|\{[^{}]*\}`)
+
+// ordinalRegex extracts the ordinal of StatefulSet pod hostnames, ex: `reader-node-2`
+var ordinalRegex = regexp.MustCompile(`-(\d+)$`)
+
+// readerNodeTemplate expands the placeholders of the reader node file and path flags
+type readerNodeTemplate struct {
+	hostname string
+	nodeRole string
+	dataDir  string
+	// nodeDataDir is empty while the node data dir itself is being expanded
+	nodeDataDir string
+	lookupEnv   func(key string) (string, bool)
+}
+
+const readerNodePlaceholdersDocumentation = "{hostname} (machine hostname), {node-role} (--reader-node-role), {ordinal} (StatefulSet ordinal parsed from the hostname), {env:VAR} (environment variable VAR), {data-dir} and {node-data-dir}, literal braces being escaped as {{ and }}"
+
+// Expand replaces the placeholders of the input, unknown placeholders, unset environment
+// variables and hostnames without ordinal when `{ordinal}` is used being errors. `{{` and `}}`
+// are replaced by a literal brace.
+func (t *readerNodeTemplate) Expand(in string) (string, error) {
+	var errs []string
+	out := readerNodePlaceholderRegex.ReplaceAllStringFunc(in, func(placeholder string) string {
+		if placeholder == "{{" || placeholder == "}}" {
+			return placeholder[:1]
+		}
+
+		value, err := t.resolve(placeholder[1 : len(placeholder)-1])
+		if err != nil {
+			errs = append(errs, err.Error())
+			return placeholder
+		}
+		return value
+	})
+
+	if len(errs) > 0 {
+		return "", fmt.Errorf("expand %q: %s", in, strings.Join(errs, ", "))
+	}
+
+	return out, nil
+}
+
+func (t *readerNodeTemplate) resolve(name string) (string, error) {
+	switch name {
+	case "hostname":
+		return t.hostname, nil
+	case "node-role":
+		return t.nodeRole, nil
+	case "data-dir":
+		return t.dataDir, nil
+	case "node-data-dir":
+		if t.nodeDataDir == "" {
+			return "", fmt.Errorf("placeholder {node-data-dir} cannot be used in the node data dir itself")
+		}
+		return t.nodeDataDir, nil
+	case "ordinal":
+		match := ordinalRegex.FindStringSubmatch(t.hostname)
+		if match == nil {
+			return "", fmt.Errorf("placeholder {ordinal} used but hostname %q has no ordinal suffix", t.hostname)
+		}
+		return match[1], nil
+	}
+
+	if key, found := strings.CutPrefix(name, "env:"); found && key != "" {
+		value, found := t.lookupEnv(key)
+		if !found {
+			return "", fmt.Errorf("placeholder {%s} used but environment variable %q is not set", name, key)
+		}
+		return value, nil
+	}
+
+	return "", fmt.Errorf("unknown placeholder {%s}, valid placeholders are %s", name, readerNodePlaceholdersDocumentation)
+}
+
+// ExpandAll expands each of the inputs
+func (t *readerNodeTemplate) ExpandAll(in []string) (out []string, err error) {
+	for _, element := range in {
+		expanded, err := t.Expand(element)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, expanded)
+	}
+	return out, nil
+}
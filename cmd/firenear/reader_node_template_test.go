@@ -0,0 +1,78 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReaderNodeTemplate_Expand(t *testing.T) {
+	template := &readerNodeTemplate{
+		hostname:    "firehose-reader-2",
+		nodeRole:    "reader",
+		dataDir:     "/data/firehose",
+		nodeDataDir: "/data/firehose/reader/data",
+		lookupEnv: func(key string) (string, bool) {
+			if key == "NETWORK" {
+				return "mainnet", true
+			}
+			return "", false
+		},
+	}
+
+	tests := []struct {
+		name          string
+		in            string
+		expected      string
+		expectedError string
+	}{
+		{"no placeholder", "./config.json", "./config.json", ""},
+		{"hostname", "gs://bucket/{hostname}/node_key.json", "gs://bucket/firehose-reader-2/node_key.json", ""},
+		{"node role", "gs://bucket/{node-role}/config.json", "gs://bucket/reader/config.json", ""},
+		{"ordinal", "gs://bucket/keys/node_key_{ordinal}.json", "gs://bucket/keys/node_key_2.json", ""},
+		{"env", "gs://bucket/{env:NETWORK}/genesis.json", "gs://bucket/mainnet/genesis.json", ""},
+		{"data dirs", "{data-dir}/snapshots,{node-data-dir}/data", "/data/firehose/snapshots,/data/firehose/reader/data/data", ""},
+		{"repeated", "{hostname}/{hostname}", "firehose-reader-2/firehose-reader-2", ""},
+		{"escaped braces", `{{"format": "{{hostname}} {hostname}"}}`, `{"format": "{hostname} firehose-reader-2"}`, ""},
+		{"lone braces", "a } b { c", "a } b { c", ""},
+		{"unknown placeholder", "gs://bucket/{role}/config.json", "", `expand "gs://bucket/{role}/config.json": unknown placeholder {role}, valid placeholders are ` + readerNodePlaceholdersDocumentation},
+		{"unset env", "{env:UNKNOWN}", "", `expand "{env:UNKNOWN}": placeholder {env:UNKNOWN} used but environment variable "UNKNOWN" is not set`},
+		{"empty env", "{env:}", "", `expand "{env:}": unknown placeholder {env:}, valid placeholders are ` + readerNodePlaceholdersDocumentation},
+		{"multiple errors", "{a}/{b}", "", `expand "{a}/{b}": unknown placeholder {a}, valid placeholders are ` + readerNodePlaceholdersDocumentation + `, unknown placeholder {b}, valid placeholders are ` + readerNodePlaceholdersDocumentation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := template.Expand(tt.in)
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, out)
+		})
+	}
+}
+
+func TestReaderNodeTemplate_ExpandNoOrdinal(t *testing.T) {
+	template := &readerNodeTemplate{hostname: "extractor"}
+
+	_, err := template.Expand("{ordinal}")
+	assert.EqualError(t, err, `expand "{ordinal}": placeholder {ordinal} used but hostname "extractor" has no ordinal suffix`)
+
+	_, err = template.Expand("{node-data-dir}/data")
+	assert.EqualError(t, err, `expand "{node-data-dir}/data": placeholder {node-data-dir} cannot be used in the node data dir itself`)
+}
+
+func TestReaderNodeTemplate_ExpandAll(t *testing.T) {
+	template := &readerNodeTemplate{hostname: "reader-0", nodeRole: "reader"}
+
+	out, err := template.ExpandAll([]string{"{hostname}.json", "{node-role}.json"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"reader-0.json", "reader.json"}, out)
+
+	_, err = template.ExpandAll([]string{"{hostname}.json", "{unknown}.json"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,262 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/streamingfast/firehose-near/finality"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+)
+
+// nearRPC is the subset of the NEAR JSON-RPC API used by the tools
+type nearRPC interface {
+	Block(ctx context.Context, height uint64) (*rpcBlock, error)
+	Chunk(ctx context.Context, chunkHash string) (*rpcChunk, error)
+	// AccountChanges calls `EXPERIMENTAL_changes` for the account changes of the block
+	AccountChanges(ctx context.Context, blockHash string, accountIDs []string) ([]*rpcStateChange, error)
+	TxStatus(ctx context.Context, txHash, signerID string) (*rpcTxStatus, error)
+	// Receipt calls `EXPERIMENTAL_receipt`, failing with an `UNKNOWN_RECEIPT` rpcError when the
+	// node does not know the receipt
+	Receipt(ctx context.Context, receiptID string) (*rpcReceipt, error)
+	// ValidatorsOrdered calls `EXPERIMENTAL_validators_ordered` for the ordered block producers of
+	// the epoch of the block
+	ValidatorsOrdered(ctx context.Context, blockHash string) ([]*finality.BlockProducer, error)
+	// NextLightClientBlock calls `next_light_client_block` for the light client block of the
+	// epoch following the epoch of the block
+	NextLightClientBlock(ctx context.Context, lastBlockHash string) (*rpcLightClientBlock, error)
+}
+
+type rpcBlock struct {
+	Author string `json:"author"`
+	Header struct {
+		Height           uint64  `json:"height"`
+		PrevHeight       *uint64 `json:"prev_height"`
+		Hash             string  `json:"hash"`
+		PrevHash         string  `json:"prev_hash"`
+		EpochID          string  `json:"epoch_id"`
+		NextEpochID      string  `json:"next_epoch_id"`
+		LastFinalBlock   string  `json:"last_final_block"`
+		TimestampNanosec string  `json:"timestamp_nanosec"`
+		GasPrice         string  `json:"gas_price"`
+		TotalSupply      string  `json:"total_supply"`
+		ChunksIncluded   uint64  `json:"chunks_included"`
+	} `json:"header"`
+	Chunks []*rpcChunkHeader `json:"chunks"`
+}
+
+type rpcChunkHeader struct {
+	ChunkHash      string `json:"chunk_hash"`
+	ShardID        uint64 `json:"shard_id"`
+	HeightCreated  uint64 `json:"height_created"`
+	HeightIncluded uint64 `json:"height_included"`
+	GasUsed        uint64 `json:"gas_used"`
+	GasLimit       uint64 `json:"gas_limit"`
+	BalanceBurnt   string `json:"balance_burnt"`
+}
+
+type rpcChunk struct {
+	Author       string            `json:"author"`
+	Header       *rpcChunkHeader   `json:"header"`
+	Transactions []*rpcTransaction `json:"transactions"`
+	Receipts     []*rpcReceipt     `json:"receipts"`
+}
+
+type rpcTransaction struct {
+	Hash       string `json:"hash"`
+	SignerID   string `json:"signer_id"`
+	PublicKey  string `json:"public_key"`
+	Nonce      uint64 `json:"nonce"`
+	ReceiverID string `json:"receiver_id"`
+	// Actions are either a string for actions without parameters, ex: `"CreateAccount"`, or an
+	// object with the action kind as single key, ex: `{"Transfer": {"deposit": "1"}}`
+	Actions []json.RawMessage `json:"actions"`
+}
+
+type rpcReceipt struct {
+	PredecessorID string `json:"predecessor_id"`
+	ReceiverID    string `json:"receiver_id"`
+	ReceiptID     string `json:"receipt_id"`
+}
+
+// rpcLightClientBlock is a light client block, empty when the node has none to return
+type rpcLightClientBlock struct {
+	PrevBlockHash string `json:"prev_block_hash"`
+	InnerRestHash string `json:"inner_rest_hash"`
+	InnerLite     struct {
+		Height uint64 `json:"height"`
+	} `json:"inner_lite"`
+}
+
+type rpcStateChange struct {
+	Cause struct {
+		Type        string `json:"type"`
+		TxHash      string `json:"tx_hash"`
+		ReceiptHash string `json:"receipt_hash"`
+	} `json:"cause"`
+	Type   string `json:"type"`
+	Change struct {
+		AccountID    string `json:"account_id"`
+		Amount       string `json:"amount"`
+		Locked       string `json:"locked"`
+		CodeHash     string `json:"code_hash"`
+		StorageUsage uint64 `json:"storage_usage"`
+	} `json:"change"`
+}
+
+type rpcTxStatus struct {
+	TransactionOutcome *rpcOutcomeWithID   `json:"transaction_outcome"`
+	ReceiptsOutcome    []*rpcOutcomeWithID `json:"receipts_outcome"`
+}
+
+type rpcOutcomeWithID struct {
+	ID        string `json:"id"`
+	BlockHash string `json:"block_hash"`
+	Outcome   struct {
+		Logs        []string `json:"logs"`
+		ReceiptIDs  []string `json:"receipt_ids"`
+		GasBurnt    uint64   `json:"gas_burnt"`
+		TokensBurnt string   `json:"tokens_burnt"`
+		ExecutorID  string   `json:"executor_id"`
+		// Status is either the string `"Unknown"` or an object with the status kind as single
+		// key, ex: `{"SuccessValue": ""}`
+		Status json.RawMessage `json:"status"`
+	} `json:"outcome"`
+}
+
+type rpcError struct {
+	Name    string `json:"name"`
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Cause   struct {
+		Name string `json:"name"`
+	} `json:"cause"`
+}
+
+func (e *rpcError) Error() string {
+	if e.Cause.Name != "" {
+		return fmt.Sprintf("rpc error %s: %s", e.Cause.Name, e.Message)
+	}
+	return fmt.Sprintf("rpc error %d: %s", e.Code, e.Message)
+}
+
+// isRPCErrorCause returns true if err is an rpcError with the given cause, ex: `UNKNOWN_RECEIPT`
+func isRPCErrorCause(err error, cause string) bool {
+	var rpcErr *rpcError
+	return errors.As(err, &rpcErr) && rpcErr.Cause.Name == cause
+}
+
+// rpcBlockApproversSource fetches the number of block approvers from a NEAR RPC node
+type rpcBlockApproversSource struct {
+	rpc nearRPC
+}
+
+func (s *rpcBlockApproversSource) BlockApprovers(ctx context.Context, blockHash *pbnear.CryptoHash) (int, error) {
+	producers, err := s.rpc.ValidatorsOrdered(ctx, blockHash.AsBase58String())
+	if err != nil {
+		return 0, err
+	}
+
+	if len(producers) == 0 {
+		return 0, fmt.Errorf("EXPERIMENTAL_validators_ordered returned no block producers for block %s", blockHash.AsBase58String())
+	}
+	return len(producers), nil
+}
+
+// httpNearRPC calls the JSON-RPC API of a NEAR node over HTTP
+type httpNearRPC struct {
+	endpoint string
+	client   *http.Client
+}
+
+func newHTTPNearRPC(endpoint string) *httpNearRPC {
+	return &httpNearRPC{endpoint: endpoint, client: &http.Client{Timeout: 30 * time.Second}}
+}
+
+func (r *httpNearRPC) Block(ctx context.Context, height uint64) (out *rpcBlock, err error) {
+	err = r.call(ctx, "block", map[string]interface{}{"block_id": height}, &out)
+	return
+}
+
+func (r *httpNearRPC) Chunk(ctx context.Context, chunkHash string) (out *rpcChunk, err error) {
+	err = r.call(ctx, "chunk", map[string]interface{}{"chunk_id": chunkHash}, &out)
+	return
+}
+
+func (r *httpNearRPC) AccountChanges(ctx context.Context, blockHash string, accountIDs []string) ([]*rpcStateChange, error) {
+	var out struct {
+		Changes []*rpcStateChange `json:"changes"`
+	}
+
+	params := map[string]interface{}{"block_id": blockHash, "changes_type": "account_changes", "account_ids": accountIDs}
+	if err := r.call(ctx, "EXPERIMENTAL_changes", params, &out); err != nil {
+		return nil, err
+	}
+	return out.Changes, nil
+}
+
+func (r *httpNearRPC) TxStatus(ctx context.Context, txHash, signerID string) (out *rpcTxStatus, err error) {
+	err = r.call(ctx, "tx", []string{txHash, signerID}, &out)
+	return
+}
+
+func (r *httpNearRPC) Receipt(ctx context.Context, receiptID string) (out *rpcReceipt, err error) {
+	err = r.call(ctx, "EXPERIMENTAL_receipt", map[string]interface{}{"receipt_id": receiptID}, &out)
+	return
+}
+
+func (r *httpNearRPC) ValidatorsOrdered(ctx context.Context, blockHash string) (out []*finality.BlockProducer, err error) {
+	err = r.call(ctx, "EXPERIMENTAL_validators_ordered", map[string]interface{}{"block_id": blockHash}, &out)
+	return
+}
+
+func (r *httpNearRPC) NextLightClientBlock(ctx context.Context, lastBlockHash string) (out *rpcLightClientBlock, err error) {
+	err = r.call(ctx, "next_light_client_block", map[string]interface{}{"last_block_hash": lastBlockHash}, &out)
+	return
+}
+
+func (r *httpNearRPC) call(ctx context.Context, method string, params interface{}, out interface{}) error {
+	body, err := json.Marshal(map[string]interface{}{"jsonrpc": "2.0", "id": "firenear", "method": method, "params": params})
+	if err != nil {
+		return fmt.Errorf("marshal %s request: %w", method, err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, r.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("new %s request: %w", method, err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := r.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("call %s: %w", method, err)
+	}
+	defer response.Body.Close()
+
+	content, err := io.ReadAll(response.Body)
+	if err != nil {
+		return fmt.Errorf("read %s response: %w", method, err)
+	}
+
+	var envelope struct {
+		Result json.RawMessage `json:"result"`
+		Error  *rpcError       `json:"error"`
+	}
+	if err := json.Unmarshal(content, &envelope); err != nil {
+		return fmt.Errorf("%s response (HTTP %d) is not a JSON-RPC response: %w", method, response.StatusCode, err)
+	}
+
+	if envelope.Error != nil {
+		return fmt.Errorf("%s: %w", method, envelope.Error)
+	}
+
+	if err := json.Unmarshal(envelope.Result, out); err != nil {
+		return fmt.Errorf("unmarshal %s result: %w", method, err)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRPCBlockApproversSource(t *testing.T) {
+	known := &pbnear.CryptoHash{Bytes: bytes.Repeat([]byte{1}, 32)}
+	empty := &pbnear.CryptoHash{Bytes: bytes.Repeat([]byte{2}, 32)}
+	producer := `{"account_id": "val.near", "public_key": "ed25519:11111111111111111111111111111111", "stake": "1"}`
+
+	endpoint, _ := stubNearRPC(t, map[string]string{
+		`EXPERIMENTAL_validators_ordered {"block_id":"` + known.AsBase58String() + `"}`: `[` + producer + `,` + producer + `]`,
+		`EXPERIMENTAL_validators_ordered {"block_id":"` + empty.AsBase58String() + `"}`: `[]`,
+		`EXPERIMENTAL_validators_ordered`: `error: {"code": -32000, "message": "Server error", "cause": {"name": "UNKNOWN_BLOCK"}}`,
+	})
+	source := &rpcBlockApproversSource{rpc: newHTTPNearRPC(endpoint)}
+
+	approvers, err := source.BlockApprovers(context.Background(), known)
+	require.NoError(t, err)
+	assert.Equal(t, 2, approvers)
+
+	_, err = source.BlockApprovers(context.Background(), empty)
+	assert.ErrorContains(t, err, "returned no block producers")
+
+	_, err = source.BlockApprovers(context.Background(), nil)
+	assert.EqualError(t, err, "EXPERIMENTAL_validators_ordered: rpc error UNKNOWN_BLOCK: Server error")
+
+	// A cancelled context stops the call
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = source.BlockApprovers(ctx, known)
+	assert.ErrorIs(t, err, context.Canceled)
+}
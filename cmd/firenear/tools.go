@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -32,93 +33,206 @@ func encodeBlock(blk firecore.Block) (*pbbstream.Block, error) {
 	return enc, nil
 }
 
-func printBlock(blk firecore.Block, alsoPrintTransactions bool, out io.Writer) error {
-	block := blk.(*pbnear.Block)
+func printBlock(blk firecore.Block, detail printDetail, out io.Writer) error {
+	printed, err := newPrintedBlock(blk.(*pbnear.Block), detail)
+	if err != nil {
+		return err
+	}
 
-	transactionCount := 0
-	for _, shard := range block.Shards {
-		if shard.Chunk != nil {
-			transactionCount += len(shard.Chunk.Transactions)
-		}
+	return writePrintedBlock(printed, out)
+}
+
+func transformFlagsParser(cmd *cobra.Command, logger *zap.Logger) ([]*anypb.Any, error) {
+	receiptFilters, err := receiptAccountFiltersParser(cmd, logger)
+	if err != nil {
+		return nil, err
 	}
 
-	if _, err := fmt.Fprintf(out, "Block #%d (%s) (prev: %s): %d transactions\n",
-		block.Num(),
-		block.ID(),
-		block.PreviousID()[0:7],
-		transactionCount,
-	); err != nil {
-		return err
+	shardFilters, err := shardFilterParser(cmd, logger)
+	if err != nil {
+		return nil, err
 	}
 
-	if alsoPrintTransactions {
-		for _, shard := range block.Shards {
-			if shard.Chunk != nil {
-				if _, err := fmt.Fprintf(out, "- Shard %d\n", shard.ShardId); err != nil {
-					return err
-				}
-
-				for _, trx := range shard.Chunk.Transactions {
-					if _, err := fmt.Fprintf(out, "  - Transaction %s\n", trx.Transaction.Hash.AsBase58String()); err != nil {
-						return err
-					}
-				}
-
-				for _, receipt := range shard.Chunk.Receipts {
-					if _, err := fmt.Fprintf(out, "  - Receipt %s\n", receipt.ReceiptId.AsBase58String()); err != nil {
-						return err
-					}
-				}
-			}
+	return append(receiptFilters, shardFilters...), nil
+}
+
+func shardFilterParser(cmd *cobra.Command, logger *zap.Logger) ([]*anypb.Any, error) {
+	shardIDsIn, err := cmd.Flags().GetString("shard-ids")
+	if err != nil {
+		return nil, fmt.Errorf("unable to get shard-ids flag: %w", err)
+	}
+
+	filterStateChanges, err := cmd.Flags().GetBool("shard-filter-state-changes")
+	if err != nil {
+		return nil, fmt.Errorf("unable to get shard-filter-state-changes flag: %w", err)
+	}
+
+	if shardIDsIn == "" {
+		return nil, nil
+	}
+
+	var shardIDs []uint64
+	for _, unit := range strings.Split(shardIDsIn, ",") {
+		shardID, err := strconv.ParseUint(strings.TrimSpace(unit), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid shard id %q: %w", unit, err)
 		}
+		shardIDs = append(shardIDs, shardID)
+	}
+
+	filter, err := anypb.New(&pbtransform.ShardFilter{
+		ShardIds:           shardIDs,
+		FilterStateChanges: filterStateChanges,
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	return []*anypb.Any{filter}, nil
 }
 
 func receiptAccountFiltersParser(cmd *cobra.Command, logger *zap.Logger) ([]*anypb.Any, error) {
-	filterStrs, err := cmd.Flags().GetStringSlice("receipt-account-filters")
+	filters, err := cmd.Flags().GetString("receipt-account-filters")
 	if err != nil {
 		return nil, fmt.Errorf("unable to get receipt-account-filters flag: %w", err)
 	}
 
-	var filters []*anypb.Any
-	for _, filterStr := range filterStrs {
-		filter, err := parseReceiptAccountFilters(filterStr)
-		if err != nil {
-			return nil, fmt.Errorf("unable to parse receipt account filters: %w", err)
-		}
-		if filter != nil {
-			filters = append(filters, filter)
-		}
+	matchers, err := cmd.Flags().GetString("receipt-account-matchers")
+	if err != nil {
+		return nil, fmt.Errorf("unable to get receipt-account-matchers flag: %w", err)
+	}
+
+	classes, err := cmd.Flags().GetString("receipt-account-classes")
+	if err != nil {
+		return nil, fmt.Errorf("unable to get receipt-account-classes flag: %w", err)
+	}
+
+	unwrapDelegateActions, err := cmd.Flags().GetBool("receipt-unwrap-delegate-actions")
+	if err != nil {
+		return nil, fmt.Errorf("unable to get receipt-unwrap-delegate-actions flag: %w", err)
+	}
+
+	delegateSenders, err := cmd.Flags().GetString("receipt-delegate-senders")
+	if err != nil {
+		return nil, fmt.Errorf("unable to get receipt-delegate-senders flag: %w", err)
+	}
+
+	filter, err := parseReceiptAccountFilters(filters, matchers, classes, unwrapDelegateActions, delegateSenders)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse receipt account filters: %w", err)
 	}
 
-	return filters, nil
+	if filter == nil {
+		return nil, nil
+	}
+
+	return []*anypb.Any{filter}, nil
 }
 
-func parseReceiptAccountFilters(in string) (*anypb.Any, error) {
-	if in == "" {
+func parseReceiptAccountFilters(in string, matchersIn string, classesIn string, unwrapDelegateActions bool, delegateSendersIn string) (*anypb.Any, error) {
+	if in == "" && matchersIn == "" && classesIn == "" && delegateSendersIn == "" {
 		return nil, nil
 	}
 
 	var pairs []*pbtransform.PrefixSuffixPair
 	var accounts []string
 
-	for _, unit := range strings.Split(in, ",") {
-		if parts := strings.Split(unit, ":"); len(parts) == 2 {
-			pairs = append(pairs, &pbtransform.PrefixSuffixPair{
-				Prefix: parts[0],
-				Suffix: parts[1],
-			})
-			continue
+	if in != "" {
+		for _, unit := range strings.Split(in, ",") {
+			if parts := strings.Split(unit, ":"); len(parts) == 2 {
+				pairs = append(pairs, &pbtransform.PrefixSuffixPair{
+					Prefix: parts[0],
+					Suffix: parts[1],
+				})
+				continue
+			}
+			accounts = append(accounts, unit)
 		}
-		accounts = append(accounts, unit)
+	}
+
+	matchers, err := parseReceiptAccountMatchers(matchersIn)
+	if err != nil {
+		return nil, err
+	}
+
+	classes, err := parseReceiptAccountClasses(classesIn)
+	if err != nil {
+		return nil, err
 	}
 
 	filters := &pbtransform.BasicReceiptFilter{
-		Accounts:             accounts,
-		PrefixAndSuffixPairs: pairs,
+		Accounts:               accounts,
+		PrefixAndSuffixPairs:   pairs,
+		AccountMatchers:        matchers,
+		AccountClassPredicates: classes,
+		UnwrapDelegateActions:  unwrapDelegateActions,
+	}
+
+	if delegateSendersIn != "" {
+		filters.DelegateSenders = strings.Split(delegateSendersIn, ",")
 	}
 
 	return anypb.New(filters)
 }
+
+// parseReceiptAccountMatchers parses comma-separated `<kind>=<pattern>` elements where kind is
+// one of `exact`, `subaccount-of`, `direct-child-of` or `glob`.
+func parseReceiptAccountMatchers(in string) (out []*pbtransform.AccountMatcher, err error) {
+	if in == "" {
+		return nil, nil
+	}
+
+	for _, unit := range strings.Split(in, ",") {
+		kind, pattern, found := strings.Cut(unit, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid account matcher %q: expected <kind>=<pattern>", unit)
+		}
+
+		switch kind {
+		case "exact":
+			out = append(out, &pbtransform.AccountMatcher{Matcher: &pbtransform.AccountMatcher_Exact{Exact: pattern}})
+		case "subaccount-of":
+			out = append(out, &pbtransform.AccountMatcher{Matcher: &pbtransform.AccountMatcher_SubaccountOf{SubaccountOf: pattern}})
+		case "direct-child-of":
+			out = append(out, &pbtransform.AccountMatcher{Matcher: &pbtransform.AccountMatcher_DirectChildOf{DirectChildOf: pattern}})
+		case "glob":
+			out = append(out, &pbtransform.AccountMatcher{Matcher: &pbtransform.AccountMatcher_Glob{Glob: pattern}})
+		default:
+			return nil, fmt.Errorf("invalid account matcher %q: unknown kind %q, valid kinds are exact, subaccount-of, direct-child-of and glob", unit, kind)
+		}
+	}
+
+	return out, nil
+}
+
+// parseReceiptAccountClasses parses comma-separated `<role>=<class>` elements where role is one of
+// `receiver`, `signer` or `predecessor` and class is one of `named`, `implicit` or `eth-implicit`.
+func parseReceiptAccountClasses(in string) (out []*pbtransform.AccountClassPredicate, err error) {
+	if in == "" {
+		return nil, nil
+	}
+
+	for _, unit := range strings.Split(in, ",") {
+		roleIn, classIn, found := strings.Cut(unit, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid account class predicate %q: expected <role>=<class>", unit)
+		}
+
+		role, found := pbtransform.AccountClassPredicate_Role_value[strings.ToUpper(roleIn)]
+		if !found {
+			return nil, fmt.Errorf("invalid account class predicate %q: unknown role %q, valid roles are receiver, signer and predecessor", unit, roleIn)
+		}
+
+		class, found := pbtransform.AccountClassPredicate_Class_value[strings.ToUpper(strings.ReplaceAll(classIn, "-", "_"))]
+		if !found {
+			return nil, fmt.Errorf("invalid account class predicate %q: unknown class %q, valid classes are named, implicit and eth-implicit", unit, classIn)
+		}
+
+		out = append(out, &pbtransform.AccountClassPredicate{
+			Role:  pbtransform.AccountClassPredicate_Role(role),
+			Class: pbtransform.AccountClassPredicate_Class(class),
+		})
+	}
+
+	return out, nil
+}
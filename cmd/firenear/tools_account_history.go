@@ -0,0 +1,407 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/cli"
+	"github.com/streamingfast/cli/sflags"
+	"github.com/streamingfast/dstore"
+	firecore "github.com/streamingfast/firehose-core"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+	nearTransform "github.com/streamingfast/firehose-near/transform"
+)
+
+func newToolsAccountHistoryCmd[B firecore.Block](chain *firecore.Chain[B]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-history <account> <start>:<stop>",
+		Short: "Print every transaction, receipt and state change involving an account over a block range",
+		Long: cli.Dedent(`
+			Print every transaction (signed by or sent to the account), receipt (received, sent or signed
+			by the account) and state change (of the account) found in the merged blocks of the range,
+			the stop block being exclusive.
+
+			Every merged blocks file of the range is read: the 'rcptaddr' index built by the 'index-builder'
+			app only records the receivers of receipts, it cannot tell which files hold transactions signed
+			by the account, receipts sent by it or changes of its state (like access key nonces).
+
+			With --received-receipts-only, only the receipts received by the account are printed and merged
+			blocks files are skipped when the index records no receipt received by the account in them, the
+			files without index being all read.
+		`),
+		Args: cobra.ExactArgs(2),
+		RunE: accountHistoryE,
+		Example: firecore.ExamplePrefixed(chain, "tools", `
+			# Print the activity of an account over 10 000 blocks
+			account-history alice.near 100000000:100010000
+
+			# Only the receipts received by the account, as CSV, skipping merged blocks files using the index of remote stores
+			account-history --received-receipts-only --merged-blocks-store-url=gs://bucket/merged-blocks --index-store-url=gs://bucket/index -o csv alice.near 100000000:100010000
+		`),
+	}
+
+	cmd.Flags().String("merged-blocks-store-url", "file://./firehose-data/storage/merged-blocks", "Store URL where merged blocks are read from")
+	cmd.Flags().String("index-store-url", "file://./firehose-data/storage/index", "Store URL where the 'rcptaddr' index files are read from")
+	cmd.Flags().Bool("received-receipts-only", false, "Only print the receipts received by the account, merged blocks files being skipped using the 'rcptaddr' index")
+	cmd.Flags().Bool("no-index", false, "Do not use the 'rcptaddr' index with --received-receipts-only, every merged blocks file of the range is read")
+	cmd.Flags().StringP("output", "o", "text", "Output format, one of 'text', 'jsonl' or 'csv'")
+
+	return cmd
+}
+
+// accountHistoryEntry is a transaction, receipt or state change involving the account
+type accountHistoryEntry struct {
+	BlockNum  uint64    `json:"block_num"`
+	BlockHash string    `json:"block_hash"`
+	Timestamp time.Time `json:"timestamp"`
+	// ShardID is nil for state changes, blocks do not record the shard they come from
+	ShardID *uint64 `json:"shard_id,omitempty"`
+	Kind    string  `json:"kind"`
+	// ID is the transaction hash, the receipt ID or the hash of the state change cause
+	ID string `json:"id,omitempty"`
+	// Role is how the account is involved, one of `signer`, `receiver`, `predecessor` or `account`
+	Role     string         `json:"role"`
+	From     string         `json:"from,omitempty"`
+	To       string         `json:"to,omitempty"`
+	Status   string         `json:"status,omitempty"`
+	Deposit  *printedAmount `json:"deposit,omitempty"`
+	Actions  []string       `json:"actions,omitempty"`
+	Logs     []string       `json:"logs,omitempty"`
+	Change   string         `json:"change,omitempty"`
+	Cause    string         `json:"cause,omitempty"`
+	Balance  *printedAmount `json:"balance,omitempty"`
+	GasBurnt uint64         `json:"gas_burnt,omitempty"`
+}
+
+var accountHistoryCSVHeader = []string{
+	"block_num", "block_hash", "timestamp", "shard_id", "kind", "id", "role", "from", "to", "status",
+	"deposit_yocto", "actions", "logs", "change", "cause", "balance_yocto", "gas_burnt",
+}
+
+func accountHistoryE(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	account := args[0]
+
+	output := sflags.MustGetString(cmd, "output")
+	if err := checkOutputFormat(output, "text", "jsonl", "csv"); err != nil {
+		return err
+	}
+
+	start, stop, err := parseClosedBlockRange(args[1])
+	if err != nil {
+		return err
+	}
+
+	mergedBlocksStore, err := dstore.NewDBinStore(sflags.MustGetString(cmd, "merged-blocks-store-url"))
+	if err != nil {
+		return fmt.Errorf("unable to create merged blocks store: %w", err)
+	}
+
+	receivedReceiptsOnly := sflags.MustGetBool(cmd, "received-receipts-only")
+
+	var indexProvider bundleBlocksProvider
+	if receivedReceiptsOnly && !sflags.MustGetBool(cmd, "no-index") {
+		indexStore, err := dstore.NewStore(sflags.MustGetString(cmd, "index-store-url"), "", "", false)
+		if err != nil {
+			return fmt.Errorf("unable to create index store: %w", err)
+		}
+
+		indexProvider = nearTransform.NewNearBlockIndexProvider(indexStore, nil, map[string]bool{account: true}, nil, nil)
+	}
+
+	writer := newAccountHistoryWriter(cmd.OutOrStdout(), output)
+	stats, err := scanAccountHistory(ctx, mergedBlocksStore, indexProvider, account, receivedReceiptsOnly, start, stop, writer.Write)
+	if err != nil {
+		return err
+	}
+
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.ErrOrStderr(), "Found %d entries, %d merged blocks files read, %d skipped by the index, %d without index\n", stats.entries, stats.bundlesRead, stats.bundlesSkipped, stats.bundlesUnindexed)
+	return nil
+}
+
+// bundleBlocksProvider returns the blocks of a merged blocks file matching the index, an error
+// meaning there is no index covering the file
+type bundleBlocksProvider interface {
+	BlocksInRange(baseBlock, bundleSize uint64) ([]uint64, error)
+}
+
+type accountHistoryStats struct {
+	entries          int
+	bundlesRead      int
+	bundlesSkipped   int
+	bundlesUnindexed int
+}
+
+// scanAccountHistory calls fn for each entry involving the account in the blocks of
+// [start, stop), only the receipts received by the account when receivedReceiptsOnly is set.
+//
+// The index provider is optional and only used when receivedReceiptsOnly is set, merged blocks
+// files without block matching it being skipped, as the `rcptaddr` index records nothing about
+// the other entries.
+func scanAccountHistory(ctx context.Context, store dstore.Store, indexProvider bundleBlocksProvider, account string, receivedReceiptsOnly bool, start, stop uint64, fn func(entry *accountHistoryEntry) error) (*accountHistoryStats, error) {
+	stats := &accountHistoryStats{}
+
+	for base := mergedBlocksBundleBase(start); base < stop; base += mergedBlocksBundleSize {
+		if indexProvider != nil && receivedReceiptsOnly {
+			matching, err := indexProvider.BlocksInRange(base, mergedBlocksBundleSize)
+			if err != nil {
+				stats.bundlesUnindexed++
+			} else if !anyInRange(matching, max(base, start), min(base+mergedBlocksBundleSize, stop)) {
+				stats.bundlesSkipped++
+				continue
+			}
+		}
+
+		stats.bundlesRead++
+		err := readMergedBlocksBundle(ctx, store, base, func(block *pbnear.Block) error {
+			if block.Num() < start || block.Num() >= stop {
+				return nil
+			}
+
+			for _, entry := range accountHistoryEntries(block, account) {
+				if receivedReceiptsOnly && (entry.Kind != "receipt" || entry.Role != "receiver") {
+					continue
+				}
+
+				stats.entries++
+				if err := fn(entry); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, mergedBlocksRangeError(base, err)
+		}
+	}
+
+	return stats, nil
+}
+
+func anyInRange(blockNums []uint64, start, exclusiveStop uint64) bool {
+	for _, blockNum := range blockNums {
+		if blockNum >= start && blockNum < exclusiveStop {
+			return true
+		}
+	}
+	return false
+}
+
+// accountHistoryEntries returns the transactions, receipts and state changes of the block
+// involving the account, in block order.
+func accountHistoryEntries(block *pbnear.Block, account string) (out []*accountHistoryEntry) {
+	newEntry := func(kind, id, role string) *accountHistoryEntry {
+		return &accountHistoryEntry{
+			BlockNum:  block.GetHeader().GetHeight(),
+			BlockHash: block.GetHeader().GetHash().AsBase58String(),
+			Timestamp: time.Unix(0, int64(block.GetHeader().GetTimestampNanosec())).UTC(),
+			Kind:      kind,
+			ID:        id,
+			Role:      role,
+		}
+	}
+
+	fillOutcome := func(entry *accountHistoryEntry, actions []*pbnear.Action, outcome *pbnear.ExecutionOutcome) {
+		entry.Status = "unknown"
+		if outcome != nil {
+			entry.Status = outcomeStatus(outcome)
+		}
+		entry.GasBurnt = outcome.GetGasBurnt()
+		entry.Logs = outcome.GetLogs()
+		entry.Deposit = newPrintedAmount(actionsDeposit(actions))
+		for _, action := range actions {
+			entry.Actions = append(entry.Actions, actionSummary(action))
+		}
+	}
+
+	for _, shard := range block.Shards {
+		shardID := shard.ShardId
+
+		for _, trx := range shard.Chunk.GetTransactions() {
+			signed := trx.GetTransaction()
+			role := accountRole(account, []string{"receiver", signed.GetReceiverId()}, []string{"signer", signed.GetSignerId()})
+			if role == "" {
+				continue
+			}
+
+			entry := newEntry("transaction", signed.GetHash().AsBase58String(), role)
+			entry.ShardID = &shardID
+			entry.From = signed.GetSignerId()
+			entry.To = signed.GetReceiverId()
+			fillOutcome(entry, signed.GetActions(), trx.GetOutcome().GetExecutionOutcome().GetOutcome())
+			out = append(out, entry)
+		}
+
+		for _, outcome := range shard.ReceiptExecutionOutcomes {
+			receipt := outcome.GetReceipt()
+			role := accountRole(account,
+				[]string{"receiver", receipt.GetReceiverId()},
+				[]string{"predecessor", receipt.GetPredecessorId()},
+				[]string{"signer", receipt.GetAction().GetSignerId()},
+			)
+			if role == "" {
+				continue
+			}
+
+			entry := newEntry("receipt", receipt.GetReceiptId().AsBase58String(), role)
+			entry.ShardID = &shardID
+			entry.From = receipt.GetPredecessorId()
+			entry.To = receipt.GetReceiverId()
+			fillOutcome(entry, receipt.GetAction().GetActions(), outcome.GetExecutionOutcome().GetOutcome())
+			out = append(out, entry)
+		}
+	}
+
+	for _, change := range block.StateChanges {
+		printed := newPrintedStateChange(change)
+		if printed.AccountID != account {
+			continue
+		}
+
+		entry := newEntry("state_change", printed.CauseHash, "account")
+		entry.Change = printed.Kind
+		entry.Cause = printed.Cause
+		if update := change.GetValue().GetAccountUpdate(); update != nil {
+			entry.Balance = newPrintedAmount(update.Account.GetAmount())
+		}
+		out = append(out, entry)
+	}
+
+	return
+}
+
+// accountRole returns the role of the first `[role, account]` candidate being the account,
+// empty if none is
+func accountRole(account string, candidates ...[]string) string {
+	for _, candidate := range candidates {
+		if candidate[1] == account {
+			return candidate[0]
+		}
+	}
+	return ""
+}
+
+// actionsDeposit returns the total amount attached to the actions by transfers and function calls
+func actionsDeposit(actions []*pbnear.Action) *pbnear.BigInt {
+	total := new(big.Int)
+	for _, action := range actions {
+		switch a := action.Action.(type) {
+		case *pbnear.Action_Transfer:
+			total.Add(total, a.Transfer.Deposit.AsBigInt())
+		case *pbnear.Action_FunctionCall:
+			total.Add(total, a.FunctionCall.Deposit.AsBigInt())
+		}
+	}
+
+	return &pbnear.BigInt{Bytes: total.Bytes()}
+}
+
+type accountHistoryWriter struct {
+	out       io.Writer
+	format    string
+	csvWriter *csv.Writer
+}
+
+func newAccountHistoryWriter(out io.Writer, format string) *accountHistoryWriter {
+	w := &accountHistoryWriter{out: out, format: format}
+	if format == "csv" {
+		w.csvWriter = csv.NewWriter(out)
+		w.csvWriter.Write(accountHistoryCSVHeader)
+	}
+	return w
+}
+
+func (w *accountHistoryWriter) Write(entry *accountHistoryEntry) error {
+	switch w.format {
+	case "jsonl":
+		content, err := json.Marshal(entry)
+		if err != nil {
+			return fmt.Errorf("marshal entry: %w", err)
+		}
+
+		_, err = fmt.Fprintln(w.out, string(content))
+		return err
+
+	case "csv":
+		shardID := ""
+		if entry.ShardID != nil {
+			shardID = strconv.FormatUint(*entry.ShardID, 10)
+		}
+
+		return w.csvWriter.Write([]string{
+			strconv.FormatUint(entry.BlockNum, 10),
+			entry.BlockHash,
+			entry.Timestamp.Format(time.RFC3339Nano),
+			shardID,
+			entry.Kind,
+			entry.ID,
+			entry.Role,
+			entry.From,
+			entry.To,
+			entry.Status,
+			yoctoAmount(entry.Deposit),
+			strings.Join(entry.Actions, "; "),
+			strings.Join(entry.Logs, "\n"),
+			entry.Change,
+			entry.Cause,
+			yoctoAmount(entry.Balance),
+			strconv.FormatUint(entry.GasBurnt, 10),
+		})
+	}
+
+	var lines []string
+	header := fmt.Sprintf("#%d %s", entry.BlockNum, entry.Timestamp.Format(time.RFC3339))
+	if entry.Kind == "state_change" {
+		line := fmt.Sprintf("%s state change %s caused by %s", header, entry.Change, entry.Cause)
+		if entry.ID != "" {
+			line += " " + entry.ID
+		}
+		if entry.Balance != nil {
+			line += ", balance " + entry.Balance.NEAR + " NEAR"
+		}
+		lines = append(lines, line)
+	} else {
+		lines = append(lines, fmt.Sprintf("%s shard %d %s %s %s -> %s (%s): %s, deposit %s NEAR, gas burnt %d",
+			header, *entry.ShardID, strings.ReplaceAll(entry.Kind, "_", " "), entry.ID, entry.From, entry.To, entry.Role, entry.Status, entry.Deposit.NEAR, entry.GasBurnt,
+		))
+	}
+
+	for _, action := range entry.Actions {
+		lines = append(lines, "  - Action: "+action)
+	}
+
+	for _, log := range entry.Logs {
+		lines = append(lines, "  - Log: "+log)
+	}
+
+	_, err := fmt.Fprintln(w.out, strings.Join(lines, "\n"))
+	return err
+}
+
+func (w *accountHistoryWriter) Flush() error {
+	if w.csvWriter == nil {
+		return nil
+	}
+
+	w.csvWriter.Flush()
+	return w.csvWriter.Error()
+}
+
+func yoctoAmount(amount *printedAmount) string {
+	if amount == nil {
+		return ""
+	}
+	return amount.Yocto
+}
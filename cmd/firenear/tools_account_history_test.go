@@ -0,0 +1,152 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountHistoryEntries(t *testing.T) {
+	transfer := func(yocto int64) *pbnear.Action {
+		return &pbnear.Action{Action: &pbnear.Action_Transfer{Transfer: &pbnear.TransferAction{Deposit: &pbnear.BigInt{Bytes: big.NewInt(yocto).Bytes()}}}}
+	}
+
+	hash := func(b byte) *pbnear.CryptoHash { return &pbnear.CryptoHash{Bytes: []byte{b}} }
+
+	block := &pbnear.Block{
+		Header: &pbnear.BlockHeader{Height: 42, Hash: hash(1)},
+		Shards: []*pbnear.IndexerShard{
+			{
+				ShardId: 3,
+				Chunk: &pbnear.IndexerChunk{Transactions: []*pbnear.IndexerTransactionWithOutcome{
+					{Transaction: &pbnear.SignedTransaction{Hash: hash(2), SignerId: "alice.near", ReceiverId: "bob.near", Actions: []*pbnear.Action{transfer(5), transfer(7)}}},
+					{Transaction: &pbnear.SignedTransaction{Hash: hash(3), SignerId: "carol.near", ReceiverId: "dave.near"}},
+				}},
+				ReceiptExecutionOutcomes: []*pbnear.IndexerExecutionOutcomeWithReceipt{
+					{
+						Receipt: &pbnear.Receipt{ReceiptId: hash(4), PredecessorId: "system", ReceiverId: "alice.near", Receipt: &pbnear.Receipt_Action{Action: &pbnear.ReceiptAction{SignerId: "alice.near"}}},
+						ExecutionOutcome: &pbnear.ExecutionOutcomeWithId{Outcome: &pbnear.ExecutionOutcome{
+							Logs:   []string{"refunded"},
+							Status: &pbnear.ExecutionOutcome_SuccessValue{SuccessValue: &pbnear.SuccessValueExecutionStatus{}},
+						}},
+					},
+					{
+						Receipt: &pbnear.Receipt{ReceiptId: hash(5), PredecessorId: "bob.near", ReceiverId: "dave.near", Receipt: &pbnear.Receipt_Action{Action: &pbnear.ReceiptAction{SignerId: "alice.near"}}},
+					},
+				},
+			},
+		},
+		StateChanges: []*pbnear.StateChangeWithCause{
+			{Value: &pbnear.StateChangeValue{Value: &pbnear.StateChangeValue_AccountUpdate_{AccountUpdate: &pbnear.StateChangeValue_AccountUpdate{
+				AccountId: "alice.near",
+				Account:   &pbnear.Account{Amount: &pbnear.BigInt{Bytes: big.NewInt(100).Bytes()}},
+			}}}},
+			{Value: &pbnear.StateChangeValue{Value: &pbnear.StateChangeValue_AccountUpdate_{AccountUpdate: &pbnear.StateChangeValue_AccountUpdate{AccountId: "bob.near"}}}},
+		},
+	}
+
+	entries := accountHistoryEntries(block, "alice.near")
+
+	var summaries []string
+	for _, entry := range entries {
+		summaries = append(summaries, entry.Kind+" "+entry.Role)
+	}
+	assert.Equal(t, []string{"transaction signer", "receipt receiver", "receipt signer", "state_change account"}, summaries)
+
+	assert.Equal(t, uint64(3), *entries[0].ShardID)
+	assert.Equal(t, "12", entries[0].Deposit.Yocto)
+	assert.Equal(t, "unknown", entries[0].Status)
+	assert.Equal(t, "success", entries[1].Status)
+	assert.Equal(t, []string{"refunded"}, entries[1].Logs)
+	assert.Nil(t, entries[3].ShardID)
+	assert.Equal(t, "account_update", entries[3].Change)
+	assert.Equal(t, "100", entries[3].Balance.Yocto)
+
+	assert.Empty(t, accountHistoryEntries(block, "erin.near"))
+}
+
+func TestAccountHistoryEntries_PartialBlock(t *testing.T) {
+	block := &pbnear.Block{
+		Shards: []*pbnear.IndexerShard{
+			{
+				Chunk:                    &pbnear.IndexerChunk{Transactions: []*pbnear.IndexerTransactionWithOutcome{{}}},
+				ReceiptExecutionOutcomes: []*pbnear.IndexerExecutionOutcomeWithReceipt{{}},
+			},
+		},
+		StateChanges: []*pbnear.StateChangeWithCause{{}},
+	}
+
+	assert.Empty(t, accountHistoryEntries(block, "alice.near"))
+}
+
+// testBundleBlocksProvider returns the matching blocks of each indexed merged blocks file
+type testBundleBlocksProvider map[uint64][]uint64
+
+func (p testBundleBlocksProvider) BlocksInRange(baseBlock, bundleSize uint64) ([]uint64, error) {
+	blocks, found := p[baseBlock]
+	if !found {
+		return nil, fmt.Errorf("no index covering %d", baseBlock)
+	}
+	return blocks, nil
+}
+
+func TestScanAccountHistory(t *testing.T) {
+	hash := func(b byte) *pbnear.CryptoHash { return &pbnear.CryptoHash{Bytes: []byte{b}} }
+
+	receivedReceipt := func(id byte) *pbnear.IndexerShard {
+		return &pbnear.IndexerShard{ReceiptExecutionOutcomes: []*pbnear.IndexerExecutionOutcomeWithReceipt{{
+			Receipt: &pbnear.Receipt{ReceiptId: hash(id), PredecessorId: "bob.near", ReceiverId: "alice.near", Receipt: &pbnear.Receipt_Action{Action: &pbnear.ReceiptAction{SignerId: "bob.near"}}},
+		}}}
+	}
+
+	store := newTestMergedBlocksStore(t,
+		// Only a transaction signed by the account, its receipt being executed in the next file
+		&pbnear.Block{Header: &pbnear.BlockHeader{Height: 99, Hash: hash(9)}, Shards: []*pbnear.IndexerShard{{Chunk: &pbnear.IndexerChunk{Transactions: []*pbnear.IndexerTransactionWithOutcome{
+			{Transaction: &pbnear.SignedTransaction{Hash: hash(1), SignerId: "alice.near", ReceiverId: "bob.near"}},
+		}}}}},
+		&pbnear.Block{Header: &pbnear.BlockHeader{Height: 150, Hash: hash(15)}, Shards: []*pbnear.IndexerShard{receivedReceipt(2)}},
+		// Only a receipt sent by the account and its access key nonce update
+		&pbnear.Block{Header: &pbnear.BlockHeader{Height: 250, Hash: hash(25)},
+			Shards: []*pbnear.IndexerShard{{ReceiptExecutionOutcomes: []*pbnear.IndexerExecutionOutcomeWithReceipt{{
+				Receipt: &pbnear.Receipt{ReceiptId: hash(3), PredecessorId: "alice.near", ReceiverId: "bob.near"},
+			}}}},
+			StateChanges: []*pbnear.StateChangeWithCause{{Value: &pbnear.StateChangeValue{Value: &pbnear.StateChangeValue_AccessKeyUpdate_{AccessKeyUpdate: &pbnear.StateChangeValue_AccessKeyUpdate{AccountId: "alice.near"}}}}},
+		},
+		&pbnear.Block{Header: &pbnear.BlockHeader{Height: 350, Hash: hash(35)}, Shards: []*pbnear.IndexerShard{receivedReceipt(4)}},
+	)
+
+	// The file of block 350 has no index
+	indexProvider := testBundleBlocksProvider{0: nil, 100: {150}, 200: nil}
+
+	scan := func(receivedReceiptsOnly bool, start, stop uint64) (summaries []string, stats *accountHistoryStats, err error) {
+		stats, err = scanAccountHistory(context.Background(), store, indexProvider, "alice.near", receivedReceiptsOnly, start, stop, func(entry *accountHistoryEntry) error {
+			summaries = append(summaries, fmt.Sprintf("#%d %s %s", entry.BlockNum, entry.Kind, entry.Role))
+			return nil
+		})
+		return
+	}
+
+	// The index does not record signers, predecessors nor state changes, every file is read
+	summaries, stats, err := scan(false, 0, 400)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"#99 transaction signer", "#150 receipt receiver", "#250 receipt predecessor", "#250 state_change account", "#350 receipt receiver"}, summaries)
+	assert.Equal(t, &accountHistoryStats{entries: 5, bundlesRead: 4}, stats)
+
+	summaries, stats, err = scan(true, 0, 400)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"#150 receipt receiver", "#350 receipt receiver"}, summaries)
+	assert.Equal(t, &accountHistoryStats{entries: 2, bundlesRead: 2, bundlesSkipped: 2, bundlesUnindexed: 1}, stats)
+
+	// Matching blocks outside of the range do not cause a file to be read
+	_, stats, err = scan(true, 160, 200)
+	require.NoError(t, err)
+	assert.Equal(t, &accountHistoryStats{bundlesSkipped: 1}, stats)
+
+	_, _, err = scan(false, 0, 500)
+	assert.ErrorContains(t, err, "merged blocks file 0000000400 not found")
+}
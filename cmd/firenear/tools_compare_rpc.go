@@ -0,0 +1,645 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/cli"
+	"github.com/streamingfast/cli/sflags"
+	"github.com/streamingfast/dstore"
+	firecore "github.com/streamingfast/firehose-core"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+)
+
+func newToolsCompareRPCCmd[B firecore.Block](chain *firecore.Chain[B]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compare-rpc <start>:<stop>",
+		Short: "Compare the merged blocks of a range with what a NEAR RPC node reports",
+		Long: cli.Dedent(`
+			Compare each merged block of the range, the stop block being exclusive, field by field with
+			what a NEAR RPC node reports and print the differences found:
+
+			- 'block': the header and the chunk headers;
+			- 'chunk': the transactions and receipts of each chunk included in the block;
+			- 'EXPERIMENTAL_changes': the account changes of the accounts updated or deleted in the block;
+			- 'tx' (unless --with-outcomes=false): the outcome of each transaction of the block and of
+			  every receipt executed in the block, the receipt outcomes reported by the 'tx' call of a
+			  transaction being matched with the blocks that follow;
+			- 'EXPERIMENTAL_receipt' (unless --with-outcomes=false): the receipts executed in the block
+			  descending from a transaction before the range, whose outcome cannot be fetched.
+
+			Accounts changed according to the RPC node but without change in the merged block are not
+			detected, nor are receipts executed in the block that descend from a transaction before the
+			range and are missing from the merged block. The RPC node must be an archive node for old
+			blocks. The command fails when differences are found.
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: compareRPCE,
+		Example: firecore.ExamplePrefixed(chain, "tools", `
+			# Compare 100 blocks with the local node
+			compare-rpc 100000000:100000100
+
+			# Compare against a remote archive node, as JSONL
+			compare-rpc --rpc-endpoint=https://archival-rpc.mainnet.near.org -o jsonl 100000000:100000100
+		`),
+	}
+
+	cmd.Flags().String("merged-blocks-store-url", "file://./firehose-data/storage/merged-blocks", "Store URL where merged blocks are read from")
+	cmd.Flags().String("rpc-endpoint", "http://localhost:3030", "NEAR JSON-RPC endpoint the blocks are compared with")
+	cmd.Flags().Bool("with-outcomes", true, "Also compare transaction and receipt outcomes, one 'tx' call per transaction and one 'EXPERIMENTAL_receipt' call per receipt descending from a transaction before the range")
+	cmd.Flags().StringP("output", "o", "text", "Output format, one of 'text' or 'jsonl'")
+
+	return cmd
+}
+
+const (
+	rpcDiffMismatch          = "mismatch"
+	rpcDiffMissingInFirehose = "missing_in_firehose"
+	rpcDiffMissingInRPC      = "missing_in_rpc"
+)
+
+// rpcDiff is a difference between a merged block and the RPC node
+type rpcDiff struct {
+	BlockNum uint64 `json:"block_num"`
+	// Path locates the differing element, ex: `header.gas_price`, `shards[0].transactions[<hash>].nonce`
+	Path     string `json:"path"`
+	Kind     string `json:"kind"`
+	Firehose string `json:"firehose,omitempty"`
+	RPC      string `json:"rpc,omitempty"`
+}
+
+func (d *rpcDiff) String() string {
+	switch d.Kind {
+	case rpcDiffMissingInFirehose:
+		return fmt.Sprintf("#%d %s: missing in Firehose (rpc %s)", d.BlockNum, d.Path, d.RPC)
+	case rpcDiffMissingInRPC:
+		return fmt.Sprintf("#%d %s: missing in RPC (firehose %s)", d.BlockNum, d.Path, d.Firehose)
+	}
+	return fmt.Sprintf("#%d %s: firehose %s, rpc %s", d.BlockNum, d.Path, d.Firehose, d.RPC)
+}
+
+func compareRPCE(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	output := sflags.MustGetString(cmd, "output")
+	if err := checkOutputFormat(output, "text", "jsonl"); err != nil {
+		return err
+	}
+
+	start, stop, err := parseClosedBlockRange(args[0])
+	if err != nil {
+		return err
+	}
+
+	store, err := dstore.NewDBinStore(sflags.MustGetString(cmd, "merged-blocks-store-url"))
+	if err != nil {
+		return fmt.Errorf("unable to create merged blocks store: %w", err)
+	}
+
+	comparer := newRPCComparer(newHTTPNearRPC(sflags.MustGetString(cmd, "rpc-endpoint")), sflags.MustGetBool(cmd, "with-outcomes"))
+
+	blockCount, diffCount := 0, 0
+	err = readMergedBlocksRange(ctx, store, start, stop, func(block *pbnear.Block) error {
+		blockCount++
+
+		diffs, err := comparer.CompareBlock(ctx, block)
+		if err != nil {
+			return fmt.Errorf("compare block #%d: %w", block.Num(), err)
+		}
+
+		diffCount += len(diffs)
+		return printRPCDiffs(cmd.OutOrStdout(), diffs, output)
+	})
+	if err != nil {
+		return err
+	}
+
+	if diffCount > 0 {
+		return fmt.Errorf("found %d differences in %d blocks", diffCount, blockCount)
+	}
+
+	fmt.Fprintf(cmd.ErrOrStderr(), "No difference found in %d blocks\n", blockCount)
+	return nil
+}
+
+func printRPCDiffs(out io.Writer, diffs []*rpcDiff, format string) error {
+	for _, diff := range diffs {
+		line := diff.String()
+		if format == "jsonl" {
+			content, err := json.Marshal(diff)
+			if err != nil {
+				return fmt.Errorf("marshal difference: %w", err)
+			}
+			line = string(content)
+		}
+
+		if _, err := fmt.Fprintln(out, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rpcComparer compares merged blocks with the RPC node, the blocks being compared in order
+type rpcComparer struct {
+	rpc          nearRPC
+	withOutcomes bool
+
+	// rpcReceiptOutcomes are the receipt outcomes reported by the `tx` calls made so far that were
+	// not matched yet with a merged block receipt outcome, by receipt ID
+	rpcReceiptOutcomes map[string]*rpcOutcomeWithID
+	// tracedReceipts are the receipts produced, directly or not, by the transactions compared so
+	// far and not executed yet, whose outcome is reported by their originating transaction `tx` call
+	tracedReceipts map[string]bool
+}
+
+func newRPCComparer(rpc nearRPC, withOutcomes bool) *rpcComparer {
+	return &rpcComparer{
+		rpc:                rpc,
+		withOutcomes:       withOutcomes,
+		rpcReceiptOutcomes: make(map[string]*rpcOutcomeWithID),
+		tracedReceipts:     make(map[string]bool),
+	}
+}
+
+// rpcDiffs accumulates the differences of a block
+type rpcDiffs struct {
+	blockNum uint64
+	diffs    []*rpcDiff
+}
+
+func (d *rpcDiffs) compare(path, firehose, rpc string) {
+	if firehose != rpc {
+		d.diffs = append(d.diffs, &rpcDiff{BlockNum: d.blockNum, Path: path, Kind: rpcDiffMismatch, Firehose: firehose, RPC: rpc})
+	}
+}
+
+func (d *rpcDiffs) missing(path, kind, value string) {
+	diff := &rpcDiff{BlockNum: d.blockNum, Path: path, Kind: kind}
+	if kind == rpcDiffMissingInFirehose {
+		diff.RPC = value
+	} else {
+		diff.Firehose = value
+	}
+	d.diffs = append(d.diffs, diff)
+}
+
+func (c *rpcComparer) CompareBlock(ctx context.Context, block *pbnear.Block) ([]*rpcDiff, error) {
+	d := &rpcDiffs{blockNum: block.Num()}
+
+	rpcBlock, err := c.rpc.Block(ctx, block.Num())
+	if err != nil {
+		return nil, err
+	}
+
+	header, rpcHeader := block.Header, rpcBlock.Header
+	d.compare("author", block.Author, rpcBlock.Author)
+	d.compare("header.hash", header.Hash.AsBase58String(), rpcHeader.Hash)
+	d.compare("header.prev_hash", header.PrevHash.AsBase58String(), rpcHeader.PrevHash)
+	if rpcHeader.PrevHeight != nil {
+		d.compare("header.prev_height", strconv.FormatUint(header.PrevHeight, 10), strconv.FormatUint(*rpcHeader.PrevHeight, 10))
+	}
+	d.compare("header.epoch_id", header.EpochId.AsBase58String(), rpcHeader.EpochID)
+	d.compare("header.next_epoch_id", header.NextEpochId.AsBase58String(), rpcHeader.NextEpochID)
+	d.compare("header.last_final_block", header.LastFinalBlock.AsBase58String(), rpcHeader.LastFinalBlock)
+	d.compare("header.timestamp_nanosec", strconv.FormatUint(header.TimestampNanosec, 10), rpcHeader.TimestampNanosec)
+	d.compare("header.gas_price", header.GasPrice.AsBigInt().String(), rpcHeader.GasPrice)
+	d.compare("header.total_supply", header.TotalSupply.AsBigInt().String(), rpcHeader.TotalSupply)
+	d.compare("header.chunks_included", strconv.FormatUint(header.ChunksIncluded, 10), strconv.FormatUint(rpcHeader.ChunksIncluded, 10))
+
+	chunkHeaders := make(map[uint64]*pbnear.ChunkHeader)
+	for _, chunkHeader := range block.ChunkHeaders {
+		chunkHeaders[chunkHeader.ShardId] = chunkHeader
+	}
+
+	shards := make(map[uint64]*pbnear.IndexerShard)
+	for _, shard := range block.Shards {
+		shards[shard.ShardId] = shard
+	}
+
+	for _, rpcChunkHeader := range rpcBlock.Chunks {
+		path := fmt.Sprintf("chunk_headers[%d]", rpcChunkHeader.ShardID)
+		chunkHeader, found := chunkHeaders[rpcChunkHeader.ShardID]
+		if !found {
+			d.missing(path, rpcDiffMissingInFirehose, rpcChunkHeader.ChunkHash)
+			continue
+		}
+		delete(chunkHeaders, rpcChunkHeader.ShardID)
+
+		d.compare(path+".chunk_hash", base58Hash(chunkHeader.ChunkHash), rpcChunkHeader.ChunkHash)
+		d.compare(path+".height_created", strconv.FormatUint(chunkHeader.HeightCreated, 10), strconv.FormatUint(rpcChunkHeader.HeightCreated, 10))
+		d.compare(path+".height_included", strconv.FormatUint(chunkHeader.HeightIncluded, 10), strconv.FormatUint(rpcChunkHeader.HeightIncluded, 10))
+		d.compare(path+".gas_used", strconv.FormatUint(chunkHeader.GasUsed, 10), strconv.FormatUint(rpcChunkHeader.GasUsed, 10))
+		d.compare(path+".gas_limit", strconv.FormatUint(chunkHeader.GasLimit, 10), strconv.FormatUint(rpcChunkHeader.GasLimit, 10))
+		d.compare(path+".balance_burnt", chunkHeader.BalanceBurnt.AsBigInt().String(), rpcChunkHeader.BalanceBurnt)
+
+		// Only chunks included in the block have their transactions in the block
+		if rpcChunkHeader.HeightIncluded != block.Num() {
+			continue
+		}
+
+		rpcChunk, err := c.rpc.Chunk(ctx, rpcChunkHeader.ChunkHash)
+		if err != nil {
+			return nil, err
+		}
+		shardPath := fmt.Sprintf("shards[%d]", rpcChunkHeader.ShardID)
+		chunk := shards[rpcChunkHeader.ShardID].GetChunk()
+		c.compareTransactions(d, shardPath, chunk.GetTransactions(), rpcChunk.Transactions)
+		c.compareChunkReceipts(d, shardPath, chunk, rpcChunk.Receipts)
+	}
+
+	for _, chunkHeader := range sortedChunkHeaders(chunkHeaders) {
+		d.missing(fmt.Sprintf("chunk_headers[%d]", chunkHeader.ShardId), rpcDiffMissingInRPC, base58Hash(chunkHeader.ChunkHash))
+	}
+
+	if err := c.compareAccountChanges(ctx, d, block); err != nil {
+		return nil, err
+	}
+
+	if c.withOutcomes {
+		if err := c.compareOutcomes(ctx, d, block); err != nil {
+			return nil, err
+		}
+	}
+
+	return d.diffs, nil
+}
+
+func sortedChunkHeaders(in map[uint64]*pbnear.ChunkHeader) (out []*pbnear.ChunkHeader) {
+	for _, chunkHeader := range in {
+		out = append(out, chunkHeader)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ShardId < out[j].ShardId })
+	return
+}
+
+func (c *rpcComparer) compareTransactions(d *rpcDiffs, shardPath string, transactions []*pbnear.IndexerTransactionWithOutcome, rpcTransactions []*rpcTransaction) {
+	byHash := make(map[string]*pbnear.SignedTransaction, len(transactions))
+	for _, trx := range transactions {
+		byHash[trx.Transaction.Hash.AsBase58String()] = trx.Transaction
+	}
+
+	for _, rpcTrx := range rpcTransactions {
+		path := fmt.Sprintf("%s.transactions[%s]", shardPath, rpcTrx.Hash)
+		trx, found := byHash[rpcTrx.Hash]
+		if !found {
+			d.missing(path, rpcDiffMissingInFirehose, rpcTrx.SignerID+" -> "+rpcTrx.ReceiverID)
+			continue
+		}
+		delete(byHash, rpcTrx.Hash)
+
+		d.compare(path+".signer_id", trx.SignerId, rpcTrx.SignerID)
+		d.compare(path+".receiver_id", trx.ReceiverId, rpcTrx.ReceiverID)
+		d.compare(path+".nonce", strconv.FormatUint(trx.Nonce, 10), strconv.FormatUint(rpcTrx.Nonce, 10))
+		d.compare(path+".public_key", trx.PublicKey.AsKeyString(), rpcTrx.PublicKey)
+		d.compare(path+".actions", strconv.Itoa(len(trx.Actions)), strconv.Itoa(len(rpcTrx.Actions)))
+
+		for i, rawAction := range rpcTrx.Actions {
+			if i >= len(trx.Actions) {
+				break
+			}
+
+			kind, deposit := rpcActionKindAndDeposit(rawAction)
+			actionPath := fmt.Sprintf("%s.actions[%d]", path, i)
+			d.compare(actionPath+".kind", normalizedKind(actionKind(trx.Actions[i])), normalizedKind(kind))
+			if deposit != "" {
+				d.compare(actionPath+".deposit", actionsDeposit(trx.Actions[i:i+1]).AsBigInt().String(), deposit)
+			}
+		}
+	}
+
+	for _, trx := range transactions {
+		if hash := trx.Transaction.Hash.AsBase58String(); byHash[hash] != nil {
+			d.missing(fmt.Sprintf("%s.transactions[%s]", shardPath, hash), rpcDiffMissingInRPC, trx.Transaction.SignerId+" -> "+trx.Transaction.ReceiverId)
+		}
+	}
+}
+
+// compareChunkReceipts compares the receipts of a chunk, the merged block also listing the local
+// receipts of the chunk's transactions (signer being the receiver) which the RPC node does not
+func (c *rpcComparer) compareChunkReceipts(d *rpcDiffs, shardPath string, chunk *pbnear.IndexerChunk, rpcReceipts []*rpcReceipt) {
+	byID := make(map[string]*pbnear.Receipt, len(chunk.GetReceipts()))
+	for _, receipt := range chunk.GetReceipts() {
+		byID[receipt.GetReceiptId().AsBase58String()] = receipt
+	}
+
+	for _, rpcReceipt := range rpcReceipts {
+		path := fmt.Sprintf("%s.receipts[%s]", shardPath, rpcReceipt.ReceiptID)
+		receipt, found := byID[rpcReceipt.ReceiptID]
+		if !found {
+			d.missing(path, rpcDiffMissingInFirehose, rpcReceipt.PredecessorID+" -> "+rpcReceipt.ReceiverID)
+			continue
+		}
+		delete(byID, rpcReceipt.ReceiptID)
+
+		d.compare(path+".predecessor_id", receipt.GetPredecessorId(), rpcReceipt.PredecessorID)
+		d.compare(path+".receiver_id", receipt.GetReceiverId(), rpcReceipt.ReceiverID)
+	}
+
+	localReceipts := make(map[string]bool)
+	for _, trx := range chunk.GetTransactions() {
+		for _, receiptID := range trx.GetOutcome().GetExecutionOutcome().GetOutcome().GetReceiptIds() {
+			localReceipts[receiptID.AsBase58String()] = true
+		}
+	}
+
+	for _, receipt := range chunk.GetReceipts() {
+		if id := receipt.GetReceiptId().AsBase58String(); byID[id] != nil && !localReceipts[id] {
+			d.missing(fmt.Sprintf("%s.receipts[%s]", shardPath, id), rpcDiffMissingInRPC, receipt.GetPredecessorId()+" -> "+receipt.GetReceiverId())
+		}
+	}
+}
+
+// rpcActionKindAndDeposit returns the kind of an RPC action and its deposit, empty for actions
+// without deposit
+func rpcActionKindAndDeposit(raw json.RawMessage) (kind, deposit string) {
+	if err := json.Unmarshal(raw, &kind); err == nil {
+		return kind, ""
+	}
+
+	var action map[string]struct {
+		Deposit string `json:"deposit"`
+	}
+	if err := json.Unmarshal(raw, &action); err != nil {
+		return "unknown", ""
+	}
+
+	for kind, params := range action {
+		return kind, params.Deposit
+	}
+	return "unknown", ""
+}
+
+// normalizedKind makes RPC kinds, ex: `AccountDoesNotExist`, comparable to Firehose ones, ex:
+// `account_does_not_exist`
+func normalizedKind(in string) string {
+	return strings.ToLower(strings.ReplaceAll(in, "_", ""))
+}
+
+func (c *rpcComparer) compareAccountChanges(ctx context.Context, d *rpcDiffs, block *pbnear.Block) error {
+	changes := make(map[string][]*pbnear.StateChangeWithCause)
+	var keys, accountIDs []string
+	seenAccounts := make(map[string]bool)
+	for _, change := range block.StateChanges {
+		printed := newPrintedStateChange(change)
+		if printed.Kind != "account_update" && printed.Kind != "account_deletion" {
+			continue
+		}
+
+		key := accountChangeKey(printed.Kind, printed.AccountID, printed.Cause, printed.CauseHash)
+		if _, found := changes[key]; !found {
+			keys = append(keys, key)
+		}
+		changes[key] = append(changes[key], change)
+
+		if !seenAccounts[printed.AccountID] {
+			seenAccounts[printed.AccountID] = true
+			accountIDs = append(accountIDs, printed.AccountID)
+		}
+	}
+
+	if len(accountIDs) == 0 {
+		return nil
+	}
+
+	rpcChanges, err := c.rpc.AccountChanges(ctx, block.Header.Hash.AsBase58String(), accountIDs)
+	if err != nil {
+		return err
+	}
+
+	rpcByKey := make(map[string][]*rpcStateChange)
+	for _, rpcChange := range rpcChanges {
+		key := accountChangeKey(rpcChange.Type, rpcChange.Change.AccountID, rpcChange.Cause.Type, rpcChange.Cause.TxHash+rpcChange.Cause.ReceiptHash)
+		if _, found := changes[key]; !found {
+			if _, found := rpcByKey[key]; !found {
+				keys = append(keys, key)
+			}
+		}
+		rpcByKey[key] = append(rpcByKey[key], rpcChange)
+	}
+
+	// Changes of the same account with the same cause are compared in order
+	for _, key := range keys {
+		firehoseChanges, rpcChanges := changes[key], rpcByKey[key]
+		for i := 0; i < max(len(firehoseChanges), len(rpcChanges)); i++ {
+			path := fmt.Sprintf("state_changes[%s][%d]", key, i)
+			switch {
+			case i >= len(firehoseChanges):
+				d.missing(path, rpcDiffMissingInFirehose, rpcChanges[i].Change.Amount)
+			case i >= len(rpcChanges):
+				d.missing(path, rpcDiffMissingInRPC, firehoseChanges[i].Value.GetAccountUpdate().GetAccount().GetAmount().AsBigInt().String())
+			default:
+				if account := firehoseChanges[i].Value.GetAccountUpdate().GetAccount(); account != nil {
+					rpcAccount := rpcChanges[i].Change
+					d.compare(path+".amount", account.Amount.AsBigInt().String(), rpcAccount.Amount)
+					d.compare(path+".locked", account.Locked.AsBigInt().String(), rpcAccount.Locked)
+					d.compare(path+".code_hash", account.CodeHash.AsBase58String(), rpcAccount.CodeHash)
+					d.compare(path+".storage_usage", strconv.FormatUint(account.StorageUsage, 10), strconv.FormatUint(rpcAccount.StorageUsage, 10))
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func accountChangeKey(kind, accountID, cause, causeHash string) string {
+	key := kind + " " + accountID + " " + cause
+	if causeHash != "" {
+		key += " " + causeHash
+	}
+	return key
+}
+
+// compareOutcomes compares the outcomes of the transactions and receipts executed in the block.
+// The outcome of a receipt is reported by the `tx` call of its originating transaction, which is
+// made when the transaction is compared, so receipts descending from a transaction before the
+// range are only compared with `EXPERIMENTAL_receipt`.
+func (c *rpcComparer) compareOutcomes(ctx context.Context, d *rpcDiffs, block *pbnear.Block) error {
+	for _, shard := range block.Shards {
+		for _, trx := range shard.Chunk.GetTransactions() {
+			hash := trx.GetTransaction().GetHash().AsBase58String()
+			status, err := c.rpc.TxStatus(ctx, hash, trx.GetTransaction().GetSignerId())
+			if err != nil {
+				return err
+			}
+
+			outcome := trx.GetOutcome().GetExecutionOutcome().GetOutcome()
+			if status.TransactionOutcome != nil {
+				compareOutcome(d, fmt.Sprintf("transactions[%s].outcome", hash), outcome, status.TransactionOutcome)
+			}
+
+			for _, rpcOutcome := range status.ReceiptsOutcome {
+				c.rpcReceiptOutcomes[rpcOutcome.ID] = rpcOutcome
+			}
+			for _, receiptID := range outcome.GetReceiptIds() {
+				c.tracedReceipts[receiptID.AsBase58String()] = true
+			}
+		}
+	}
+
+	blockHash := block.GetHeader().GetHash().AsBase58String()
+	for _, shard := range block.Shards {
+		for _, receiptOutcome := range shard.ReceiptExecutionOutcomes {
+			receipt := receiptOutcome.GetReceipt()
+			outcome := receiptOutcome.GetExecutionOutcome().GetOutcome()
+			receiptID := receipt.GetReceiptId().AsBase58String()
+			path := fmt.Sprintf("receipts[%s].outcome", receiptID)
+
+			traced := c.tracedReceipts[receiptID]
+			delete(c.tracedReceipts, receiptID)
+			if traced {
+				for _, producedID := range outcome.GetReceiptIds() {
+					c.tracedReceipts[producedID.AsBase58String()] = true
+				}
+			}
+
+			rpcOutcome, found := c.rpcReceiptOutcomes[receiptID]
+			switch {
+			case found:
+				delete(c.rpcReceiptOutcomes, receiptID)
+				d.compare(path+".block_hash", blockHash, rpcOutcome.BlockHash)
+				compareOutcome(d, path, outcome, rpcOutcome)
+			case traced:
+				// The `tx` call of its originating transaction does not report it
+				d.missing(path, rpcDiffMissingInRPC, outcomeStatusOrUnknown(outcome))
+			default:
+				if err := c.compareReceipt(ctx, d, fmt.Sprintf("receipts[%s]", receiptID), receipt); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	var missing []*rpcOutcomeWithID
+	for receiptID, rpcOutcome := range c.rpcReceiptOutcomes {
+		if rpcOutcome.BlockHash == blockHash {
+			missing = append(missing, rpcOutcome)
+			delete(c.rpcReceiptOutcomes, receiptID)
+		}
+	}
+	sort.Slice(missing, func(i, j int) bool { return missing[i].ID < missing[j].ID })
+
+	for _, rpcOutcome := range missing {
+		d.missing(fmt.Sprintf("receipts[%s].outcome", rpcOutcome.ID), rpcDiffMissingInFirehose, rpcOutcomeStatus(rpcOutcome.Outcome.Status))
+	}
+
+	return nil
+}
+
+// compareReceipt compares a receipt with what `EXPERIMENTAL_receipt` reports
+func (c *rpcComparer) compareReceipt(ctx context.Context, d *rpcDiffs, path string, receipt *pbnear.Receipt) error {
+	rpcReceipt, err := c.rpc.Receipt(ctx, receipt.GetReceiptId().AsBase58String())
+	if isRPCErrorCause(err, "UNKNOWN_RECEIPT") {
+		d.missing(path, rpcDiffMissingInRPC, receipt.GetPredecessorId()+" -> "+receipt.GetReceiverId())
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	d.compare(path+".predecessor_id", receipt.GetPredecessorId(), rpcReceipt.PredecessorID)
+	d.compare(path+".receiver_id", receipt.GetReceiverId(), rpcReceipt.ReceiverID)
+	return nil
+}
+
+func compareOutcome(d *rpcDiffs, path string, outcome *pbnear.ExecutionOutcome, rpcOutcome *rpcOutcomeWithID) {
+	status := outcomeStatusOrUnknown(outcome)
+
+	rpcStatus := rpcOutcomeStatus(rpcOutcome.Outcome.Status)
+	if normalizedStatus(status) != normalizedStatus(rpcStatus) {
+		d.compare(path+".status", status, rpcStatus)
+	}
+
+	d.compare(path+".executor_id", outcome.GetExecutorId(), rpcOutcome.Outcome.ExecutorID)
+	d.compare(path+".gas_burnt", strconv.FormatUint(outcome.GetGasBurnt(), 10), strconv.FormatUint(rpcOutcome.Outcome.GasBurnt, 10))
+	d.compare(path+".tokens_burnt", outcome.GetTokensBurnt().AsBigInt().String(), rpcOutcome.Outcome.TokensBurnt)
+	d.compare(path+".logs", strings.Join(outcome.GetLogs(), "\n"), strings.Join(rpcOutcome.Outcome.Logs, "\n"))
+
+	receiptIDs := make([]string, len(outcome.GetReceiptIds()))
+	for i, receiptID := range outcome.GetReceiptIds() {
+		receiptIDs[i] = receiptID.AsBase58String()
+	}
+	d.compare(path+".receipt_ids", strings.Join(receiptIDs, ","), strings.Join(rpcOutcome.Outcome.ReceiptIDs, ","))
+}
+
+// outcomeStatusOrUnknown is outcomeStatus, a nil outcome being `unknown`
+func outcomeStatusOrUnknown(outcome *pbnear.ExecutionOutcome) string {
+	if outcome == nil {
+		return "unknown"
+	}
+	return outcomeStatus(outcome)
+}
+
+// normalizedStatus normalizes the failure kind of the status, see normalizedKind
+func normalizedStatus(in string) string {
+	if kind, found := strings.CutPrefix(in, "failure: "); found {
+		return "failure: " + normalizedKind(kind)
+	}
+	return in
+}
+
+// rpcOutcomeStatus renders an RPC outcome status like outcomeStatus renders Firehose ones, the
+// failure kind being the RPC one, ex: `failure: AccountDoesNotExist`
+func rpcOutcomeStatus(raw json.RawMessage) string {
+	var status map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &status); err != nil {
+		return "unknown"
+	}
+
+	if _, found := status["SuccessValue"]; found {
+		return "success"
+	}
+
+	if receiptID, found := status["SuccessReceiptId"]; found {
+		var id string
+		_ = json.Unmarshal(receiptID, &id)
+		return "success (receipt " + id + ")"
+	}
+
+	if failure, found := status["Failure"]; found {
+		var errors map[string]json.RawMessage
+		if err := json.Unmarshal(failure, &errors); err != nil {
+			return "failure: unknown"
+		}
+
+		if actionError, found := errors["ActionError"]; found {
+			var parsed struct {
+				Kind json.RawMessage `json:"kind"`
+			}
+			if err := json.Unmarshal(actionError, &parsed); err == nil {
+				return "failure: " + rpcVariantName(parsed.Kind)
+			}
+		}
+
+		if invalidTxError, found := errors["InvalidTxError"]; found {
+			return "failure: " + rpcVariantName(invalidTxError)
+		}
+		return "failure: unknown"
+	}
+
+	return "unknown"
+}
+
+// rpcVariantName returns the name of an RPC enum variant, either a string or an object with the
+// variant name as single key
+func rpcVariantName(raw json.RawMessage) string {
+	var name string
+	if err := json.Unmarshal(raw, &name); err == nil {
+		return name
+	}
+
+	var variant map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &variant); err == nil {
+		for name := range variant {
+			return name
+		}
+	}
+	return "unknown"
+}
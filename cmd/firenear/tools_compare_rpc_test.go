@@ -0,0 +1,264 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubNearRPC serves the recorded result of each method, requests being recorded in calls. A
+// result keyed by `<method> <params>` is served for these exact JSON params over the method one,
+// and a result prefixed by `error: ` is served as the JSON-RPC error.
+func stubNearRPC(t *testing.T, results map[string]string) (endpoint string, calls *[]string) {
+	calls = &[]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		var request struct {
+			Method string          `json:"method"`
+			Params json.RawMessage `json:"params"`
+		}
+		require.NoError(t, json.Unmarshal(body, &request))
+		*calls = append(*calls, request.Method)
+
+		result, found := results[request.Method+" "+string(request.Params)]
+		if !found {
+			result, found = results[request.Method]
+		}
+		if !found {
+			w.Write([]byte(`{"jsonrpc": "2.0", "id": "firenear", "error": {"code": -32601, "message": "Method not found"}}`))
+			return
+		}
+		if rpcErr, found := strings.CutPrefix(result, "error: "); found {
+			w.Write([]byte(`{"jsonrpc": "2.0", "id": "firenear", "error": ` + rpcErr + `}`))
+			return
+		}
+		w.Write([]byte(`{"jsonrpc": "2.0", "id": "firenear", "result": ` + result + `}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server.URL, calls
+}
+
+func TestRPCComparer_CompareBlock(t *testing.T) {
+	hash := func(b byte) *pbnear.CryptoHash {
+		out := make([]byte, 32)
+		out[0] = b
+		return &pbnear.CryptoHash{Bytes: out}
+	}
+	amount := func(yocto int64) *pbnear.BigInt { return &pbnear.BigInt{Bytes: big.NewInt(yocto).Bytes()} }
+
+	block := &pbnear.Block{
+		Author: "val.near",
+		Header: &pbnear.BlockHeader{
+			Height: 100, PrevHeight: 99, Hash: hash(1), PrevHash: hash(2), EpochId: hash(3), NextEpochId: hash(4), LastFinalBlock: hash(2),
+			TimestampNanosec: 1700000000000000000, GasPrice: amount(100), TotalSupply: amount(1000), ChunksIncluded: 1,
+		},
+		ChunkHeaders: []*pbnear.ChunkHeader{
+			{ChunkHash: hash(5).Bytes, ShardId: 0, HeightCreated: 100, HeightIncluded: 100, GasUsed: 10, GasLimit: 1000, BalanceBurnt: amount(0)},
+		},
+		Shards: []*pbnear.IndexerShard{{
+			ShardId: 0,
+			Chunk: &pbnear.IndexerChunk{Transactions: []*pbnear.IndexerTransactionWithOutcome{{
+				Transaction: &pbnear.SignedTransaction{
+					Hash: hash(6), SignerId: "alice.near", ReceiverId: "bob.near", Nonce: 7,
+					PublicKey: &pbnear.PublicKey{Bytes: make([]byte, 32)},
+					Actions:   []*pbnear.Action{{Action: &pbnear.Action_Transfer{Transfer: &pbnear.TransferAction{Deposit: amount(5)}}}},
+				},
+				Outcome: &pbnear.IndexerExecutionOutcomeWithOptionalReceipt{ExecutionOutcome: &pbnear.ExecutionOutcomeWithId{Outcome: &pbnear.ExecutionOutcome{
+					ExecutorId: "alice.near", GasBurnt: 20, TokensBurnt: amount(2), ReceiptIds: []*pbnear.CryptoHash{hash(7)},
+					Status: &pbnear.ExecutionOutcome_SuccessReceiptId{SuccessReceiptId: &pbnear.SuccessReceiptIdExecutionStatus{Id: hash(7)}},
+				}}},
+			}}},
+			ReceiptExecutionOutcomes: []*pbnear.IndexerExecutionOutcomeWithReceipt{{
+				Receipt: &pbnear.Receipt{ReceiptId: hash(7), PredecessorId: "alice.near", ReceiverId: "bob.near"},
+				ExecutionOutcome: &pbnear.ExecutionOutcomeWithId{Outcome: &pbnear.ExecutionOutcome{
+					ExecutorId: "bob.near", GasBurnt: 30, TokensBurnt: amount(3),
+					Status: &pbnear.ExecutionOutcome_Failure{Failure: &pbnear.FailureExecutionStatus{Failure: &pbnear.FailureExecutionStatus_ActionError{ActionError: &pbnear.ActionError{
+						Kind: &pbnear.ActionError_AccountDoesNotExist{AccountDoesNotExist: &pbnear.AccountDoesNotExistErrorKind{}},
+					}}}},
+				}},
+			}},
+		}},
+		StateChanges: []*pbnear.StateChangeWithCause{{
+			Value: &pbnear.StateChangeValue{Value: &pbnear.StateChangeValue_AccountUpdate_{AccountUpdate: &pbnear.StateChangeValue_AccountUpdate{
+				AccountId: "alice.near",
+				Account:   &pbnear.Account{Amount: amount(90), Locked: amount(0), CodeHash: hash(0), StorageUsage: 182},
+			}}},
+			Cause: &pbnear.StateChangeCause{Cause: &pbnear.StateChangeCause_TransactionProcessing_{TransactionProcessing: &pbnear.StateChangeCause_TransactionProcessing{TxHash: hash(6)}}},
+		}},
+	}
+
+	b58 := func(h *pbnear.CryptoHash) string { return h.AsBase58String() }
+	endpoint, calls := stubNearRPC(t, map[string]string{
+		// The gas price differs
+		"block": `{"author": "val.near", "header": {"height": 100, "prev_height": 99, "hash": "` + b58(hash(1)) + `", "prev_hash": "` + b58(hash(2)) + `",
+			"epoch_id": "` + b58(hash(3)) + `", "next_epoch_id": "` + b58(hash(4)) + `", "last_final_block": "` + b58(hash(2)) + `",
+			"timestamp_nanosec": "1700000000000000000", "gas_price": "101", "total_supply": "1000", "chunks_included": 1},
+			"chunks": [{"chunk_hash": "` + b58(hash(5)) + `", "shard_id": 0, "height_created": 100, "height_included": 100, "gas_used": 10, "gas_limit": 1000, "balance_burnt": "0"}]}`,
+		// A transaction is missing from the merged block
+		"chunk": `{"author": "val.near", "transactions": [
+			{"hash": "` + b58(hash(6)) + `", "signer_id": "alice.near", "public_key": "ed25519:11111111111111111111111111111111", "nonce": 7, "receiver_id": "bob.near", "actions": [{"Transfer": {"deposit": "5"}}]},
+			{"hash": "` + b58(hash(8)) + `", "signer_id": "carol.near", "public_key": "ed25519:11111111111111111111111111111111", "nonce": 1, "receiver_id": "bob.near", "actions": ["CreateAccount"]}
+		]}`,
+		// The amount differs
+		"EXPERIMENTAL_changes": `{"block_hash": "` + b58(hash(1)) + `", "changes": [{"cause": {"type": "transaction_processing", "tx_hash": "` + b58(hash(6)) + `"}, "type": "account_update",
+			"change": {"account_id": "alice.near", "amount": "95", "locked": "0", "code_hash": "11111111111111111111111111111111", "storage_usage": 182}}]}`,
+		// The receipt outcome status differs
+		"tx": `{"transaction_outcome": {"id": "` + b58(hash(6)) + `", "block_hash": "` + b58(hash(1)) + `", "outcome": {"logs": [], "receipt_ids": ["` + b58(hash(7)) + `"], "gas_burnt": 20, "tokens_burnt": "2", "executor_id": "alice.near", "status": {"SuccessReceiptId": "` + b58(hash(7)) + `"}}},
+			"receipts_outcome": [{"id": "` + b58(hash(7)) + `", "block_hash": "` + b58(hash(1)) + `", "outcome": {"logs": [], "receipt_ids": [], "gas_burnt": 30, "tokens_burnt": "3", "executor_id": "bob.near", "status": {"Failure": {"ActionError": {"index": 0, "kind": {"ActorNoPermission": {}}}}}}}]}`,
+	})
+
+	comparer := newRPCComparer(newHTTPNearRPC(endpoint), true)
+	diffs, err := comparer.CompareBlock(context.Background(), block)
+	require.NoError(t, err)
+
+	var lines []string
+	for _, diff := range diffs {
+		lines = append(lines, diff.String())
+	}
+
+	assert.Equal(t, []string{
+		"#100 header.gas_price: firehose 100, rpc 101",
+		"#100 shards[0].transactions[" + b58(hash(8)) + "]: missing in Firehose (rpc carol.near -> bob.near)",
+		"#100 state_changes[account_update alice.near transaction_processing " + b58(hash(6)) + "][0].amount: firehose 90, rpc 95",
+		"#100 receipts[" + b58(hash(7)) + "].outcome.status: firehose failure: account_does_not_exist, rpc failure: ActorNoPermission",
+	}, lines)
+	assert.Equal(t, []string{"block", "chunk", "EXPERIMENTAL_changes", "tx"}, *calls)
+}
+
+func TestRPCComparer_ReceiptsFromEarlierBlocks(t *testing.T) {
+	hash := func(b byte) *pbnear.CryptoHash {
+		out := make([]byte, 32)
+		out[0] = b
+		return &pbnear.CryptoHash{Bytes: out}
+	}
+	b58 := func(h *pbnear.CryptoHash) string { return h.AsBase58String() }
+	receiptOutcome := func(id byte, gasBurnt uint64, produced ...*pbnear.CryptoHash) *pbnear.IndexerExecutionOutcomeWithReceipt {
+		return &pbnear.IndexerExecutionOutcomeWithReceipt{
+			Receipt: &pbnear.Receipt{ReceiptId: hash(id), PredecessorId: "alice.near", ReceiverId: "bob.near"},
+			ExecutionOutcome: &pbnear.ExecutionOutcomeWithId{Outcome: &pbnear.ExecutionOutcome{
+				ExecutorId: "bob.near", GasBurnt: gasBurnt, ReceiptIds: produced,
+				Status: &pbnear.ExecutionOutcome_SuccessValue{SuccessValue: &pbnear.SuccessValueExecutionStatus{}},
+			}},
+		}
+	}
+	rpcBlock := func(height uint64, blockHash string, chunks string) string {
+		return fmt.Sprintf(`{"author": "", "header": {"height": %d, "hash": "%s", "prev_hash": "", "epoch_id": "", "next_epoch_id": "",
+			"last_final_block": "", "timestamp_nanosec": "0", "gas_price": "0", "total_supply": "0", "chunks_included": 0}, "chunks": %s}`, height, blockHash, chunks)
+	}
+	rpcOutcome := func(id, blockHash string, gasBurnt uint64, receiptIDs string) string {
+		return fmt.Sprintf(`{"id": "%s", "block_hash": "%s", "outcome": {"logs": [], "receipt_ids": %s, "gas_burnt": %d, "tokens_burnt": "0", "executor_id": "bob.near", "status": {"SuccessValue": ""}}}`, id, blockHash, receiptIDs, gasBurnt)
+	}
+
+	// The transaction of block 100 produces receipts 7 and 13, executed in block 101, receipt 7
+	// producing receipt 14 executed in block 102
+	block100 := &pbnear.Block{
+		Header: &pbnear.BlockHeader{Height: 100, Hash: hash(1)},
+		Shards: []*pbnear.IndexerShard{{
+			Chunk: &pbnear.IndexerChunk{Transactions: []*pbnear.IndexerTransactionWithOutcome{{
+				Transaction: &pbnear.SignedTransaction{Hash: hash(6), SignerId: "alice.near", ReceiverId: "bob.near"},
+				Outcome: &pbnear.IndexerExecutionOutcomeWithOptionalReceipt{ExecutionOutcome: &pbnear.ExecutionOutcomeWithId{Outcome: &pbnear.ExecutionOutcome{
+					ExecutorId: "bob.near", GasBurnt: 20, ReceiptIds: []*pbnear.CryptoHash{hash(7), hash(13)},
+					Status: &pbnear.ExecutionOutcome_SuccessValue{SuccessValue: &pbnear.SuccessValueExecutionStatus{}},
+				}}},
+			}}},
+		}},
+	}
+
+	// Receipts 10 and 11 descend from a transaction before the range
+	block101 := &pbnear.Block{
+		Header:       &pbnear.BlockHeader{Height: 101, Hash: hash(2)},
+		ChunkHeaders: []*pbnear.ChunkHeader{{ChunkHash: hash(20).Bytes, HeightCreated: 101, HeightIncluded: 101, BalanceBurnt: &pbnear.BigInt{}}},
+		Shards: []*pbnear.IndexerShard{{
+			Chunk: &pbnear.IndexerChunk{Receipts: []*pbnear.Receipt{
+				{ReceiptId: hash(7), PredecessorId: "alice.near", ReceiverId: "bob.near"},
+				{ReceiptId: hash(12), PredecessorId: "alice.near", ReceiverId: "bob.near"},
+			}},
+			ReceiptExecutionOutcomes: []*pbnear.IndexerExecutionOutcomeWithReceipt{
+				receiptOutcome(7, 30, hash(14)),
+				receiptOutcome(13, 30),
+				receiptOutcome(10, 30),
+				receiptOutcome(11, 30),
+			},
+		}},
+	}
+
+	block102 := &pbnear.Block{
+		Header: &pbnear.BlockHeader{Height: 102, Hash: hash(3)},
+		Shards: []*pbnear.IndexerShard{{ReceiptExecutionOutcomes: []*pbnear.IndexerExecutionOutcomeWithReceipt{receiptOutcome(14, 40)}}},
+	}
+
+	endpoint, calls := stubNearRPC(t, map[string]string{
+		`block {"block_id":100}`: rpcBlock(100, b58(hash(1)), `[]`),
+		`block {"block_id":101}`: rpcBlock(101, b58(hash(2)), `[{"chunk_hash": "`+b58(hash(20))+`", "shard_id": 0, "height_created": 101, "height_included": 101, "gas_used": 0, "gas_limit": 0, "balance_burnt": "0"}]`),
+		`block {"block_id":102}`: rpcBlock(102, b58(hash(3)), `[]`),
+		// Receipt 12 is not a receipt of the chunk
+		"chunk": `{"author": "", "transactions": [], "receipts": [{"predecessor_id": "alice.near", "receiver_id": "bob.near", "receipt_id": "` + b58(hash(7)) + `"}]}`,
+		// Receipt 7 burnt more gas, receipt 13 is not reported and receipts 9 and 15 are missing
+		// from blocks 101 and 102
+		"tx": `{"transaction_outcome": ` + rpcOutcome(b58(hash(6)), b58(hash(1)), 20, `["`+b58(hash(7))+`", "`+b58(hash(13))+`"]`) + `, "receipts_outcome": [` +
+			rpcOutcome(b58(hash(7)), b58(hash(2)), 35, `["`+b58(hash(14))+`"]`) + `, ` +
+			rpcOutcome(b58(hash(9)), b58(hash(2)), 10, `[]`) + `, ` +
+			rpcOutcome(b58(hash(14)), b58(hash(3)), 40, `[]`) + `, ` +
+			rpcOutcome(b58(hash(15)), b58(hash(3)), 10, `[]`) + `]}`,
+		`EXPERIMENTAL_receipt {"receipt_id":"` + b58(hash(10)) + `"}`: `error: {"code": -32000, "message": "Server error", "cause": {"name": "UNKNOWN_RECEIPT"}}`,
+		`EXPERIMENTAL_receipt {"receipt_id":"` + b58(hash(11)) + `"}`: `{"predecessor_id": "alice.near", "receiver_id": "carol.near", "receipt_id": "` + b58(hash(11)) + `"}`,
+	})
+
+	comparer := newRPCComparer(newHTTPNearRPC(endpoint), true)
+
+	var lines []string
+	for _, block := range []*pbnear.Block{block100, block101, block102} {
+		diffs, err := comparer.CompareBlock(context.Background(), block)
+		require.NoError(t, err)
+
+		for _, diff := range diffs {
+			lines = append(lines, diff.String())
+		}
+	}
+
+	assert.Equal(t, []string{
+		"#101 shards[0].receipts[" + b58(hash(12)) + "]: missing in RPC (firehose alice.near -> bob.near)",
+		"#101 receipts[" + b58(hash(7)) + "].outcome.gas_burnt: firehose 30, rpc 35",
+		"#101 receipts[" + b58(hash(13)) + "].outcome: missing in RPC (firehose success)",
+		"#101 receipts[" + b58(hash(10)) + "]: missing in RPC (firehose alice.near -> bob.near)",
+		"#101 receipts[" + b58(hash(11)) + "].receiver_id: firehose bob.near, rpc carol.near",
+		"#101 receipts[" + b58(hash(9)) + "].outcome: missing in Firehose (rpc success)",
+		"#102 receipts[" + b58(hash(15)) + "].outcome: missing in Firehose (rpc success)",
+	}, lines)
+	assert.Equal(t, []string{"block", "tx", "block", "chunk", "EXPERIMENTAL_receipt", "EXPERIMENTAL_receipt", "block"}, *calls)
+}
+
+func TestRPCOutcomeStatus(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected string
+	}{
+		{`{"SuccessValue": ""}`, "success"},
+		{`{"SuccessReceiptId": "abc"}`, "success (receipt abc)"},
+		{`{"Failure": {"ActionError": {"index": 0, "kind": {"AccountDoesNotExist": {"account_id": "a.near"}}}}}`, "failure: AccountDoesNotExist"},
+		{`{"Failure": {"ActionError": {"index": 0, "kind": "DelegateActionExpired"}}}`, "failure: DelegateActionExpired"},
+		{`{"Failure": {"InvalidTxError": {"InvalidNonce": {"tx_nonce": 1, "ak_nonce": 2}}}}`, "failure: InvalidNonce"},
+		{`"Unknown"`, "unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			assert.Equal(t, tt.expected, rpcOutcomeStatus(json.RawMessage(tt.in)))
+		})
+	}
+
+	assert.Equal(t, normalizedStatus("failure: account_does_not_exist"), normalizedStatus("failure: AccountDoesNotExist"))
+}
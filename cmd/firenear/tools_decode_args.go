@@ -0,0 +1,239 @@
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/cli"
+	"github.com/streamingfast/cli/sflags"
+	"github.com/streamingfast/dstore"
+	firecore "github.com/streamingfast/firehose-core"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+)
+
+func newToolsDecodeArgsCmd[B firecore.Block](chain *firecore.Chain[B]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decode-args <merged_blocks_store> <block_num> <receipt_id|tx_hash>",
+		Short: "Decode the function call args of a receipt or transaction, as JSON or Borsh using contract ABIs",
+		Long: cli.Dedent(`
+			Decode the args of every function call action (including the ones wrapped in NEP-366 delegate
+			actions) of a receipt or transaction of the given block.
+
+			Args are decoded using the near-sdk ABI (as produced by 'cargo near abi') of the called
+			contract when found in --abi-dir, the ABI of contract 'token.near' being read from file
+			'<abi-dir>/token.near.json'. Without ABI, args are shown as JSON when they are valid JSON
+			and as base64 otherwise.
+		`),
+		Args: cobra.ExactArgs(3),
+		RunE: decodeArgsE,
+		Example: firecore.ExamplePrefixed(chain, "tools", `
+			# Decode the args of a receipt using the ABIs found in ./abis
+			decode-args --abi-dir=./abis file://./firehose-data/storage/merged-blocks 100000042 8bKtsYiAwmPw3pgEhbBzMGnBXvhkMeHh3FvmH4Dx4pKb
+		`),
+	}
+
+	cmd.Flags().String("abi-dir", "", "Directory of contract ABIs, one '<account_id>.json' file per contract")
+	cmd.Flags().StringP("output", "o", "text", "Output format, one of 'text' or 'json' (one JSON object per function call)")
+
+	return cmd
+}
+
+type decodedCall struct {
+	ContractID string          `json:"contract_id"`
+	MethodName string          `json:"method_name"`
+	Delegated  bool            `json:"delegated"`
+	Encoding   string          `json:"encoding"`
+	Args       json.RawMessage `json:"args,omitempty"`
+	ArgsBase64 string          `json:"args_base64,omitempty"`
+	Error      string          `json:"error,omitempty"`
+}
+
+func decodeArgsE(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	output := sflags.MustGetString(cmd, "output")
+	if err := checkOutputFormat(output, "text", "json"); err != nil {
+		return err
+	}
+
+	registry := pbnear.NewABIRegistry()
+	if abiDir := sflags.MustGetString(cmd, "abi-dir"); abiDir != "" {
+		var err error
+		if registry, err = loadABIDirectory(abiDir); err != nil {
+			return err
+		}
+	}
+
+	store, err := dstore.NewDBinStore(args[0])
+	if err != nil {
+		return fmt.Errorf("unable to create store at path %q: %w", args[0], err)
+	}
+
+	blockNum, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid block number %q: %w", args[1], err)
+	}
+
+	hash, err := parseCryptoHash(args[2])
+	if err != nil {
+		return err
+	}
+
+	block, err := readMergedBlock(ctx, store, blockNum)
+	if err != nil {
+		return err
+	}
+
+	if block == nil {
+		return fmt.Errorf("block #%d not found in merged blocks", blockNum)
+	}
+
+	receiverID, actions, found := findActionsByHash(block, hash)
+	if !found {
+		return fmt.Errorf("no receipt nor transaction %s found in block #%d", args[2], blockNum)
+	}
+
+	calls := decodeFunctionCalls(registry, receiverID, actions, false)
+	if len(calls) == 0 {
+		return fmt.Errorf("receipt or transaction %s has no function call action", args[2])
+	}
+
+	out := cmd.OutOrStdout()
+	for _, call := range calls {
+		if err := printDecodedCall(out, call, output); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func printDecodedCall(out io.Writer, call *decodedCall, format string) error {
+	if format == "json" {
+		content, err := json.Marshal(call)
+		if err != nil {
+			return fmt.Errorf("marshal decoded call: %w", err)
+		}
+
+		_, err = fmt.Fprintln(out, string(content))
+		return err
+	}
+
+	delegated := ""
+	if call.Delegated {
+		delegated = " (delegated)"
+	}
+
+	lines := []string{fmt.Sprintf("%s on %s%s, %s args", call.MethodName, call.ContractID, delegated, call.Encoding)}
+	switch {
+	case call.Error != "":
+		lines = append(lines, "  error: "+call.Error, "  args (base64): "+call.ArgsBase64)
+	case call.Args != nil:
+		content, err := json.MarshalIndent(call.Args, "  ", "  ")
+		if err != nil {
+			content = call.Args
+		}
+		lines = append(lines, "  "+string(content))
+	case call.ArgsBase64 != "":
+		lines = append(lines, "  args (base64): "+call.ArgsBase64)
+	}
+
+	_, err := fmt.Fprintln(out, strings.Join(lines, "\n"))
+	return err
+}
+
+// loadABIDirectory registers each `<account_id>.json` file of the directory as the ABI of the account
+func loadABIDirectory(dir string) (*pbnear.ABIRegistry, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("read ABI directory: %w", err)
+	}
+
+	registry := pbnear.NewABIRegistry()
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+
+		content, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("read ABI file: %w", err)
+		}
+
+		abi, err := pbnear.ParseContractABI(content)
+		if err != nil {
+			return nil, fmt.Errorf("ABI file %q: %w", entry.Name(), err)
+		}
+
+		registry.Register(strings.TrimSuffix(entry.Name(), ".json"), abi)
+	}
+
+	return registry, nil
+}
+
+// findActionsByHash returns the receiver and actions of the receipt executed in the block, or of
+// the transaction included in it, having the given ID or hash.
+func findActionsByHash(block *pbnear.Block, hash []byte) (receiverID string, actions []*pbnear.Action, found bool) {
+	for _, shard := range block.Shards {
+		for _, outcome := range shard.ReceiptExecutionOutcomes {
+			if bytes.Equal(outcome.Receipt.GetReceiptId().GetBytes(), hash) {
+				return outcome.Receipt.ReceiverId, outcome.Receipt.GetAction().GetActions(), true
+			}
+		}
+
+		for _, receipt := range shard.Chunk.GetReceipts() {
+			if bytes.Equal(receipt.GetReceiptId().GetBytes(), hash) {
+				return receipt.ReceiverId, receipt.GetAction().GetActions(), true
+			}
+		}
+
+		for _, trx := range shard.Chunk.GetTransactions() {
+			if bytes.Equal(trx.Transaction.GetHash().GetBytes(), hash) {
+				return trx.Transaction.ReceiverId, trx.Transaction.Actions, true
+			}
+		}
+	}
+
+	return "", nil, false
+}
+
+func decodeFunctionCalls(registry *pbnear.ABIRegistry, receiverID string, actions []*pbnear.Action, delegated bool) (out []*decodedCall) {
+	for _, action := range actions {
+		if delegate := action.GetDelegate().GetDelegateAction(); delegate != nil {
+			out = append(out, decodeFunctionCalls(registry, delegate.ReceiverId, delegate.Actions, true)...)
+			continue
+		}
+
+		call := action.GetFunctionCall()
+		if call == nil {
+			continue
+		}
+
+		decoded := &decodedCall{ContractID: receiverID, MethodName: call.MethodName, Delegated: delegated}
+		args, err := registry.DecodeFunctionCallArgs(receiverID, call)
+		switch {
+		case err != nil:
+			decoded.Encoding = string(pbnear.ArgsEncodingUnknown)
+			decoded.Error = err.Error()
+			decoded.ArgsBase64 = base64.StdEncoding.EncodeToString(call.Args)
+		case args.Encoding == pbnear.ArgsEncodingUnknown:
+			decoded.Encoding = string(args.Encoding)
+			decoded.ArgsBase64 = base64.StdEncoding.EncodeToString(call.Args)
+		default:
+			decoded.Encoding = string(args.Encoding)
+			decoded.Args = args.JSON
+		}
+
+		out = append(out, decoded)
+	}
+
+	return
+}
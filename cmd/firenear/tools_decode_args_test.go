@@ -0,0 +1,101 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"strings"
+	"testing"
+
+	firecore "github.com/streamingfast/firehose-core"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeArgsCmd(t *testing.T) {
+	receiptID := &pbnear.CryptoHash{Bytes: bytes.Repeat([]byte{1}, 32)}
+
+	var borshArgs []byte
+	borshArgs = binary.LittleEndian.AppendUint32(borshArgs, uint32(len("bob.near")))
+	borshArgs = append(borshArgs, "bob.near"...)
+	borshArgs = append(borshArgs, 0x40, 0x42, 0x0f, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0) // 1_000_000
+
+	functionCall := func(method string, args []byte) *pbnear.Action {
+		return &pbnear.Action{Action: &pbnear.Action_FunctionCall{FunctionCall: &pbnear.FunctionCallAction{MethodName: method, Args: args}}}
+	}
+
+	store := newTestMergedBlocksStore(t, &pbnear.Block{
+		Header: &pbnear.BlockHeader{Height: 42},
+		Shards: []*pbnear.IndexerShard{{ReceiptExecutionOutcomes: []*pbnear.IndexerExecutionOutcomeWithReceipt{{
+			Receipt: &pbnear.Receipt{
+				ReceiptId:  receiptID,
+				ReceiverId: "token.near",
+				Receipt: &pbnear.Receipt_Action{Action: &pbnear.ReceiptAction{Actions: []*pbnear.Action{
+					functionCall("borsh_transfer", borshArgs),
+					functionCall("borsh_transfer", borshArgs[:4]),
+					{Action: &pbnear.Action_Delegate{Delegate: &pbnear.SignedDelegateAction{DelegateAction: &pbnear.DelegateAction{
+						SenderId:   "alice.near",
+						ReceiverId: "other.near",
+						Actions:    []*pbnear.Action{functionCall("set", []byte{0xff})},
+					}}}},
+				}}},
+			},
+		}}}},
+	})
+
+	run := func(output string) (string, error) {
+		cmd := newToolsDecodeArgsCmd(&firecore.Chain[*pbnear.Block]{ShortName: "near", LongName: "NEAR"})
+		buf := &bytes.Buffer{}
+		cmd.SetOut(buf)
+		cmd.SetArgs([]string{"--abi-dir=testdata/abis", "-o", output, store.BaseURL().String(), "42", receiptID.AsBase58String()})
+
+		err := cmd.Execute()
+		return buf.String(), err
+	}
+
+	out, err := run("text")
+	require.NoError(t, err)
+	assert.Equal(t, strings.Join([]string{
+		"borsh_transfer on token.near, borsh args",
+		"  {",
+		`    "receiver_id": "bob.near",`,
+		`    "amount": "1000000"`,
+		"  }",
+		"borsh_transfer on token.near, unknown args",
+		"  error: " + decodeErrorOf(t, "token.near", "borsh_transfer", borshArgs[:4]),
+		"  args (base64): CAAAAA==",
+		"set on other.near (delegated), unknown args",
+		"  args (base64): /w==",
+		"",
+	}, "\n"), out)
+
+	out, err = run("json")
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	require.Len(t, lines, 3)
+	assert.JSONEq(t, `{"contract_id":"token.near","method_name":"borsh_transfer","delegated":false,"encoding":"borsh","args":{"receiver_id":"bob.near","amount":"1000000"}}`, lines[0])
+	assert.JSONEq(t, `{"contract_id":"other.near","method_name":"set","delegated":true,"encoding":"unknown","args_base64":"/w=="}`, lines[2])
+}
+
+func decodeErrorOf(t *testing.T, contractID, method string, args []byte) string {
+	registry, err := loadABIDirectory("testdata/abis")
+	require.NoError(t, err)
+
+	_, err = registry.DecodeFunctionCallArgs(contractID, &pbnear.FunctionCallAction{MethodName: method, Args: args})
+	require.Error(t, err)
+	return err.Error()
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("broken pipe")
+}
+
+func TestPrintDecodedCall_WriteError(t *testing.T) {
+	call := &decodedCall{ContractID: "token.near", MethodName: "set", Encoding: "unknown", ArgsBase64: "/w=="}
+
+	assert.EqualError(t, printDecodedCall(failingWriter{}, call, "text"), "broken pipe")
+	assert.EqualError(t, printDecodedCall(failingWriter{}, call, "json"), "broken pipe")
+}
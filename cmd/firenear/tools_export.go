@@ -0,0 +1,640 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/cli"
+	"github.com/streamingfast/cli/sflags"
+	"github.com/streamingfast/dstore"
+	firecore "github.com/streamingfast/firehose-core"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+	"golang.org/x/sync/errgroup"
+)
+
+func newToolsExportCmd[B firecore.Block](chain *firecore.Chain[B]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export <start>:<stop>",
+		Short: "Export the merged blocks of a range as normalized Parquet or CSV tables",
+		Long: cli.Dedent(`
+			Export the merged blocks of the range, the stop block being exclusive, as the normalized tables
+			blocks, chunks, transactions, receipts, actions, execution_outcomes, logs and state_changes.
+
+			Each table is written in its own folder of --output-store-url, one file per merged blocks file
+			named after its base block, ex: 'transactions/0100000000.parquet'. Rows reference their block,
+			transaction, receipt or outcome by hash (base58, as displayed by NEAR explorers), amounts are
+			decimal yoctoNEAR strings and binary payloads are base64. Actions have one row per action,
+			the actions of delegate actions having their own rows with 'delegate_index' set.
+
+			Merged blocks files are processed in parallel.
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: exportE,
+		Example: firecore.ExamplePrefixed(chain, "tools", `
+			# Export 10 000 blocks as Parquet in ./export
+			export 100000000:100010000
+
+			# Export as CSV to a bucket
+			export --format=csv --output-store-url=gs://bucket/near-export 100000000:100010000
+		`),
+	}
+
+	cmd.Flags().String("merged-blocks-store-url", "file://./firehose-data/storage/merged-blocks", "Store URL where merged blocks are read from")
+	cmd.Flags().String("output-store-url", "file://./export", "Store URL where the tables are written")
+	cmd.Flags().String("format", "parquet", "Tables format, one of 'parquet' or 'csv'")
+	cmd.Flags().Int("workers", 8, "Number of merged blocks files processed in parallel")
+
+	return cmd
+}
+
+type exportBlock struct {
+	BlockHeight           uint64 `parquet:"block_height"`
+	BlockHash             string `parquet:"block_hash"`
+	PrevBlockHash         string `parquet:"prev_block_hash"`
+	PrevHeight            uint64 `parquet:"prev_height"`
+	TimestampNanosec      uint64 `parquet:"timestamp_nanosec"`
+	AuthorID              string `parquet:"author_id"`
+	EpochID               string `parquet:"epoch_id"`
+	NextEpochID           string `parquet:"next_epoch_id"`
+	ChunksIncluded        uint64 `parquet:"chunks_included"`
+	GasPrice              string `parquet:"gas_price"`
+	TotalSupply           string `parquet:"total_supply"`
+	LastFinalBlockHash    string `parquet:"last_final_block_hash"`
+	LastFinalBlockHeight  uint64 `parquet:"last_final_block_height"`
+	LatestProtocolVersion uint32 `parquet:"latest_protocol_version"`
+}
+
+type exportChunk struct {
+	ChunkHash        string `parquet:"chunk_hash"`
+	BlockHash        string `parquet:"block_hash"`
+	BlockHeight      uint64 `parquet:"block_height"`
+	ShardID          uint64 `parquet:"shard_id"`
+	AuthorID         string `parquet:"author_id"`
+	HeightCreated    uint64 `parquet:"height_created"`
+	HeightIncluded   uint64 `parquet:"height_included"`
+	GasUsed          uint64 `parquet:"gas_used"`
+	GasLimit         uint64 `parquet:"gas_limit"`
+	BalanceBurnt     string `parquet:"balance_burnt"`
+	TransactionCount uint64 `parquet:"transaction_count"`
+	ReceiptCount     uint64 `parquet:"receipt_count"`
+}
+
+type exportTransaction struct {
+	TransactionHash        string `parquet:"transaction_hash"`
+	BlockHash              string `parquet:"block_hash"`
+	BlockHeight            uint64 `parquet:"block_height"`
+	ChunkHash              string `parquet:"chunk_hash"`
+	ShardID                uint64 `parquet:"shard_id"`
+	IndexInChunk           uint32 `parquet:"index_in_chunk"`
+	SignerID               string `parquet:"signer_id"`
+	SignerPublicKey        string `parquet:"signer_public_key"`
+	Nonce                  uint64 `parquet:"nonce"`
+	ReceiverID             string `parquet:"receiver_id"`
+	ActionCount            uint32 `parquet:"action_count"`
+	ConvertedIntoReceiptID string `parquet:"converted_into_receipt_id"`
+}
+
+type exportReceipt struct {
+	ReceiptID       string `parquet:"receipt_id"`
+	BlockHash       string `parquet:"block_hash"`
+	BlockHeight     uint64 `parquet:"block_height"`
+	ChunkHash       string `parquet:"chunk_hash"`
+	ShardID         uint64 `parquet:"shard_id"`
+	IndexInShard    uint32 `parquet:"index_in_shard"`
+	PredecessorID   string `parquet:"predecessor_id"`
+	ReceiverID      string `parquet:"receiver_id"`
+	ReceiptKind     string `parquet:"receipt_kind"`
+	SignerID        string `parquet:"signer_id"`
+	SignerPublicKey string `parquet:"signer_public_key"`
+	GasPrice        string `parquet:"gas_price"`
+	ActionCount     uint32 `parquet:"action_count"`
+	DataID          string `parquet:"data_id"`
+	DataBase64      string `parquet:"data_base64"`
+}
+
+type exportAction struct {
+	ParentHash             string  `parquet:"parent_hash"`
+	ParentKind             string  `parquet:"parent_kind"`
+	BlockHash              string  `parquet:"block_hash"`
+	BlockHeight            uint64  `parquet:"block_height"`
+	IndexInParent          uint32  `parquet:"index_in_parent"`
+	DelegateIndex          *uint32 `parquet:"delegate_index,optional"`
+	ActionKind             string  `parquet:"action_kind"`
+	Deposit                string  `parquet:"deposit"`
+	Gas                    uint64  `parquet:"gas"`
+	MethodName             string  `parquet:"method_name"`
+	ArgsBase64             string  `parquet:"args_base64"`
+	ArgsSHA256             string  `parquet:"args_sha256"`
+	ArgsSize               uint64  `parquet:"args_size"`
+	CodeSHA256             string  `parquet:"code_sha256"`
+	CodeSize               uint64  `parquet:"code_size"`
+	Stake                  string  `parquet:"stake"`
+	PublicKey              string  `parquet:"public_key"`
+	AccessKeyNonce         uint64  `parquet:"access_key_nonce"`
+	AccessKeyPermission    string  `parquet:"access_key_permission"`
+	AccessKeyAllowance     string  `parquet:"access_key_allowance"`
+	AccessKeyReceiverID    string  `parquet:"access_key_receiver_id"`
+	AccessKeyMethodNames   string  `parquet:"access_key_method_names"`
+	BeneficiaryID          string  `parquet:"beneficiary_id"`
+	DelegateSenderID       string  `parquet:"delegate_sender_id"`
+	DelegateReceiverID     string  `parquet:"delegate_receiver_id"`
+	DelegateNonce          uint64  `parquet:"delegate_nonce"`
+	DelegateMaxBlockHeight uint64  `parquet:"delegate_max_block_height"`
+}
+
+type exportOutcome struct {
+	OutcomeID          string `parquet:"outcome_id"`
+	OutcomeKind        string `parquet:"outcome_kind"`
+	BlockHash          string `parquet:"block_hash"`
+	BlockHeight        uint64 `parquet:"block_height"`
+	ShardID            uint64 `parquet:"shard_id"`
+	ExecutorID         string `parquet:"executor_id"`
+	Status             string `parquet:"status"`
+	FailureKind        string `parquet:"failure_kind"`
+	SuccessReceiptID   string `parquet:"success_receipt_id"`
+	SuccessValueBase64 string `parquet:"success_value_base64"`
+	GasBurnt           uint64 `parquet:"gas_burnt"`
+	TokensBurnt        string `parquet:"tokens_burnt"`
+	ReceiptIDs         string `parquet:"receipt_ids"`
+	LogCount           uint32 `parquet:"log_count"`
+}
+
+type exportLog struct {
+	OutcomeID      string `parquet:"outcome_id"`
+	BlockHash      string `parquet:"block_hash"`
+	BlockHeight    uint64 `parquet:"block_height"`
+	IndexInOutcome uint32 `parquet:"index_in_outcome"`
+	Log            string `parquet:"log"`
+}
+
+type exportStateChange struct {
+	BlockHash       string `parquet:"block_hash"`
+	BlockHeight     uint64 `parquet:"block_height"`
+	IndexInBlock    uint32 `parquet:"index_in_block"`
+	ChangeKind      string `parquet:"change_kind"`
+	AccountID       string `parquet:"account_id"`
+	Cause           string `parquet:"cause"`
+	CauseHash       string `parquet:"cause_hash"`
+	Amount          string `parquet:"amount"`
+	Locked          string `parquet:"locked"`
+	StorageUsage    uint64 `parquet:"storage_usage"`
+	CodeHash        string `parquet:"code_hash"`
+	PublicKey       string `parquet:"public_key"`
+	DataKeyBase64   string `parquet:"data_key_base64"`
+	DataValueBase64 string `parquet:"data_value_base64"`
+}
+
+// exportTables are the rows of the tables for a set of blocks
+type exportTables struct {
+	blocks       []exportBlock
+	chunks       []exportChunk
+	transactions []exportTransaction
+	receipts     []exportReceipt
+	actions      []exportAction
+	outcomes     []exportOutcome
+	logs         []exportLog
+	stateChanges []exportStateChange
+}
+
+func (t *exportTables) addBlock(block *pbnear.Block) {
+	header := block.Header
+	blockHash := header.Hash.AsBase58String()
+	height := header.Height
+
+	t.blocks = append(t.blocks, exportBlock{
+		BlockHeight:           height,
+		BlockHash:             blockHash,
+		PrevBlockHash:         header.PrevHash.AsBase58String(),
+		PrevHeight:            header.PrevHeight,
+		TimestampNanosec:      header.TimestampNanosec,
+		AuthorID:              block.Author,
+		EpochID:               header.EpochId.AsBase58String(),
+		NextEpochID:           header.NextEpochId.AsBase58String(),
+		ChunksIncluded:        header.ChunksIncluded,
+		GasPrice:              header.GasPrice.AsBigInt().String(),
+		TotalSupply:           header.TotalSupply.AsBigInt().String(),
+		LastFinalBlockHash:    header.LastFinalBlock.AsBase58String(),
+		LastFinalBlockHeight:  header.LastFinalBlockHeight,
+		LatestProtocolVersion: header.LatestProtocolVersion,
+	})
+
+	for _, shard := range block.Shards {
+		chunkHash := ""
+		if shard.Chunk != nil {
+			chunkHeader := shardChunkHeader(block, shard)
+			chunkHash = base58Hash(chunkHeader.GetChunkHash())
+
+			t.chunks = append(t.chunks, exportChunk{
+				ChunkHash:        chunkHash,
+				BlockHash:        blockHash,
+				BlockHeight:      height,
+				ShardID:          shard.ShardId,
+				AuthorID:         shard.Chunk.Author,
+				HeightCreated:    chunkHeader.GetHeightCreated(),
+				HeightIncluded:   chunkHeader.GetHeightIncluded(),
+				GasUsed:          chunkHeader.GetGasUsed(),
+				GasLimit:         chunkHeader.GetGasLimit(),
+				BalanceBurnt:     chunkHeader.GetBalanceBurnt().AsBigInt().String(),
+				TransactionCount: uint64(len(shard.Chunk.Transactions)),
+				ReceiptCount:     uint64(len(shard.ReceiptExecutionOutcomes)),
+			})
+		}
+
+		for i, trx := range shard.Chunk.GetTransactions() {
+			signed := trx.Transaction
+			hash := signed.Hash.AsBase58String()
+			outcome := trx.Outcome.GetExecutionOutcome().GetOutcome()
+
+			row := exportTransaction{
+				TransactionHash: hash,
+				BlockHash:       blockHash,
+				BlockHeight:     height,
+				ChunkHash:       chunkHash,
+				ShardID:         shard.ShardId,
+				IndexInChunk:    uint32(i),
+				SignerID:        signed.SignerId,
+				SignerPublicKey: exportPublicKey(signed.PublicKey),
+				Nonce:           signed.Nonce,
+				ReceiverID:      signed.ReceiverId,
+				ActionCount:     uint32(len(signed.Actions)),
+			}
+			if receiptIDs := outcome.GetReceiptIds(); len(receiptIDs) > 0 {
+				row.ConvertedIntoReceiptID = receiptIDs[0].AsBase58String()
+			}
+			t.transactions = append(t.transactions, row)
+
+			t.addActions(hash, "transaction", blockHash, height, signed.Actions)
+			t.addOutcome(hash, "transaction", blockHash, height, shard.ShardId, outcome)
+		}
+
+		for i, receiptOutcome := range shard.ReceiptExecutionOutcomes {
+			receipt := receiptOutcome.Receipt
+			receiptID := receipt.ReceiptId.AsBase58String()
+
+			row := exportReceipt{
+				ReceiptID:     receiptID,
+				BlockHash:     blockHash,
+				BlockHeight:   height,
+				ChunkHash:     chunkHash,
+				ShardID:       shard.ShardId,
+				IndexInShard:  uint32(i),
+				PredecessorID: receipt.PredecessorId,
+				ReceiverID:    receipt.ReceiverId,
+			}
+
+			switch r := receipt.Receipt.(type) {
+			case *pbnear.Receipt_Action:
+				row.ReceiptKind = "action"
+				row.SignerID = r.Action.SignerId
+				row.SignerPublicKey = exportPublicKey(r.Action.SignerPublicKey)
+				row.GasPrice = r.Action.GasPrice.AsBigInt().String()
+				row.ActionCount = uint32(len(r.Action.Actions))
+				t.addActions(receiptID, "receipt", blockHash, height, r.Action.Actions)
+			case *pbnear.Receipt_Data:
+				row.ReceiptKind = "data"
+				row.DataID = r.Data.DataId.AsBase58String()
+				row.DataBase64 = base64.StdEncoding.EncodeToString(r.Data.Data)
+			}
+			t.receipts = append(t.receipts, row)
+
+			t.addOutcome(receiptID, "receipt", blockHash, height, shard.ShardId, receiptOutcome.ExecutionOutcome.GetOutcome())
+		}
+	}
+
+	for i, change := range block.StateChanges {
+		printed := newPrintedStateChange(change)
+		row := exportStateChange{
+			BlockHash:    blockHash,
+			BlockHeight:  height,
+			IndexInBlock: uint32(i),
+			ChangeKind:   printed.Kind,
+			AccountID:    printed.AccountID,
+			Cause:        printed.Cause,
+			CauseHash:    printed.CauseHash,
+		}
+
+		switch value := change.Value.GetValue().(type) {
+		case *pbnear.StateChangeValue_AccountUpdate_:
+			account := value.AccountUpdate.Account
+			row.Amount = account.GetAmount().AsBigInt().String()
+			row.Locked = account.GetLocked().AsBigInt().String()
+			row.StorageUsage = account.GetStorageUsage()
+			row.CodeHash = account.GetCodeHash().AsBase58String()
+		case *pbnear.StateChangeValue_AccessKeyUpdate_:
+			row.PublicKey = exportPublicKey(value.AccessKeyUpdate.PublicKey)
+		case *pbnear.StateChangeValue_AccessKeyDeletion_:
+			row.PublicKey = exportPublicKey(value.AccessKeyDeletion.PublicKey)
+		case *pbnear.StateChangeValue_DataUpdate_:
+			row.DataKeyBase64 = base64.StdEncoding.EncodeToString(value.DataUpdate.Key)
+			row.DataValueBase64 = base64.StdEncoding.EncodeToString(value.DataUpdate.Value)
+		case *pbnear.StateChangeValue_DataDeletion_:
+			row.DataKeyBase64 = base64.StdEncoding.EncodeToString(value.DataDeletion.Key)
+		case *pbnear.StateChangeValue_ContractCodeUpdate_:
+			row.CodeHash = codeSHA256(value.ContractCodeUpdate.Code, value.ContractCodeUpdate.CodeHash)
+		}
+		t.stateChanges = append(t.stateChanges, row)
+	}
+}
+
+func (t *exportTables) addActions(parentHash, parentKind, blockHash string, height uint64, actions []*pbnear.Action) {
+	for i, action := range actions {
+		row := newExportAction(action)
+		row.ParentHash, row.ParentKind, row.BlockHash, row.BlockHeight, row.IndexInParent = parentHash, parentKind, blockHash, height, uint32(i)
+		t.actions = append(t.actions, row)
+
+		for j, inner := range action.GetDelegate().GetDelegateAction().GetActions() {
+			delegateIndex := uint32(j)
+			innerRow := newExportAction(inner)
+			innerRow.ParentHash, innerRow.ParentKind, innerRow.BlockHash, innerRow.BlockHeight, innerRow.IndexInParent = parentHash, parentKind, blockHash, height, uint32(i)
+			innerRow.DelegateIndex = &delegateIndex
+			t.actions = append(t.actions, innerRow)
+		}
+	}
+}
+
+func newExportAction(action *pbnear.Action) exportAction {
+	row := exportAction{ActionKind: actionKind(action)}
+
+	switch a := action.Action.(type) {
+	case *pbnear.Action_DeployContract:
+		row.CodeSHA256 = codeSHA256(a.DeployContract.Code, a.DeployContract.CodeHash)
+		row.CodeSize = a.DeployContract.CodeSize
+		if len(a.DeployContract.Code) > 0 {
+			row.CodeSize = uint64(len(a.DeployContract.Code))
+		}
+	case *pbnear.Action_FunctionCall:
+		call := a.FunctionCall
+		row.MethodName = call.MethodName
+		row.Gas = call.Gas
+		row.Deposit = call.Deposit.AsBigInt().String()
+		row.ArgsBase64 = base64.StdEncoding.EncodeToString(call.Args)
+		row.ArgsSHA256 = codeSHA256(call.Args, call.ArgsHash)
+		row.ArgsSize = call.ArgsSize
+		if len(call.Args) > 0 {
+			row.ArgsSize = uint64(len(call.Args))
+		}
+	case *pbnear.Action_Transfer:
+		row.Deposit = a.Transfer.Deposit.AsBigInt().String()
+	case *pbnear.Action_Stake:
+		row.Stake = a.Stake.Stake.AsBigInt().String()
+		row.PublicKey = exportPublicKey(a.Stake.PublicKey)
+	case *pbnear.Action_AddKey:
+		row.PublicKey = exportPublicKey(a.AddKey.PublicKey)
+		row.AccessKeyNonce = a.AddKey.AccessKey.GetNonce()
+		row.AccessKeyPermission = "full_access"
+		if permission := a.AddKey.AccessKey.GetPermission().GetFunctionCall(); permission != nil {
+			row.AccessKeyPermission = "function_call"
+			row.AccessKeyReceiverID = permission.ReceiverId
+			row.AccessKeyMethodNames = strings.Join(permission.MethodNames, ",")
+			if permission.Allowance != nil {
+				row.AccessKeyAllowance = permission.Allowance.AsBigInt().String()
+			}
+		}
+	case *pbnear.Action_DeleteKey:
+		row.PublicKey = exportPublicKey(a.DeleteKey.PublicKey)
+	case *pbnear.Action_DeleteAccount:
+		row.BeneficiaryID = a.DeleteAccount.BeneficiaryId
+	case *pbnear.Action_Delegate:
+		delegate := a.Delegate.GetDelegateAction()
+		row.DelegateSenderID = delegate.GetSenderId()
+		row.DelegateReceiverID = delegate.GetReceiverId()
+		row.DelegateNonce = delegate.GetNonce()
+		row.DelegateMaxBlockHeight = delegate.GetMaxBlockHeight()
+		row.PublicKey = exportPublicKey(delegate.GetPublicKey())
+	}
+
+	return row
+}
+
+// codeSHA256 returns the hex sha256 of the payload, the one of the stripped payload when it has
+// been stripped by the StripPayloads transform
+func codeSHA256(payload []byte, strippedHash *pbnear.CryptoHash) string {
+	if len(payload) == 0 {
+		if strippedHash != nil {
+			return hex.EncodeToString(strippedHash.Bytes)
+		}
+		return ""
+	}
+
+	checksum := sha256.Sum256(payload)
+	return hex.EncodeToString(checksum[:])
+}
+
+// exportPublicKey returns the key string of the public key, the empty string if it is absent
+func exportPublicKey(key *pbnear.PublicKey) string {
+	if key == nil {
+		return ""
+	}
+	return key.AsKeyString()
+}
+
+func (t *exportTables) addOutcome(id, kind, blockHash string, height, shardID uint64, outcome *pbnear.ExecutionOutcome) {
+	if outcome == nil {
+		return
+	}
+
+	row := exportOutcome{
+		OutcomeID:   id,
+		OutcomeKind: kind,
+		BlockHash:   blockHash,
+		BlockHeight: height,
+		ShardID:     shardID,
+		ExecutorID:  outcome.ExecutorId,
+		Status:      "unknown",
+		GasBurnt:    outcome.GasBurnt,
+		TokensBurnt: outcome.TokensBurnt.AsBigInt().String(),
+		LogCount:    uint32(len(outcome.Logs)),
+	}
+
+	switch status := outcome.Status.(type) {
+	case *pbnear.ExecutionOutcome_SuccessValue:
+		row.Status = "success_value"
+		row.SuccessValueBase64 = base64.StdEncoding.EncodeToString(status.SuccessValue.GetValue())
+	case *pbnear.ExecutionOutcome_SuccessReceiptId:
+		row.Status = "success_receipt_id"
+		row.SuccessReceiptID = status.SuccessReceiptId.GetId().AsBase58String()
+	case *pbnear.ExecutionOutcome_Failure:
+		row.Status = "failure"
+		row.FailureKind = failureKind(status.Failure)
+	}
+
+	receiptIDs := make([]string, len(outcome.ReceiptIds))
+	for i, receiptID := range outcome.ReceiptIds {
+		receiptIDs[i] = receiptID.AsBase58String()
+	}
+	row.ReceiptIDs = strings.Join(receiptIDs, ",")
+	t.outcomes = append(t.outcomes, row)
+
+	for i, log := range outcome.Logs {
+		t.logs = append(t.logs, exportLog{OutcomeID: id, BlockHash: blockHash, BlockHeight: height, IndexInOutcome: uint32(i), Log: log})
+	}
+}
+
+// write writes each table of the tables to its `<table>/<base>.<format>` file of the store
+func (t *exportTables) write(ctx context.Context, store dstore.Store, format string, base uint64) error {
+	tables := []struct {
+		name   string
+		encode func(format string) ([]byte, error)
+	}{
+		{"blocks", func(format string) ([]byte, error) { return encodeExportTable(format, t.blocks) }},
+		{"chunks", func(format string) ([]byte, error) { return encodeExportTable(format, t.chunks) }},
+		{"transactions", func(format string) ([]byte, error) { return encodeExportTable(format, t.transactions) }},
+		{"receipts", func(format string) ([]byte, error) { return encodeExportTable(format, t.receipts) }},
+		{"actions", func(format string) ([]byte, error) { return encodeExportTable(format, t.actions) }},
+		{"execution_outcomes", func(format string) ([]byte, error) { return encodeExportTable(format, t.outcomes) }},
+		{"logs", func(format string) ([]byte, error) { return encodeExportTable(format, t.logs) }},
+		{"state_changes", func(format string) ([]byte, error) { return encodeExportTable(format, t.stateChanges) }},
+	}
+
+	for _, table := range tables {
+		content, err := table.encode(format)
+		if err != nil {
+			return fmt.Errorf("encode table %s: %w", table.name, err)
+		}
+
+		filename := fmt.Sprintf("%s/%010d.%s", table.name, base, format)
+		if err := store.WriteObject(ctx, filename, bytes.NewReader(content)); err != nil {
+			return fmt.Errorf("write %q: %w", filename, err)
+		}
+	}
+
+	return nil
+}
+
+// encodeExportTable encodes the rows as Parquet or CSV, the CSV columns being the Parquet ones
+func encodeExportTable[T any](format string, rows []T) ([]byte, error) {
+	buffer := &bytes.Buffer{}
+
+	if format == "parquet" {
+		writer := parquet.NewGenericWriter[T](buffer)
+		if _, err := writer.Write(rows); err != nil {
+			return nil, err
+		}
+		if err := writer.Close(); err != nil {
+			return nil, err
+		}
+		return buffer.Bytes(), nil
+	}
+
+	writer := csv.NewWriter(buffer)
+	rowType := reflect.TypeOf((*T)(nil)).Elem()
+
+	header := make([]string, rowType.NumField())
+	for i := range header {
+		header[i], _, _ = strings.Cut(rowType.Field(i).Tag.Get("parquet"), ",")
+	}
+	if err := writer.Write(header); err != nil {
+		return nil, err
+	}
+
+	record := make([]string, len(header))
+	for _, row := range rows {
+		value := reflect.ValueOf(row)
+		for i := range record {
+			column, err := exportCSVValue(value.Field(i))
+			if err != nil {
+				return nil, fmt.Errorf("column %s: %w", header[i], err)
+			}
+			record[i] = column
+		}
+
+		if err := writer.Write(record); err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+	return buffer.Bytes(), writer.Error()
+}
+
+func exportCSVValue(value reflect.Value) (string, error) {
+	switch value.Kind() {
+	case reflect.Pointer:
+		if value.IsNil() {
+			return "", nil
+		}
+		return exportCSVValue(value.Elem())
+	case reflect.String:
+		return value.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(value.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(value.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(value.Float(), 'g', -1, value.Type().Bits()), nil
+	}
+
+	return "", fmt.Errorf("unsupported export column kind %s", value.Kind())
+}
+
+func exportE(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	format := sflags.MustGetString(cmd, "format")
+	if format != "parquet" && format != "csv" {
+		return fmt.Errorf("invalid format %q, expected 'parquet' or 'csv'", format)
+	}
+
+	start, stop, err := parseClosedBlockRange(args[0])
+	if err != nil {
+		return err
+	}
+
+	workers := sflags.MustGetInt(cmd, "workers")
+	if workers < 1 {
+		return fmt.Errorf("invalid workers count %d, expected at least 1", workers)
+	}
+
+	mergedBlocksStore, err := dstore.NewDBinStore(sflags.MustGetString(cmd, "merged-blocks-store-url"))
+	if err != nil {
+		return fmt.Errorf("unable to create merged blocks store: %w", err)
+	}
+
+	outputStore, err := dstore.NewStore(sflags.MustGetString(cmd, "output-store-url"), "", "", true)
+	if err != nil {
+		return fmt.Errorf("unable to create output store: %w", err)
+	}
+
+	group, ctx := errgroup.WithContext(ctx)
+	group.SetLimit(workers)
+
+	var blockCount atomic.Uint64
+	for base := mergedBlocksBundleBase(start); base < stop; base += mergedBlocksBundleSize {
+		base := base
+		group.Go(func() error {
+			tables := &exportTables{}
+			err := readMergedBlocksBundle(ctx, mergedBlocksStore, base, func(block *pbnear.Block) error {
+				if block.Num() >= start && block.Num() < stop {
+					tables.addBlock(block)
+				}
+				return nil
+			})
+			if err != nil {
+				return mergedBlocksRangeError(base, err)
+			}
+
+			blockCount.Add(uint64(len(tables.blocks)))
+			return tables.write(ctx, outputStore, format, base)
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.ErrOrStderr(), "Exported %d blocks to %s\n", blockCount.Load(), outputStore.BaseURL())
+	return nil
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/parquet-go/parquet-go"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportTables_AddBlock(t *testing.T) {
+	hash := func(b byte) *pbnear.CryptoHash {
+		out := make([]byte, 32)
+		out[0] = b
+		return &pbnear.CryptoHash{Bytes: out}
+	}
+	amount := func(yocto int64) *pbnear.BigInt { return &pbnear.BigInt{Bytes: big.NewInt(yocto).Bytes()} }
+	b58 := func(h *pbnear.CryptoHash) string { return h.AsBase58String() }
+
+	block := &pbnear.Block{
+		Author: "val.near",
+		Header: &pbnear.BlockHeader{Height: 100, PrevHeight: 99, Hash: hash(1), PrevHash: hash(2), GasPrice: amount(100), TotalSupply: amount(1000)},
+		ChunkHeaders: []*pbnear.ChunkHeader{
+			{ChunkHash: hash(5).Bytes, ShardId: 0, GasUsed: 10, GasLimit: 1000},
+		},
+		Shards: []*pbnear.IndexerShard{{
+			ShardId: 0,
+			Chunk: &pbnear.IndexerChunk{Transactions: []*pbnear.IndexerTransactionWithOutcome{{
+				Transaction: &pbnear.SignedTransaction{
+					Hash: hash(6), SignerId: "relayer.near", ReceiverId: "alice.near",
+					Actions: []*pbnear.Action{{Action: &pbnear.Action_Delegate{Delegate: &pbnear.SignedDelegateAction{DelegateAction: &pbnear.DelegateAction{
+						SenderId: "alice.near", ReceiverId: "bob.near", Nonce: 3,
+						Actions: []*pbnear.Action{
+							{Action: &pbnear.Action_Transfer{Transfer: &pbnear.TransferAction{Deposit: amount(5)}}},
+							{Action: &pbnear.Action_FunctionCall{FunctionCall: &pbnear.FunctionCallAction{MethodName: "ping", Args: []byte("{}"), Gas: 7}}},
+						},
+					}}}}},
+				},
+				Outcome: &pbnear.IndexerExecutionOutcomeWithOptionalReceipt{ExecutionOutcome: &pbnear.ExecutionOutcomeWithId{Outcome: &pbnear.ExecutionOutcome{
+					ExecutorId: "relayer.near", ReceiptIds: []*pbnear.CryptoHash{hash(7)},
+					Status: &pbnear.ExecutionOutcome_SuccessReceiptId{SuccessReceiptId: &pbnear.SuccessReceiptIdExecutionStatus{Id: hash(7)}},
+				}}},
+			}}},
+			ReceiptExecutionOutcomes: []*pbnear.IndexerExecutionOutcomeWithReceipt{{
+				Receipt: &pbnear.Receipt{ReceiptId: hash(7), PredecessorId: "relayer.near", ReceiverId: "alice.near", Receipt: &pbnear.Receipt_Data{Data: &pbnear.ReceiptData{DataId: hash(8), Data: []byte("ok")}}},
+				ExecutionOutcome: &pbnear.ExecutionOutcomeWithId{Outcome: &pbnear.ExecutionOutcome{
+					ExecutorId: "alice.near", Logs: []string{"first", "second"},
+					Status: &pbnear.ExecutionOutcome_SuccessValue{SuccessValue: &pbnear.SuccessValueExecutionStatus{Value: []byte("ok")}},
+				}},
+			}},
+		}},
+		StateChanges: []*pbnear.StateChangeWithCause{{
+			Value: &pbnear.StateChangeValue{Value: &pbnear.StateChangeValue_DataUpdate_{DataUpdate: &pbnear.StateChangeValue_DataUpdate{AccountId: "alice.near", Key: []byte("k"), Value: []byte("v")}}},
+			Cause: &pbnear.StateChangeCause{Cause: &pbnear.StateChangeCause_ReceiptProcessing_{ReceiptProcessing: &pbnear.StateChangeCause_ReceiptProcessing{TxHash: hash(7)}}},
+		}},
+	}
+
+	tables := &exportTables{}
+	tables.addBlock(block)
+
+	require.Len(t, tables.blocks, 1)
+	assert.Equal(t, b58(hash(2)), tables.blocks[0].PrevBlockHash)
+	assert.Equal(t, "100", tables.blocks[0].GasPrice)
+
+	require.Len(t, tables.chunks, 1)
+	assert.Equal(t, b58(hash(5)), tables.chunks[0].ChunkHash)
+	assert.Equal(t, uint64(1), tables.chunks[0].TransactionCount)
+
+	require.Len(t, tables.transactions, 1)
+	assert.Equal(t, b58(hash(5)), tables.transactions[0].ChunkHash)
+	assert.Equal(t, b58(hash(7)), tables.transactions[0].ConvertedIntoReceiptID)
+
+	require.Len(t, tables.receipts, 1)
+	assert.Equal(t, "data", tables.receipts[0].ReceiptKind)
+	assert.Equal(t, b58(hash(8)), tables.receipts[0].DataID)
+
+	require.Len(t, tables.actions, 3)
+	assert.Equal(t, "delegate", tables.actions[0].ActionKind)
+	assert.Nil(t, tables.actions[0].DelegateIndex)
+	assert.Equal(t, "bob.near", tables.actions[0].DelegateReceiverID)
+	assert.Equal(t, "transfer", tables.actions[1].ActionKind)
+	assert.Equal(t, uint32(0), *tables.actions[1].DelegateIndex)
+	assert.Equal(t, "5", tables.actions[1].Deposit)
+	assert.Equal(t, "function_call", tables.actions[2].ActionKind)
+	assert.Equal(t, uint32(1), *tables.actions[2].DelegateIndex)
+	assert.Equal(t, uint64(2), tables.actions[2].ArgsSize)
+	for _, action := range tables.actions {
+		assert.Equal(t, b58(hash(6)), action.ParentHash)
+		assert.Equal(t, "transaction", action.ParentKind)
+	}
+
+	require.Len(t, tables.outcomes, 2)
+	assert.Equal(t, "success_receipt_id", tables.outcomes[0].Status)
+	assert.Equal(t, "success_value", tables.outcomes[1].Status)
+	assert.Equal(t, "b2s=", tables.outcomes[1].SuccessValueBase64)
+
+	assert.Equal(t, []exportLog{
+		{OutcomeID: b58(hash(7)), BlockHash: b58(hash(1)), BlockHeight: 100, IndexInOutcome: 0, Log: "first"},
+		{OutcomeID: b58(hash(7)), BlockHash: b58(hash(1)), BlockHeight: 100, IndexInOutcome: 1, Log: "second"},
+	}, tables.logs)
+
+	require.Len(t, tables.stateChanges, 1)
+	assert.Equal(t, "data_update", tables.stateChanges[0].ChangeKind)
+	assert.Equal(t, b58(hash(7)), tables.stateChanges[0].CauseHash)
+	assert.Equal(t, "aw==", tables.stateChanges[0].DataKeyBase64)
+}
+
+func TestEncodeExportTable(t *testing.T) {
+	index := uint32(2)
+	rows := []exportAction{
+		{ParentHash: "abc", ParentKind: "receipt", BlockHeight: 10, ActionKind: "transfer", Deposit: "1"},
+		{ParentHash: "abc", ParentKind: "receipt", BlockHeight: 10, DelegateIndex: &index, ActionKind: "function_call", MethodName: "a,b"},
+	}
+
+	content, err := encodeExportTable("csv", rows[:1])
+	require.NoError(t, err)
+	assert.Equal(t, "parent_hash,parent_kind,block_hash,block_height,index_in_parent,delegate_index,action_kind,deposit,gas,method_name,args_base64,args_sha256,args_size,code_sha256,code_size,stake,public_key,access_key_nonce,access_key_permission,access_key_allowance,access_key_receiver_id,access_key_method_names,beneficiary_id,delegate_sender_id,delegate_receiver_id,delegate_nonce,delegate_max_block_height\n"+
+		"abc,receipt,,10,0,,transfer,1,0,,,,0,,0,,,0,,,,,,,,0,0\n", string(content))
+
+	content, err = encodeExportTable("parquet", rows)
+	require.NoError(t, err)
+
+	read, err := parquet.Read[exportAction](bytes.NewReader(content), int64(len(content)))
+	require.NoError(t, err)
+	assert.Equal(t, rows, read)
+}
+
+func TestEncodeExportTable_ColumnKinds(t *testing.T) {
+	type row struct {
+		Flag  bool    `parquet:"flag"`
+		Delta int64   `parquet:"delta"`
+		Ratio float64 `parquet:"ratio"`
+	}
+
+	content, err := encodeExportTable("csv", []row{{Flag: true, Delta: -2, Ratio: 0.5}})
+	require.NoError(t, err)
+	assert.Equal(t, "flag,delta,ratio\ntrue,-2,0.5\n", string(content))
+
+	type unsupportedRow struct {
+		Tags []string `parquet:"tags,list"`
+	}
+
+	_, err = encodeExportTable("csv", []unsupportedRow{{Tags: []string{"a"}}})
+	assert.EqualError(t, err, "column tags: unsupported export column kind slice")
+}
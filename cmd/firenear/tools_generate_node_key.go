@@ -1,23 +1,21 @@
 package main
 
 import (
-	"crypto/ed25519"
-	"encoding/json"
-	"fmt"
-	"os"
+	"crypto/rand"
 
-	"github.com/mr-tron/base58"
 	"github.com/spf13/cobra"
 	"github.com/streamingfast/cli"
 	firecore "github.com/streamingfast/firehose-core"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
 )
 
 func newToolsGenerateNodeKeyCmd[B firecore.Block](chain *firecore.Chain[B]) *cobra.Command {
 	return &cobra.Command{
-		Use:   "generate-node-key [<output_file>]",
-		Short: "Generate a new node key JSON file suitable to be used by NEAR node, if no argument is provided, write to './node_key.json'",
-		Args:  cobra.RangeArgs(0, 1),
-		RunE:  generateNodeKeyE,
+		Use:        "generate-node-key [<output_file>]",
+		Short:      "Generate a new node key JSON file suitable to be used by NEAR node, if no argument is provided, write to './node_key.json'",
+		Deprecated: "use 'tools keys generate' instead",
+		Args:       cobra.RangeArgs(0, 1),
+		RunE:       generateNodeKeyE,
 		Example: firecore.ExamplePrefixed(chain, "tools", `
 			# Generate NEAR node key in file named 'node_key.json' in current directory
 			generate-node-key
@@ -29,28 +27,15 @@ func newToolsGenerateNodeKeyCmd[B firecore.Block](chain *firecore.Chain[B]) *cob
 }
 
 func generateNodeKeyE(cmd *cobra.Command, args []string) error {
-	type NodeKey struct {
-		AccountID string `json:"account_id"`
-		PublicKey string `json:"public_key"`
-		SecretKey string `json:"secret_key"`
-	}
-
 	outputFile := "node_key.json"
 	if len(args) > 0 {
 		outputFile = args[0]
 	}
 
-	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	secretKey, err := pbnear.GenerateSecretKey(pbnear.CurveKind_ED25519, rand.Reader)
 	cli.NoError(err, "Unable to generate ed25519 public/private key pair")
 
-	out, err := json.MarshalIndent(NodeKey{
-		AccountID: "node",
-		PublicKey: fmt.Sprintf("ed25519:%s", base58.Encode([]byte(publicKey))),
-		SecretKey: fmt.Sprintf("ed25519:%s", base58.Encode([]byte(privateKey))),
-	}, "", "  ")
-	cli.NoError(err, "Unable to marshal node key")
-
-	err = os.WriteFile(outputFile, out, os.ModePerm)
+	err = writeKeyFile(outputFile, "node", secretKey, true)
 	cli.NoError(err, "Unable to write output file")
 
 	return err
@@ -0,0 +1,255 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/cli"
+	"github.com/streamingfast/cli/sflags"
+	firecore "github.com/streamingfast/firehose-core"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+)
+
+func newToolsKeysCmd[B firecore.Block](chain *firecore.Chain[B]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "keys",
+		Short: "Generate and inspect NEAR node and validator key files",
+	}
+
+	generateCmd := &cobra.Command{
+		Use:   "generate [<output_file>]",
+		Short: "Generate a new node or validator key JSON file, written readable by its owner only",
+		Long: cli.Dedent(`
+			Generate a new key JSON file in the format used by NEAR node for its 'node_key.json' and
+			'validator_key.json' files. When no output file is provided, the key is written to
+			'./node_key.json' or './validator_key.json' depending on --kind.
+
+			With --seed, the key is derived deterministically from the seed the same way
+			'neard init --test-seed' does, which is only supported for ed25519 keys. Such keys
+			must never be used outside of test networks.
+
+			The file is written with owner-only permissions (0600) and an existing file is never
+			overwritten unless --force is used.
+		`),
+		Args: cobra.RangeArgs(0, 1),
+		RunE: keysGenerateE,
+		Example: firecore.ExamplePrefixed(chain, "tools keys", `
+			# Generate a random node key in './node_key.json'
+			generate
+
+			# Generate the validator key of 'validator.pool.f863973.m0' in './validator_key.json'
+			generate --kind=validator --account-id=validator.pool.f863973.m0
+
+			# Generate a deterministic test key for account 'test.near'
+			generate --account-id=test.near --seed=test.near path/node_key.json
+		`),
+	}
+	generateCmd.Flags().String("kind", "node", "Kind of key to generate, one of 'node' or 'validator', determines the default output file")
+	generateCmd.Flags().String("account-id", "", "Account ID of the key, defaults to 'node' for node keys and is required for validator keys")
+	generateCmd.Flags().String("curve", "ed25519", "Curve of the key, one of 'ed25519' or 'secp256k1'")
+	generateCmd.Flags().String("seed", "", "Derive the key deterministically from this seed instead of randomly (ed25519 only, for test networks)")
+	generateCmd.Flags().Bool("force", false, "Overwrite the output file if it already exists")
+
+	inspectCmd := &cobra.Command{
+		Use:   "inspect <key_file>",
+		Short: "Inspect a key JSON file, checking that its public key matches its secret key",
+		Args:  cobra.ExactArgs(1),
+		RunE:  keysInspectE,
+		Example: firecore.ExamplePrefixed(chain, "tools keys", `
+			inspect ./firehose-data/reader/data/node_key.json
+		`),
+	}
+
+	implicitAccountCmd := &cobra.Command{
+		Use:   "implicit-account <public_key>",
+		Short: "Print the implicit account ID controlled by the public key",
+		Long: cli.Dedent(`
+			Print the implicit account ID controlled by the public key, the hex encoded public key
+			for ed25519 keys and the ETH-implicit account ('0x' followed by the last 20 bytes of
+			the keccak256 of the public key) for secp256k1 keys.
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: keysImplicitAccountE,
+		Example: firecore.ExamplePrefixed(chain, "tools keys", `
+			implicit-account ed25519:DcA2MzgpJbrUATQLLceocVckhhAqrkingax4oJ9kZ847
+		`),
+	}
+
+	cmd.AddCommand(generateCmd)
+	cmd.AddCommand(inspectCmd)
+	cmd.AddCommand(implicitAccountCmd)
+
+	return cmd
+}
+
+// keyFile is the format of NEAR node `node_key.json` and `validator_key.json` files
+type keyFile struct {
+	AccountID string `json:"account_id"`
+	PublicKey string `json:"public_key"`
+	SecretKey string `json:"secret_key"`
+	// PrivateKey is the name used by older NEAR node versions for the secret key, it's only read
+	PrivateKey string `json:"private_key,omitempty"`
+}
+
+func keysGenerateE(cmd *cobra.Command, args []string) error {
+	kind := sflags.MustGetString(cmd, "kind")
+	accountID := sflags.MustGetString(cmd, "account-id")
+
+	outputFile := ""
+	switch kind {
+	case "node":
+		outputFile = "node_key.json"
+		if accountID == "" {
+			accountID = "node"
+		}
+	case "validator":
+		outputFile = "validator_key.json"
+		if accountID == "" {
+			return fmt.Errorf("flag --account-id is required for validator keys")
+		}
+	default:
+		return fmt.Errorf("invalid key kind %q, expected 'node' or 'validator'", kind)
+	}
+
+	if len(args) > 0 {
+		outputFile = args[0]
+	}
+
+	var curve pbnear.CurveKind
+	switch value := sflags.MustGetString(cmd, "curve"); value {
+	case "ed25519":
+		curve = pbnear.CurveKind_ED25519
+	case "secp256k1":
+		curve = pbnear.CurveKind_SECP256K1
+	default:
+		return fmt.Errorf("invalid curve %q, expected 'ed25519' or 'secp256k1'", value)
+	}
+
+	var secretKey *pbnear.SecretKey
+	if seed := sflags.MustGetString(cmd, "seed"); seed != "" {
+		if curve != pbnear.CurveKind_ED25519 {
+			return fmt.Errorf("deriving a key from a seed is only supported for ed25519 keys")
+		}
+		secretKey = pbnear.SecretKeyFromSeed(seed)
+	} else {
+		var err error
+		if secretKey, err = pbnear.GenerateSecretKey(curve, rand.Reader); err != nil {
+			return err
+		}
+	}
+
+	if err := writeKeyFile(outputFile, accountID, secretKey, sflags.MustGetBool(cmd, "force")); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Wrote %s key of account %q to %s\n", kind, accountID, outputFile)
+	fmt.Fprintf(cmd.OutOrStdout(), "Public key: %s\n", secretKey.PublicKey().AsKeyString())
+	return nil
+}
+
+// writeKeyFile writes the key file readable and writable by its owner only, failing if the
+// file already exists unless overwrite is true.
+func writeKeyFile(path string, accountID string, secretKey *pbnear.SecretKey, overwrite bool) error {
+	content, err := json.MarshalIndent(keyFile{
+		AccountID: accountID,
+		PublicKey: secretKey.PublicKey().AsKeyString(),
+		SecretKey: secretKey.AsKeyString(),
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal key: %w", err)
+	}
+
+	flags := os.O_WRONLY | os.O_CREATE | os.O_EXCL
+	if overwrite {
+		flags = os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	}
+
+	file, err := os.OpenFile(path, flags, 0600)
+	if err != nil {
+		if os.IsExist(err) {
+			return fmt.Errorf("key file %q already exists, use --force to overwrite it", path)
+		}
+		return fmt.Errorf("open key file: %w", err)
+	}
+
+	// OpenFile does not change the mode of an existing file
+	if err := file.Chmod(0600); err != nil {
+		file.Close()
+		return fmt.Errorf("restrict key file permissions: %w", err)
+	}
+
+	if _, err := file.Write(content); err != nil {
+		file.Close()
+		return fmt.Errorf("write key file: %w", err)
+	}
+
+	return file.Close()
+}
+
+func keysInspectE(cmd *cobra.Command, args []string) error {
+	content, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("read key file: %w", err)
+	}
+
+	key := keyFile{}
+	if err := json.Unmarshal(content, &key); err != nil {
+		return fmt.Errorf("unmarshal key file: %w", err)
+	}
+
+	encodedSecretKey := key.SecretKey
+	if encodedSecretKey == "" {
+		encodedSecretKey = key.PrivateKey
+	}
+
+	if encodedSecretKey == "" {
+		return fmt.Errorf("key file has neither 'secret_key' nor 'private_key' field")
+	}
+
+	secretKey, err := pbnear.ParseSecretKey(encodedSecretKey)
+	if err != nil {
+		return fmt.Errorf("invalid secret key: %w", err)
+	}
+
+	publicKey, err := pbnear.ParsePublicKey(key.PublicKey)
+	if err != nil {
+		return fmt.Errorf("invalid public key: %w", err)
+	}
+
+	derivedPublicKey := secretKey.PublicKey()
+	if derivedPublicKey.AsKeyString() != publicKey.AsKeyString() {
+		return fmt.Errorf("public key %s does not match the secret key, whose public key is %s", publicKey.AsKeyString(), derivedPublicKey.AsKeyString())
+	}
+
+	out := cmd.OutOrStdout()
+	fmt.Fprintf(out, "Account ID: %s\n", key.AccountID)
+	fmt.Fprintf(out, "Curve: %s\n", curveName(publicKey.Type))
+	fmt.Fprintf(out, "Public key: %s (matches secret key)\n", publicKey.AsKeyString())
+	fmt.Fprintf(out, "Implicit account: %s\n", publicKey.ImplicitAccountID())
+
+	if stat, err := os.Stat(args[0]); err == nil && stat.Mode().Perm()&0077 != 0 {
+		fmt.Fprintf(out, "Warning: key file is accessible by other users (mode %s), restrict it with 'chmod 600 %s'\n", stat.Mode().Perm(), args[0])
+	}
+
+	return nil
+}
+
+func keysImplicitAccountE(cmd *cobra.Command, args []string) error {
+	publicKey, err := pbnear.ParsePublicKey(args[0])
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(cmd.OutOrStdout(), publicKey.ImplicitAccountID())
+	return nil
+}
+
+func curveName(curve pbnear.CurveKind) string {
+	if curve == pbnear.CurveKind_SECP256K1 {
+		return "secp256k1"
+	}
+	return "ed25519"
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/cli"
+	"github.com/streamingfast/cli/sflags"
+	"github.com/streamingfast/dstore"
+	firecore "github.com/streamingfast/firehose-core"
+	"github.com/streamingfast/firehose-near/nodeconfig"
+)
+
+func newToolsNodeConfigCmd[B firecore.Block](chain *firecore.Chain[B]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "node-config",
+		Short: "Generate and validate neard 'config.json' files for Firehose reader nodes",
+	}
+
+	generateCmd := &cobra.Command{
+		Use:   "generate [<output_file>]",
+		Short: "Generate a neard 'config.json' suitable for a Firehose reader node, printed to stdout if no output file is provided",
+		Long: cli.Dedent(`
+			Generate a neard 'config.json' suitable for a Firehose reader node of the network: the node
+			is archival, tracks all shards, uses the network's boot nodes and serves its JSON-RPC on
+			127.0.0.1:3030 where the codec expects it. Settings absent from the generated config get
+			neard's defaults.
+		`),
+		Args: cobra.RangeArgs(0, 1),
+		RunE: nodeConfigGenerateE,
+		Example: firecore.ExamplePrefixed(chain, "tools node-config", `
+			# Generate a mainnet reader node config in './reader/config.json'
+			generate --network=mainnet ./reader/config.json
+		`),
+	}
+	generateCmd.Flags().String("network", "", "Network of the node, one of 'mainnet' or 'testnet' (required)")
+	generateCmd.Flags().StringSlice("boot-nodes", nil, "Boot nodes of the node, in '<public_key>@<host>:<port>' form, defaults to the network's boot nodes")
+	generateCmd.Flags().String("rpc-addr", "", "Listen address of the node's JSON-RPC, defaults to '127.0.0.1:3030'")
+	generateCmd.Flags().String("tracked-shards", "", "Comma separated shard IDs tracked by the node, defaults to '0' which tracks all shards")
+
+	validateCmd := &cobra.Command{
+		Use:   "validate <config_file>",
+		Short: "Validate a neard 'config.json' for a Firehose reader node, failing if it contains settings that break Firehose",
+		Long: cli.Dedent(`
+			Validate a neard 'config.json', which can be any dstore URL, for a Firehose reader node.
+			Settings that break Firehose, like a disabled or unreachable JSON-RPC, are reported as
+			errors and make the command fail, settings that may break it are reported as warnings.
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: nodeConfigValidateE,
+		Example: firecore.ExamplePrefixed(chain, "tools node-config", `
+			validate ./reader/config.json
+		`),
+	}
+
+	cmd.AddCommand(generateCmd)
+	cmd.AddCommand(validateCmd)
+
+	return cmd
+}
+
+func nodeConfigGenerateE(cmd *cobra.Command, args []string) error {
+	network, err := nodeconfig.ParseNetwork(sflags.MustGetString(cmd, "network"))
+	if err != nil {
+		return err
+	}
+
+	bootNodes, err := cmd.Flags().GetStringSlice("boot-nodes")
+	if err != nil {
+		return err
+	}
+
+	var trackedShards []uint64
+	if value := sflags.MustGetString(cmd, "tracked-shards"); value != "" {
+		for _, element := range strings.Split(value, ",") {
+			shardID, err := strconv.ParseUint(strings.TrimSpace(element), 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid tracked shard %q: %w", element, err)
+			}
+			trackedShards = append(trackedShards, shardID)
+		}
+	}
+
+	config, err := nodeconfig.Generate(network, nodeconfig.GenerateOptions{
+		BootNodes:     bootNodes,
+		RPCAddr:       sflags.MustGetString(cmd, "rpc-addr"),
+		TrackedShards: trackedShards,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, issue := range config.Validate() {
+		fmt.Fprintf(cmd.ErrOrStderr(), "%s\n", issue)
+	}
+
+	content, err := config.Marshal()
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 0 {
+		_, err := fmt.Fprintln(cmd.OutOrStdout(), string(content))
+		return err
+	}
+
+	if err := os.WriteFile(args[0], content, 0644); err != nil {
+		return fmt.Errorf("write config file: %w", err)
+	}
+
+	fmt.Fprintf(cmd.ErrOrStderr(), "Wrote %s reader node config to %s\n", network, args[0])
+	return nil
+}
+
+func nodeConfigValidateE(cmd *cobra.Command, args []string) error {
+	reader, _, _, err := dstore.OpenObject(cmd.Context(), args[0])
+	if err != nil {
+		return fmt.Errorf("open config file %q: %w", args[0], err)
+	}
+	defer reader.Close()
+
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return fmt.Errorf("read config file %q: %w", args[0], err)
+	}
+
+	config, err := nodeconfig.Parse(content)
+	if err != nil {
+		return err
+	}
+
+	errorCount := 0
+	for _, issue := range config.Validate() {
+		if issue.Severity == nodeconfig.SeverityError {
+			errorCount++
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), issue)
+	}
+
+	if errorCount > 0 {
+		return fmt.Errorf("config %q has %d setting(s) breaking Firehose", args[0], errorCount)
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Config %s is suitable for a Firehose reader node\n", args[0])
+	return nil
+}
@@ -0,0 +1,507 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/bstream"
+	"github.com/streamingfast/cli"
+	"github.com/streamingfast/cli/sflags"
+	"github.com/streamingfast/dstore"
+	firecore "github.com/streamingfast/firehose-core"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+)
+
+type printDetail int
+
+const (
+	printDetailHeader printDetail = iota
+	printDetailShards
+	printDetailTransactions
+	printDetailReceipts
+	printDetailStateChanges
+)
+
+var printDetailNames = []string{"header", "shards", "transactions", "receipts", "state-changes"}
+
+func parsePrintDetail(in string) (printDetail, error) {
+	for i, name := range printDetailNames {
+		if strings.EqualFold(in, name) {
+			return printDetail(i), nil
+		}
+	}
+
+	return 0, fmt.Errorf("invalid detail level %q, expected one of %s", in, strings.Join(printDetailNames, ", "))
+}
+
+func newToolsPrintNearCmd[B firecore.Block](chain *firecore.Chain[B]) *cobra.Command {
+	printCmd := &cobra.Command{
+		Use:   "print-near",
+		Short: "Prints NEAR blocks of a one-block or merged blocks file with NEAR specific decoding",
+		Long: cli.Dedent(`
+			Prints NEAR blocks of a one-block or merged blocks file. Hashes are shown in base58, amounts in
+			NEAR (and yoctoNEAR in JSONL output) and function call arguments are pretty-printed when they are JSON.
+
+			The --detail flag selects how much of each block is printed, each level including the previous ones:
+			header, shards, transactions, receipts (with their actions and outcome logs) and state-changes.
+		`),
+	}
+
+	oneBlockCmd := &cobra.Command{
+		Use:   "one-block <store> <block_num>",
+		Short: "Prints a block from a one-block file",
+		Args:  cobra.ExactArgs(2),
+		RunE:  printNearOneBlockE,
+	}
+
+	mergedBlocksCmd := &cobra.Command{
+		Use:   "merged-blocks <store> <start_block>",
+		Short: "Prints the blocks of a merged blocks file",
+		Args:  cobra.ExactArgs(2),
+		RunE:  printNearMergedBlocksE,
+		Example: firecore.ExamplePrefixed(chain, "tools print-near", `
+			# Print the transactions of the merged blocks file containing block 100000042
+			merged-blocks --detail=transactions file://./firehose-data/storage/merged-blocks 100000042
+
+			# Print everything as JSONL
+			merged-blocks --detail=state-changes -o jsonl file://./firehose-data/storage/merged-blocks 100000000
+		`),
+	}
+
+	printCmd.AddCommand(oneBlockCmd)
+	printCmd.AddCommand(mergedBlocksCmd)
+
+	printCmd.PersistentFlags().StringP("output", "o", "text", "Output format, either 'text' or 'jsonl'")
+	printCmd.PersistentFlags().String("detail", "header", "Detail level, one of "+strings.Join(printDetailNames, ", ")+", each level including the previous ones")
+
+	return printCmd
+}
+
+func printNearMergedBlocksE(cmd *cobra.Command, args []string) error {
+	printer, err := newNearBlockPrinter(cmd)
+	if err != nil {
+		return err
+	}
+
+	store, err := dstore.NewDBinStore(args[0])
+	if err != nil {
+		return fmt.Errorf("unable to create store at path %q: %w", args[0], err)
+	}
+
+	startBlock, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid base block %q: %w", args[1], err)
+	}
+
+	return readMergedBlocksBundle(cmd.Context(), store, mergedBlocksBundleBase(startBlock), printer)
+}
+
+func printNearOneBlockE(cmd *cobra.Command, args []string) error {
+	printer, err := newNearBlockPrinter(cmd)
+	if err != nil {
+		return err
+	}
+
+	store, err := dstore.NewDBinStore(args[0])
+	if err != nil {
+		return fmt.Errorf("unable to create store at path %q: %w", args[0], err)
+	}
+
+	blockNum, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return fmt.Errorf("unable to parse block number %q: %w", args[1], err)
+	}
+
+	return readOneBlockFiles(cmd.Context(), store, blockNum, printer)
+}
+
+func newNearBlockPrinter(cmd *cobra.Command) (func(block *pbnear.Block) error, error) {
+	detail, err := parsePrintDetail(sflags.MustGetString(cmd, "detail"))
+	if err != nil {
+		return nil, err
+	}
+
+	output := sflags.MustGetString(cmd, "output")
+	if err := checkOutputFormat(output, "text", "jsonl"); err != nil {
+		return nil, err
+	}
+
+	out := cmd.OutOrStdout()
+	if output == "text" {
+		return func(block *pbnear.Block) error {
+			return printBlock(block, detail, out)
+		}, nil
+	}
+
+	return func(block *pbnear.Block) error {
+		printed, err := newPrintedBlock(block, detail)
+		if err != nil {
+			return err
+		}
+
+		content, err := json.Marshal(printed)
+		if err != nil {
+			return fmt.Errorf("marshal block #%d: %w", block.Num(), err)
+		}
+
+		_, err = fmt.Fprintln(out, string(content))
+		return err
+	}, nil
+}
+
+// readOneBlockFiles calls fn for the block of each one-block file of blockNum, there is more
+// than one when forks were seen at that height.
+func readOneBlockFiles(ctx context.Context, store dstore.Store, blockNum uint64, fn func(block *pbnear.Block) error) error {
+	var files []string
+	err := store.Walk(ctx, fmt.Sprintf("%010d", blockNum), func(filename string) error {
+		files = append(files, filename)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("unable to find one block files: %w", err)
+	}
+
+	if len(files) == 0 {
+		return fmt.Errorf("no one block file found for block #%d", blockNum)
+	}
+
+	for _, filename := range files {
+		if err := readOneBlockFile(ctx, store, filename, fn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func readOneBlockFile(ctx context.Context, store dstore.Store, filename string, fn func(block *pbnear.Block) error) error {
+	reader, err := store.OpenObject(ctx, filename)
+	if err != nil {
+		return fmt.Errorf("open one block file %q: %w", filename, err)
+	}
+	defer reader.Close()
+
+	blockReader, err := bstream.NewDBinBlockReader(reader)
+	if err != nil {
+		return fmt.Errorf("new block reader for %q: %w", filename, err)
+	}
+
+	blk, err := blockReader.Read()
+	if err != nil {
+		return fmt.Errorf("read block from %q: %w", filename, err)
+	}
+
+	block := &pbnear.Block{}
+	if err := blk.Payload.UnmarshalTo(block); err != nil {
+		return fmt.Errorf("unmarshal block #%d: %w", blk.Number, err)
+	}
+
+	return fn(block)
+}
+
+type printedAmount struct {
+	Yocto string `json:"yocto"`
+	NEAR  string `json:"near"`
+}
+
+func newPrintedAmount(amount *pbnear.BigInt) *printedAmount {
+	return &printedAmount{Yocto: amount.AsBigInt().String(), NEAR: nearAmount(amount)}
+}
+
+// printedBlock is the NEAR friendly rendition of a block used by the `print-near` tool, only the
+// sections requested by the detail level are filled.
+type printedBlock struct {
+	Number               uint64                `json:"number"`
+	Hash                 string                `json:"hash"`
+	FirehoseID           string                `json:"firehose_id"`
+	FirehoseParentID     string                `json:"firehose_parent_id"`
+	PrevHeight           uint64                `json:"prev_height"`
+	PrevHash             string                `json:"prev_hash"`
+	LastFinalBlockHeight uint64                `json:"last_final_block_height"`
+	Timestamp            time.Time             `json:"timestamp"`
+	Author               string                `json:"author"`
+	EpochID              string                `json:"epoch_id"`
+	ProtocolVersion      uint32                `json:"protocol_version"`
+	GasPrice             *printedAmount        `json:"gas_price"`
+	TotalSupply          *printedAmount        `json:"total_supply"`
+	ChunksIncluded       uint64                `json:"chunks_included"`
+	TransactionCount     int                   `json:"transaction_count"`
+	ReceiptCount         int                   `json:"receipt_count"`
+	Shards               []*printedShard       `json:"shards,omitempty"`
+	StateChanges         []*printedStateChange `json:"state_changes,omitempty"`
+}
+
+type printedShard struct {
+	ShardID          uint64                `json:"shard_id"`
+	ChunkHash        string                `json:"chunk_hash,omitempty"`
+	GasUsed          uint64                `json:"gas_used"`
+	GasLimit         uint64                `json:"gas_limit"`
+	BalanceBurnt     *printedAmount        `json:"balance_burnt"`
+	TransactionCount int                   `json:"transaction_count"`
+	ReceiptCount     int                   `json:"receipt_count"`
+	Transactions     []*printedTransaction `json:"transactions,omitempty"`
+	Receipts         []*printedReceipt     `json:"receipts,omitempty"`
+}
+
+type printedTransaction struct {
+	Hash        string           `json:"hash"`
+	SignerID    string           `json:"signer_id"`
+	ReceiverID  string           `json:"receiver_id"`
+	Nonce       uint64           `json:"nonce"`
+	Status      string           `json:"status"`
+	GasBurnt    uint64           `json:"gas_burnt"`
+	TokensBurnt *printedAmount   `json:"tokens_burnt"`
+	Actions     []*printedAction `json:"actions"`
+}
+
+type printedReceipt struct {
+	ID            string           `json:"id"`
+	PredecessorID string           `json:"predecessor_id"`
+	ReceiverID    string           `json:"receiver_id"`
+	ExecutorID    string           `json:"executor_id"`
+	Status        string           `json:"status"`
+	GasBurnt      uint64           `json:"gas_burnt"`
+	TokensBurnt   *printedAmount   `json:"tokens_burnt"`
+	Actions       []*printedAction `json:"actions,omitempty"`
+	Logs          []string         `json:"logs,omitempty"`
+	ReceiptIDs    []string         `json:"receipt_ids,omitempty"`
+}
+
+type printedAction struct {
+	Kind    string `json:"kind"`
+	Summary string `json:"summary"`
+	// Args holds the function call args when they are valid JSON, ArgsBase64 otherwise
+	Args       json.RawMessage `json:"args,omitempty"`
+	ArgsBase64 string          `json:"args_base64,omitempty"`
+}
+
+type printedStateChange struct {
+	Kind      string `json:"kind"`
+	AccountID string `json:"account_id"`
+	Cause     string `json:"cause"`
+	CauseHash string `json:"cause_hash,omitempty"`
+}
+
+func newPrintedBlock(block *pbnear.Block, detail printDetail) (*printedBlock, error) {
+	if block.Header == nil {
+		return nil, fmt.Errorf("block has no header")
+	}
+
+	envelope, err := encodeBlock(block)
+	if err != nil {
+		return nil, fmt.Errorf("encode block: %w", err)
+	}
+
+	header := block.Header
+	out := &printedBlock{
+		Number:               header.Height,
+		Hash:                 header.Hash.AsBase58String(),
+		FirehoseID:           envelope.Id,
+		FirehoseParentID:     envelope.ParentId,
+		PrevHeight:           header.PrevHeight,
+		PrevHash:             header.PrevHash.AsBase58String(),
+		LastFinalBlockHeight: header.LastFinalBlockHeight,
+		Timestamp:            block.Time(),
+		Author:               block.Author,
+		EpochID:              header.EpochId.AsBase58String(),
+		ProtocolVersion:      header.LatestProtocolVersion,
+		GasPrice:             newPrintedAmount(header.GasPrice),
+		TotalSupply:          newPrintedAmount(header.TotalSupply),
+		ChunksIncluded:       header.ChunksIncluded,
+	}
+
+	for _, shard := range block.Shards {
+		printed := newPrintedShard(block, shard, detail)
+		out.TransactionCount += printed.TransactionCount
+		out.ReceiptCount += printed.ReceiptCount
+
+		if detail >= printDetailShards {
+			out.Shards = append(out.Shards, printed)
+		}
+	}
+
+	if detail >= printDetailStateChanges {
+		for _, change := range block.StateChanges {
+			out.StateChanges = append(out.StateChanges, newPrintedStateChange(change))
+		}
+	}
+
+	return out, nil
+}
+
+func newPrintedShard(block *pbnear.Block, shard *pbnear.IndexerShard, detail printDetail) *printedShard {
+	out := &printedShard{
+		ShardID:          shard.ShardId,
+		TransactionCount: len(shard.Chunk.GetTransactions()),
+		ReceiptCount:     len(shard.ReceiptExecutionOutcomes),
+	}
+
+	chunkHeader := shardChunkHeader(block, shard)
+
+	if chunkHeader != nil {
+		out.ChunkHash = base58Hash(chunkHeader.ChunkHash)
+		out.GasUsed = chunkHeader.GasUsed
+		out.GasLimit = chunkHeader.GasLimit
+	}
+	out.BalanceBurnt = newPrintedAmount(chunkHeader.GetBalanceBurnt())
+
+	if detail >= printDetailTransactions {
+		for _, trx := range shard.Chunk.GetTransactions() {
+			transaction := trx.GetTransaction()
+			outcome := trx.GetOutcome().GetExecutionOutcome().GetOutcome()
+			out.Transactions = append(out.Transactions, &printedTransaction{
+				Hash:        transaction.GetHash().AsBase58String(),
+				SignerID:    transaction.GetSignerId(),
+				ReceiverID:  transaction.GetReceiverId(),
+				Nonce:       transaction.GetNonce(),
+				Status:      outcomeStatus(outcome),
+				GasBurnt:    outcome.GetGasBurnt(),
+				TokensBurnt: newPrintedAmount(outcome.GetTokensBurnt()),
+				Actions:     newPrintedActions(transaction.GetActions()),
+			})
+		}
+	}
+
+	if detail >= printDetailReceipts {
+		for _, receiptOutcome := range shard.ReceiptExecutionOutcomes {
+			receipt := receiptOutcome.Receipt
+			outcome := receiptOutcome.ExecutionOutcome.GetOutcome()
+
+			printed := &printedReceipt{
+				ID:            receipt.GetReceiptId().AsBase58String(),
+				PredecessorID: receipt.GetPredecessorId(),
+				ReceiverID:    receipt.GetReceiverId(),
+				ExecutorID:    outcome.GetExecutorId(),
+				Status:        outcomeStatus(outcome),
+				GasBurnt:      outcome.GetGasBurnt(),
+				TokensBurnt:   newPrintedAmount(outcome.GetTokensBurnt()),
+				Actions:       newPrintedActions(receipt.GetAction().GetActions()),
+				Logs:          outcome.GetLogs(),
+			}
+
+			for _, receiptID := range outcome.GetReceiptIds() {
+				printed.ReceiptIDs = append(printed.ReceiptIDs, receiptID.AsBase58String())
+			}
+
+			out.Receipts = append(out.Receipts, printed)
+		}
+	}
+
+	return out
+}
+
+func newPrintedActions(actions []*pbnear.Action) (out []*printedAction) {
+	for _, action := range actions {
+		printed := &printedAction{Kind: actionKind(action), Summary: actionSummary(action)}
+		if call := action.GetFunctionCall(); call != nil {
+			// Without ABI, decoding only detects JSON args and never fails
+			if decoded, _ := call.DecodeArgs(nil); decoded.Encoding == pbnear.ArgsEncodingJSON {
+				printed.Args = decoded.JSON
+			} else if decoded.Encoding == pbnear.ArgsEncodingUnknown {
+				printed.ArgsBase64 = base64.StdEncoding.EncodeToString(call.Args)
+			}
+		}
+
+		out = append(out, printed)
+	}
+
+	return
+}
+
+func newPrintedStateChange(change *pbnear.StateChangeWithCause) *printedStateChange {
+	out := &printedStateChange{Kind: "unknown", Cause: "unknown"}
+
+	if value := change.Value.ProtoReflect(); value.IsValid() {
+		if field := value.WhichOneof(value.Descriptor().Oneofs().ByName("value")); field != nil {
+			out.Kind = string(field.Name())
+
+			// All state change values have the account they apply to as `account_id`
+			inner := value.Get(field).Message()
+			if accountField := inner.Descriptor().Fields().ByName("account_id"); accountField != nil {
+				out.AccountID = inner.Get(accountField).String()
+			}
+		}
+	}
+
+	if cause := change.Cause.ProtoReflect(); cause.IsValid() {
+		if field := cause.WhichOneof(cause.Descriptor().Oneofs().ByName("cause")); field != nil {
+			out.Cause = string(field.Name())
+		}
+	}
+
+	if hash := change.Cause.CausingHash(); hash != nil {
+		out.CauseHash = hash.AsBase58String()
+	}
+
+	return out
+}
+
+func writePrintedBlock(block *printedBlock, out io.Writer) error {
+	var lines []string
+	add := func(format string, args ...any) {
+		lines = append(lines, fmt.Sprintf(format, args...))
+	}
+
+	add("Block #%d %s (prev: #%d %s, final: #%d) at %s by %s: %d chunks, %d transactions, %d receipts",
+		block.Number, block.Hash, block.PrevHeight, block.PrevHash, block.LastFinalBlockHeight, block.Timestamp.Format(time.RFC3339Nano), block.Author,
+		block.ChunksIncluded, block.TransactionCount, block.ReceiptCount,
+	)
+	add("  Firehose ID %s (parent %s)", block.FirehoseID, block.FirehoseParentID)
+	add("  Epoch %s, protocol version %d, gas price %s yoctoNEAR, total supply %s NEAR", block.EpochID, block.ProtocolVersion, block.GasPrice.Yocto, block.TotalSupply.NEAR)
+
+	for _, shard := range block.Shards {
+		add("- Shard %d chunk %s: gas used %d/%d, balance burnt %s NEAR, %d transactions, %d receipts",
+			shard.ShardID, shard.ChunkHash, shard.GasUsed, shard.GasLimit, shard.BalanceBurnt.NEAR, shard.TransactionCount, shard.ReceiptCount,
+		)
+
+		for _, trx := range shard.Transactions {
+			add("  - Transaction %s %s -> %s (nonce %d): %s, gas burnt %d, tokens burnt %s NEAR", trx.Hash, trx.SignerID, trx.ReceiverID, trx.Nonce, trx.Status, trx.GasBurnt, trx.TokensBurnt.NEAR)
+			lines = append(lines, printedActionsLines(trx.Actions, "    ")...)
+		}
+
+		for _, receipt := range shard.Receipts {
+			add("  - Receipt %s %s -> %s (executor %s): %s, gas burnt %d, tokens burnt %s NEAR", receipt.ID, receipt.PredecessorID, receipt.ReceiverID, receipt.ExecutorID, receipt.Status, receipt.GasBurnt, receipt.TokensBurnt.NEAR)
+			lines = append(lines, printedActionsLines(receipt.Actions, "    ")...)
+
+			for _, log := range receipt.Logs {
+				add("    - Log: %s", log)
+			}
+		}
+	}
+
+	for _, change := range block.StateChanges {
+		if change.CauseHash != "" {
+			add("- State change %s of %s caused by %s %s", change.Kind, change.AccountID, change.Cause, change.CauseHash)
+		} else {
+			add("- State change %s of %s caused by %s", change.Kind, change.AccountID, change.Cause)
+		}
+	}
+
+	_, err := fmt.Fprintln(out, strings.Join(lines, "\n"))
+	return err
+}
+
+func printedActionsLines(actions []*printedAction, indent string) (lines []string) {
+	for _, action := range actions {
+		lines = append(lines, indent+"- "+action.Summary)
+
+		if len(action.Args) > 0 {
+			content, err := json.MarshalIndent(action.Args, indent+"  ", "  ")
+			if err != nil {
+				content = action.Args
+			}
+			lines = append(lines, indent+"  "+string(content))
+		} else if action.ArgsBase64 != "" {
+			lines = append(lines, indent+"  args (base64): "+action.ArgsBase64)
+		}
+	}
+
+	return
+}
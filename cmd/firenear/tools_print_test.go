@@ -0,0 +1,139 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	firecore "github.com/streamingfast/firehose-core"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testPrintBlock() *pbnear.Block {
+	hash := func(b byte) *pbnear.CryptoHash {
+		return &pbnear.CryptoHash{Bytes: bytes.Repeat([]byte{b}, 32)}
+	}
+
+	yocto := func(b ...byte) *pbnear.BigInt {
+		return &pbnear.BigInt{Bytes: b}
+	}
+
+	return &pbnear.Block{
+		Author: "validator.near",
+		Header: &pbnear.BlockHeader{
+			Height:                101,
+			PrevHeight:            100,
+			Hash:                  hash(101),
+			PrevHash:              hash(100),
+			LastFinalBlockHeight:  99,
+			TimestampNanosec:      1_700_000_000_000_000_000,
+			EpochId:               hash(1),
+			LatestProtocolVersion: 63,
+			GasPrice:              yocto(0x05, 0xf5, 0xe1, 0x00),
+			TotalSupply:           yocto(0x03, 0x3b, 0x2e, 0x3c, 0x9f, 0xd0, 0x80, 0x3c, 0xe8, 0x00, 0x00, 0x00),
+			ChunksIncluded:        1,
+		},
+		ChunkHeaders: []*pbnear.ChunkHeader{
+			{ShardId: 0, ChunkHash: hash(10).Bytes, GasUsed: 2_000, GasLimit: 1_000_000, BalanceBurnt: yocto(0x01, 0x00)},
+		},
+		Shards: []*pbnear.IndexerShard{
+			{
+				ShardId: 0,
+				Chunk: &pbnear.IndexerChunk{Transactions: []*pbnear.IndexerTransactionWithOutcome{{
+					Transaction: &pbnear.SignedTransaction{
+						Hash:       hash(20),
+						SignerId:   "alice.near",
+						ReceiverId: "app.near",
+						Nonce:      7,
+						Actions: []*pbnear.Action{
+							{Action: &pbnear.Action_FunctionCall{FunctionCall: &pbnear.FunctionCallAction{MethodName: "set", Args: []byte(`{"value":1}`), Gas: 30, Deposit: yocto()}}},
+						},
+					},
+					Outcome: &pbnear.IndexerExecutionOutcomeWithOptionalReceipt{ExecutionOutcome: &pbnear.ExecutionOutcomeWithId{Outcome: &pbnear.ExecutionOutcome{
+						ExecutorId:  "alice.near",
+						GasBurnt:    1_000,
+						TokensBurnt: yocto(0x10),
+						ReceiptIds:  []*pbnear.CryptoHash{hash(30)},
+						Status:      &pbnear.ExecutionOutcome_SuccessReceiptId{SuccessReceiptId: &pbnear.SuccessReceiptIdExecutionStatus{Id: hash(30)}},
+					}}},
+				}}},
+				ReceiptExecutionOutcomes: []*pbnear.IndexerExecutionOutcomeWithReceipt{{
+					Receipt: &pbnear.Receipt{
+						ReceiptId:     hash(30),
+						PredecessorId: "alice.near",
+						ReceiverId:    "app.near",
+						Receipt: &pbnear.Receipt_Action{Action: &pbnear.ReceiptAction{Actions: []*pbnear.Action{
+							{Action: &pbnear.Action_FunctionCall{FunctionCall: &pbnear.FunctionCallAction{MethodName: "raw", Args: []byte{0xff, 0x00}, Gas: 30, Deposit: yocto()}}},
+						}}},
+					},
+					ExecutionOutcome: &pbnear.ExecutionOutcomeWithId{Outcome: &pbnear.ExecutionOutcome{
+						ExecutorId:  "app.near",
+						GasBurnt:    1_000,
+						TokensBurnt: yocto(0x10),
+						Logs:        []string{"value set"},
+						Status:      &pbnear.ExecutionOutcome_SuccessValue{SuccessValue: &pbnear.SuccessValueExecutionStatus{}},
+					}},
+				}},
+			},
+		},
+		StateChanges: []*pbnear.StateChangeWithCause{
+			{
+				Cause: &pbnear.StateChangeCause{Cause: &pbnear.StateChangeCause_TransactionProcessing_{TransactionProcessing: &pbnear.StateChangeCause_TransactionProcessing{TxHash: hash(20)}}},
+				Value: &pbnear.StateChangeValue{Value: &pbnear.StateChangeValue_AccountUpdate_{AccountUpdate: &pbnear.StateChangeValue_AccountUpdate{AccountId: "alice.near"}}},
+			},
+			{
+				Cause: &pbnear.StateChangeCause{Cause: &pbnear.StateChangeCause_ValidatorAccountsUpdate_{ValidatorAccountsUpdate: &pbnear.StateChangeCause_ValidatorAccountsUpdate{}}},
+				Value: &pbnear.StateChangeValue{Value: &pbnear.StateChangeValue_AccountUpdate_{AccountUpdate: &pbnear.StateChangeValue_AccountUpdate{AccountId: "validator.near"}}},
+			},
+		},
+	}
+}
+
+func TestPrintNear_Golden(t *testing.T) {
+	store := newTestMergedBlocksStore(t, testPrintBlock())
+	chain := &firecore.Chain[*pbnear.Block]{ShortName: "near", LongName: "NEAR"}
+
+	for _, output := range []string{"text", "jsonl"} {
+		for _, detail := range printDetailNames {
+			t.Run(output+"/"+detail, func(t *testing.T) {
+				cmd := newToolsPrintNearCmd(chain)
+				buf := &bytes.Buffer{}
+				cmd.SetOut(buf)
+				cmd.SetArgs([]string{"merged-blocks", "--detail=" + detail, "-o", output, store.BaseURL().String(), "101"})
+				require.NoError(t, cmd.Execute())
+
+				goldenFile := filepath.Join("testdata", "print-near."+detail+".golden."+output)
+				if os.Getenv("GOLDEN_UPDATE") == "true" {
+					require.NoError(t, os.WriteFile(goldenFile, buf.Bytes(), 0644))
+				}
+
+				expected, err := os.ReadFile(goldenFile)
+				require.NoError(t, err)
+				assert.Equal(t, string(expected), buf.String())
+			})
+		}
+	}
+}
+
+func TestNewPrintedBlock_NoHeader(t *testing.T) {
+	_, err := newPrintedBlock(&pbnear.Block{}, printDetailStateChanges)
+	assert.EqualError(t, err, "block has no header")
+}
+
+func TestNewPrintedBlock_PartialTransactions(t *testing.T) {
+	block := &pbnear.Block{
+		Header: &pbnear.BlockHeader{Height: 10},
+		Shards: []*pbnear.IndexerShard{
+			{Chunk: &pbnear.IndexerChunk{Transactions: []*pbnear.IndexerTransactionWithOutcome{{}}}},
+		},
+	}
+
+	printed, err := newPrintedBlock(block, printDetailStateChanges)
+	require.NoError(t, err)
+	require.Len(t, printed.Shards, 1)
+	require.Len(t, printed.Shards[0].Transactions, 1)
+	assert.Equal(t, "unknown", printed.Shards[0].Transactions[0].Status)
+}
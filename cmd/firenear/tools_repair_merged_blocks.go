@@ -0,0 +1,169 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/bstream"
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/cli"
+	"github.com/streamingfast/cli/sflags"
+	"github.com/streamingfast/dstore"
+	firecore "github.com/streamingfast/firehose-core"
+)
+
+func newToolsRepairMergedBlocksCmd[B firecore.Block](chain *firecore.Chain[B]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "repair-merged-blocks <source> <destination> <start>:<stop>",
+		Short: "Rewrite merged blocks repairing their previous and last final block heights",
+		Long: cli.Dedent(`
+			Walk the merged blocks of the range in order, remembering the height of each block hash, and
+			rewrite them to the destination store with their 'PrevHeight' and 'LastFinalBlockHeight'
+			resolved from the previous and last final block hashes, in the block payload as well as in
+			the merged blocks envelope (parent and LIB numbers). Each fixed field is reported.
+
+			This repairs blocks produced by the old console reader format or during RPC outages, which
+			can carry a 0 'PrevHeight' or a wrong 'LastFinalBlockHeight'. Blocks are otherwise upgraded
+			like 'upgrade-merged-blocks' does.
+
+			The range must cover whole merged blocks files, start and stop being multiples of 100, the
+			stop block being exclusive. The merged blocks file preceding the range, when it exists, is
+			read to resolve the heights of the first blocks of the range; blocks referencing a block
+			that was not seen keep their heights.
+		`),
+		Args: cobra.ExactArgs(3),
+		RunE: repairMergedBlocksE,
+		Example: firecore.ExamplePrefixed(chain, "tools", `
+			# Repair a range into a new store
+			repair-merged-blocks file://./merged-blocks file://./merged-blocks-repaired 100000000:100010000
+
+			# Only report the blocks that would be fixed
+			repair-merged-blocks --dry-run file://./merged-blocks "" 100000000:100010000
+		`),
+	}
+
+	cmd.Flags().Bool("dry-run", false, "Only report the fixes, nothing is written to the destination")
+	cmd.Flags().StringP("output", "o", "text", "Output format of the reported fixes, one of 'text' or 'jsonl'")
+
+	return cmd
+}
+
+func repairMergedBlocksE(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	output := sflags.MustGetString(cmd, "output")
+	if err := checkOutputFormat(output, "text", "jsonl"); err != nil {
+		return err
+	}
+
+	start, stop, err := parseClosedBlockRange(args[2])
+	if err != nil {
+		return err
+	}
+
+	if start%mergedBlocksBundleSize != 0 || stop%mergedBlocksBundleSize != 0 {
+		return fmt.Errorf("invalid range %q, start and stop must be multiples of %d", args[2], mergedBlocksBundleSize)
+	}
+
+	sourceStore, err := dstore.NewDBinStore(args[0])
+	if err != nil {
+		return fmt.Errorf("unable to create source store: %w", err)
+	}
+
+	dryRun := sflags.MustGetBool(cmd, "dry-run")
+
+	var destinationStore dstore.Store
+	if !dryRun {
+		destinationStore, err = dstore.NewStore(args[1], "dbin.zst", "zstd", true)
+		if err != nil {
+			return fmt.Errorf("unable to create destination store: %w", err)
+		}
+	}
+
+	repairer := newHeightsRepairer()
+	if start >= mergedBlocksBundleSize {
+		err := readMergedBlocksBundleRaw(ctx, sourceStore, start-mergedBlocksBundleSize, func(blk *pbbstream.Block) error {
+			_, _, err := repairer.Upgrade(blk)
+			return err
+		})
+		if err != nil && !errors.Is(err, dstore.ErrNotFound) {
+			return fmt.Errorf("read merged blocks preceding the range: %w", err)
+		}
+	}
+
+	blockCount, repairCount := 0, 0
+	for base := start; base < stop; base += mergedBlocksBundleSize {
+		var blocks []*pbbstream.Block
+		err := readMergedBlocksBundleRaw(ctx, sourceStore, base, func(blk *pbbstream.Block) error {
+			upgraded, repairs, err := repairer.Upgrade(blk)
+			if err != nil {
+				return fmt.Errorf("upgrade block #%d: %w", blk.Number, err)
+			}
+
+			blockCount++
+			repairCount += len(repairs)
+			blocks = append(blocks, upgraded)
+
+			return printHeightsRepairs(cmd.OutOrStdout(), repairs, output)
+		})
+		if err != nil {
+			return mergedBlocksRangeError(base, err)
+		}
+
+		if !dryRun {
+			if err := writeMergedBlocksBundle(ctx, destinationStore, base, blocks); err != nil {
+				return err
+			}
+		}
+	}
+
+	fmt.Fprintf(cmd.ErrOrStderr(), "Repaired %d heights in %d blocks\n", repairCount, blockCount)
+	return nil
+}
+
+func printHeightsRepairs(out io.Writer, repairs []*heightsRepair, format string) error {
+	for _, repair := range repairs {
+		line := repair.String()
+		if format == "jsonl" {
+			content, err := json.Marshal(repair)
+			if err != nil {
+				return fmt.Errorf("marshal repair: %w", err)
+			}
+			line = string(content)
+		}
+
+		if _, err := fmt.Fprintln(out, line); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeMergedBlocksBundle writes the blocks as the merged blocks file starting at baseBlockNum
+func writeMergedBlocksBundle(ctx context.Context, store dstore.Store, baseBlockNum uint64, blocks []*pbbstream.Block) error {
+	reader, writer := io.Pipe()
+
+	go func() {
+		blockWriter, err := bstream.NewDBinBlockWriter(writer)
+		for _, blk := range blocks {
+			if err != nil {
+				break
+			}
+			err = blockWriter.Write(blk)
+		}
+		writer.CloseWithError(err)
+	}()
+
+	filename := fmt.Sprintf("%010d", baseBlockNum)
+	if err := store.WriteObject(ctx, filename, reader); err != nil {
+		reader.CloseWithError(err)
+		return fmt.Errorf("write merged blocks file %q: %w", filename, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,462 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/cli"
+	"github.com/streamingfast/cli/sflags"
+	"github.com/streamingfast/dstore"
+	firecore "github.com/streamingfast/firehose-core"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+	"golang.org/x/sync/errgroup"
+)
+
+func newToolsStatsCmd[B firecore.Block](chain *firecore.Chain[B]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats <start>:<stop>",
+		Short: "Compute chain statistics over a block range, in total and per shard",
+		Long: cli.Dedent(`
+			Compute statistics of the merged blocks of the range, the stop block being exclusive, in total
+			and per shard: blocks, skipped heights, transactions, receipts, actions by kind, gas used and
+			gas limit of the included chunks, tokens burnt by transactions and receipts, top receivers
+			(of receipts) and signers (of transactions), failed receipts by ActionError kind and average
+			block time.
+
+			Merged blocks files are read in parallel. With --interval, statistics are computed for each
+			interval of the range instead of the range as a whole.
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: statsE,
+		Example: firecore.ExamplePrefixed(chain, "tools", `
+			# Statistics of 10 000 blocks
+			stats 100000000:100010000
+
+			# Same, per 1000 blocks interval, as JSON
+			stats --interval=1000 -o json 100000000:100010000
+		`),
+	}
+
+	cmd.Flags().String("merged-blocks-store-url", "file://./firehose-data/storage/merged-blocks", "Store URL where merged blocks are read from")
+	cmd.Flags().Uint64("interval", 0, "Compute statistics per interval of this many blocks, a multiple of 100, instead of the range as a whole")
+	cmd.Flags().Uint64("top", 10, "Number of top receivers and signers reported")
+	cmd.Flags().Int("workers", 8, "Number of merged blocks files read in parallel")
+	cmd.Flags().StringP("output", "o", "text", "Output format, one of 'text' or 'json'")
+
+	return cmd
+}
+
+type accountCount struct {
+	Account string `json:"account"`
+	Count   uint64 `json:"count"`
+}
+
+// activityStats are the statistics of the transactions, receipts and chunks of a shard, or of all
+// shards
+type activityStats struct {
+	// Chunks counts the chunks included in the blocks, their gas used and limit being summed
+	Chunks         uint64             `json:"chunks"`
+	Transactions   uint64             `json:"transactions"`
+	Receipts       uint64             `json:"receipts"`
+	Actions        map[string]uint64  `json:"actions"`
+	GasUsed        uint64             `json:"gas_used"`
+	GasLimit       uint64             `json:"gas_limit"`
+	TokensBurnt    *printedAmount     `json:"tokens_burnt"`
+	FailedReceipts uint64             `json:"failed_receipts"`
+	Failures       map[string]uint64  `json:"failures"`
+	FailureRates   map[string]float64 `json:"failure_rates"`
+	TopReceivers   []*accountCount    `json:"top_receivers"`
+	TopSigners     []*accountCount    `json:"top_signers"`
+
+	tokensBurnt *big.Int
+	receivers   map[string]uint64
+	signers     map[string]uint64
+}
+
+func newActivityStats() *activityStats {
+	return &activityStats{
+		Actions:     make(map[string]uint64),
+		Failures:    make(map[string]uint64),
+		tokensBurnt: new(big.Int),
+		receivers:   make(map[string]uint64),
+		signers:     make(map[string]uint64),
+	}
+}
+
+func (s *activityStats) addChunk(header *pbnear.ChunkHeader) {
+	s.Chunks++
+	s.GasUsed += header.GetGasUsed()
+	s.GasLimit += header.GetGasLimit()
+}
+
+// shardChunkHeader returns the header of the chunk of the shard, taken from the block chunk
+// headers when the chunk has none
+func shardChunkHeader(block *pbnear.Block, shard *pbnear.IndexerShard) *pbnear.ChunkHeader {
+	if header := shard.Chunk.GetHeader(); header != nil {
+		return header
+	}
+
+	for _, header := range block.ChunkHeaders {
+		if header.ShardId == shard.ShardId {
+			return header
+		}
+	}
+	return nil
+}
+
+func (s *activityStats) addShard(shard *pbnear.IndexerShard) {
+	if chunk := shard.Chunk; chunk != nil {
+		for _, trx := range chunk.Transactions {
+			s.Transactions++
+			s.signers[trx.Transaction.SignerId]++
+			s.addActions(trx.Transaction.Actions)
+			s.tokensBurnt.Add(s.tokensBurnt, trx.Outcome.GetExecutionOutcome().GetOutcome().GetTokensBurnt().AsBigInt())
+		}
+	}
+
+	for _, outcome := range shard.ReceiptExecutionOutcomes {
+		s.Receipts++
+		s.receivers[outcome.Receipt.GetReceiverId()]++
+		s.addActions(outcome.Receipt.GetAction().GetActions())
+
+		executionOutcome := outcome.ExecutionOutcome.GetOutcome()
+		s.tokensBurnt.Add(s.tokensBurnt, executionOutcome.GetTokensBurnt().AsBigInt())
+		if failure := executionOutcome.GetFailure(); failure != nil {
+			s.FailedReceipts++
+			s.Failures[failureKind(failure)]++
+		}
+	}
+}
+
+func (s *activityStats) addActions(actions []*pbnear.Action) {
+	for _, action := range actions {
+		s.Actions[actionKind(action)]++
+	}
+}
+
+func (s *activityStats) merge(other *activityStats) {
+	s.Chunks += other.Chunks
+	s.Transactions += other.Transactions
+	s.Receipts += other.Receipts
+	s.GasUsed += other.GasUsed
+	s.GasLimit += other.GasLimit
+	s.FailedReceipts += other.FailedReceipts
+	s.tokensBurnt.Add(s.tokensBurnt, other.tokensBurnt)
+	mergeCounts(s.Actions, other.Actions)
+	mergeCounts(s.Failures, other.Failures)
+	mergeCounts(s.receivers, other.receivers)
+	mergeCounts(s.signers, other.signers)
+}
+
+// finalize fills the fields derived from the counts
+func (s *activityStats) finalize(top int) {
+	s.TokensBurnt = newPrintedAmount(&pbnear.BigInt{Bytes: s.tokensBurnt.Bytes()})
+	s.TopReceivers = topAccounts(s.receivers, top)
+	s.TopSigners = topAccounts(s.signers, top)
+
+	s.FailureRates = make(map[string]float64, len(s.Failures))
+	for kind, count := range s.Failures {
+		s.FailureRates[kind] = float64(count) / float64(s.Receipts)
+	}
+}
+
+func mergeCounts(into, from map[string]uint64) {
+	for key, count := range from {
+		into[key] += count
+	}
+}
+
+// topAccounts returns the accounts with the highest counts, ties being sorted by account
+func topAccounts(counts map[string]uint64, top int) []*accountCount {
+	out := make([]*accountCount, 0, len(counts))
+	for account, count := range counts {
+		out = append(out, &accountCount{Account: account, Count: count})
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Count != out[j].Count {
+			return out[i].Count > out[j].Count
+		}
+		return out[i].Account < out[j].Account
+	})
+
+	if len(out) > top {
+		out = out[:top]
+	}
+	return out
+}
+
+type shardStats struct {
+	ShardID uint64 `json:"shard_id"`
+	*activityStats
+}
+
+// rangeStats are the statistics of the blocks of [StartBlock, StopBlock)
+type rangeStats struct {
+	StartBlock uint64 `json:"start_block"`
+	StopBlock  uint64 `json:"stop_block"`
+	Blocks     uint64 `json:"blocks"`
+	// SkippedHeights counts the heights of the range without block before each block, heights
+	// skipped after the last block of the range are not known
+	SkippedHeights     uint64  `json:"skipped_heights"`
+	AverageBlockTimeMS float64 `json:"average_block_time_ms"`
+	*activityStats
+	Shards []*shardStats `json:"shards"`
+
+	firstBlockTime time.Time
+	lastBlockTime  time.Time
+	// lastHeight is the height of the last block added, 0 when none was
+	lastHeight uint64
+	shards     map[uint64]*shardStats
+}
+
+func newRangeStats(start, stop uint64) *rangeStats {
+	return &rangeStats{StartBlock: start, StopBlock: stop, activityStats: newActivityStats(), shards: make(map[uint64]*shardStats)}
+}
+
+func (s *rangeStats) shard(shardID uint64) *shardStats {
+	shard, found := s.shards[shardID]
+	if !found {
+		shard = &shardStats{ShardID: shardID, activityStats: newActivityStats()}
+		s.shards[shardID] = shard
+	}
+	return shard
+}
+
+// addBlock adds a block to the statistics, blocks being added in order, skipped heights being
+// counted from the range start
+func (s *rangeStats) addBlock(block *pbnear.Block) {
+	s.Blocks++
+
+	height := block.Header.Height
+	prevHeight := block.Header.PrevHeight
+	if prevHeight == 0 {
+		// Old format blocks and blocks fetched during RPC outages have no previous height, the
+		// skipped heights are counted from the last block added, none for the first one
+		prevHeight = s.lastHeight
+	}
+
+	if skippedFrom := max(prevHeight+1, s.StartBlock); prevHeight != 0 && height > skippedFrom {
+		s.SkippedHeights += height - skippedFrom
+	}
+	s.lastHeight = height
+
+	s.addBlockTime(block.Time(), block.Time())
+
+	for _, shard := range block.Shards {
+		stats := s.shard(shard.ShardId)
+		if shard.Chunk != nil {
+			stats.addChunk(shardChunkHeader(block, shard))
+		}
+		stats.addShard(shard)
+	}
+}
+
+func (s *rangeStats) addBlockTime(first, last time.Time) {
+	if s.firstBlockTime.IsZero() || first.Before(s.firstBlockTime) {
+		s.firstBlockTime = first
+	}
+	if last.After(s.lastBlockTime) {
+		s.lastBlockTime = last
+	}
+}
+
+// merge adds the statistics of a sub range of the range
+func (s *rangeStats) merge(other *rangeStats) {
+	s.Blocks += other.Blocks
+	s.SkippedHeights += other.SkippedHeights
+	if other.Blocks > 0 {
+		s.addBlockTime(other.firstBlockTime, other.lastBlockTime)
+	}
+
+	for shardID, shard := range other.shards {
+		s.shard(shardID).merge(shard.activityStats)
+	}
+}
+
+func (s *rangeStats) finalize(top int) {
+	s.Shards = make([]*shardStats, 0, len(s.shards))
+	for _, shard := range s.shards {
+		s.activityStats.merge(shard.activityStats)
+		shard.finalize(top)
+		s.Shards = append(s.Shards, shard)
+	}
+	sort.Slice(s.Shards, func(i, j int) bool { return s.Shards[i].ShardID < s.Shards[j].ShardID })
+
+	s.activityStats.finalize(top)
+
+	if s.Blocks > 1 {
+		s.AverageBlockTimeMS = float64(s.lastBlockTime.Sub(s.firstBlockTime).Milliseconds()) / float64(s.Blocks-1)
+	}
+}
+
+func statsE(cmd *cobra.Command, args []string) error {
+	output := sflags.MustGetString(cmd, "output")
+	if err := checkOutputFormat(output, "text", "json"); err != nil {
+		return err
+	}
+
+	start, stop, err := parseClosedBlockRange(args[0])
+	if err != nil {
+		return err
+	}
+
+	interval := sflags.MustGetUint64(cmd, "interval")
+	if interval%mergedBlocksBundleSize != 0 {
+		return fmt.Errorf("invalid interval %d, expected a multiple of %d", interval, mergedBlocksBundleSize)
+	}
+
+	workers := sflags.MustGetInt(cmd, "workers")
+	if workers < 1 {
+		return fmt.Errorf("invalid workers count %d, expected at least 1", workers)
+	}
+
+	store, err := dstore.NewDBinStore(sflags.MustGetString(cmd, "merged-blocks-store-url"))
+	if err != nil {
+		return fmt.Errorf("unable to create merged blocks store: %w", err)
+	}
+
+	ranges, err := computeRangeStats(cmd.Context(), store, start, stop, interval, workers)
+	if err != nil {
+		return err
+	}
+
+	top := int(sflags.MustGetUint64(cmd, "top"))
+	for _, stats := range ranges {
+		stats.finalize(top)
+	}
+
+	if output == "json" {
+		content, err := json.MarshalIndent(ranges, "", "  ")
+		if err != nil {
+			return fmt.Errorf("marshal statistics: %w", err)
+		}
+
+		_, err = fmt.Fprintln(cmd.OutOrStdout(), string(content))
+		return err
+	}
+
+	for _, stats := range ranges {
+		if err := printRangeStats(cmd.OutOrStdout(), stats); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// computeRangeStats reads the merged blocks files covering [start, stop) using workers
+// goroutines and returns the statistics of each interval of the range, the whole range when
+// interval is 0.
+func computeRangeStats(ctx context.Context, store dstore.Store, start, stop, interval uint64, workers int) ([]*rangeStats, error) {
+	var ranges []*rangeStats
+	rangeOf := func(blockNum uint64) *rangeStats {
+		if interval == 0 {
+			return ranges[0]
+		}
+		return ranges[(blockNum-(start-start%interval))/interval]
+	}
+
+	if interval == 0 {
+		ranges = append(ranges, newRangeStats(start, stop))
+	} else {
+		for base := start - start%interval; base < stop; base += interval {
+			ranges = append(ranges, newRangeStats(max(base, start), min(base+interval, stop)))
+		}
+	}
+
+	group, ctx := errgroup.WithContext(ctx)
+	group.SetLimit(workers)
+
+	lock := sync.Mutex{}
+	for base := mergedBlocksBundleBase(start); base < stop; base += mergedBlocksBundleSize {
+		base := base
+		group.Go(func() error {
+			// Skipped heights are counted from the start of the range the bundle belongs to
+			bundle := newRangeStats(max(rangeOf(base).StartBlock, start), stop)
+			err := readMergedBlocksBundle(ctx, store, base, func(block *pbnear.Block) error {
+				if block.Num() >= start && block.Num() < stop {
+					bundle.addBlock(block)
+				}
+				return nil
+			})
+			if err != nil {
+				return mergedBlocksRangeError(base, err)
+			}
+
+			lock.Lock()
+			defer lock.Unlock()
+			rangeOf(base).merge(bundle)
+			return nil
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+
+	return ranges, nil
+}
+
+func printRangeStats(out io.Writer, stats *rangeStats) error {
+	var lines []string
+	add := func(format string, args ...any) {
+		lines = append(lines, fmt.Sprintf(format, args...))
+	}
+
+	add("Blocks #%d to #%d (exclusive): %d blocks, %d skipped heights, average block time %.0fms", stats.StartBlock, stats.StopBlock, stats.Blocks, stats.SkippedHeights, stats.AverageBlockTimeMS)
+	lines = append(lines, activityStatsLines(stats.activityStats, "  ")...)
+
+	for _, shard := range stats.Shards {
+		add("  Shard %d", shard.ShardID)
+		lines = append(lines, activityStatsLines(shard.activityStats, "    ")...)
+	}
+
+	_, err := fmt.Fprintln(out, strings.Join(lines, "\n"))
+	return err
+}
+
+func activityStatsLines(stats *activityStats, indent string) (lines []string) {
+	add := func(format string, args ...any) {
+		lines = append(lines, indent+fmt.Sprintf(format, args...))
+	}
+
+	add("Chunks: %d, transactions: %d, receipts: %d", stats.Chunks, stats.Transactions, stats.Receipts)
+	add("Gas used: %d / %d, tokens burnt: %s NEAR", stats.GasUsed, stats.GasLimit, stats.TokensBurnt.NEAR)
+	add("Actions: %s", formatCounts(stats.Actions))
+
+	failureRate := 0.0
+	if stats.Receipts > 0 {
+		failureRate = float64(stats.FailedReceipts) / float64(stats.Receipts)
+	}
+	add("Failed receipts: %d (%.2f%%) %s", stats.FailedReceipts, failureRate*100, formatCounts(stats.Failures))
+
+	add("Top receivers: %s", formatAccountCounts(stats.TopReceivers))
+	add("Top signers: %s", formatAccountCounts(stats.TopSigners))
+	return
+}
+
+// formatCounts renders counts sorted by decreasing count, ex: `transfer=12, function_call=3`
+func formatCounts(counts map[string]uint64) string {
+	sorted := topAccounts(counts, len(counts))
+	return formatAccountCounts(sorted)
+}
+
+func formatAccountCounts(counts []*accountCount) string {
+	if len(counts) == 0 {
+		return "-"
+	}
+
+	elements := make([]string, len(counts))
+	for i, count := range counts {
+		elements[i] = fmt.Sprintf("%s=%d", count.Account, count.Count)
+	}
+	return strings.Join(elements, ", ")
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"testing"
+
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRangeStats(t *testing.T) {
+	failure := func(kind *pbnear.ActionError) *pbnear.ExecutionOutcomeWithId {
+		return &pbnear.ExecutionOutcomeWithId{Outcome: &pbnear.ExecutionOutcome{
+			TokensBurnt: &pbnear.BigInt{Bytes: []byte{1}},
+			Status:      &pbnear.ExecutionOutcome_Failure{Failure: &pbnear.FailureExecutionStatus{Failure: &pbnear.FailureExecutionStatus_ActionError{ActionError: kind}}},
+		}}
+	}
+
+	newBlock := func(height, prevHeight uint64, seconds uint64, receivers ...string) *pbnear.Block {
+		shard := &pbnear.IndexerShard{
+			ShardId: height % 2,
+			Chunk: &pbnear.IndexerChunk{
+				Header: &pbnear.ChunkHeader{GasUsed: 10, GasLimit: 100},
+				Transactions: []*pbnear.IndexerTransactionWithOutcome{
+					{Transaction: &pbnear.SignedTransaction{SignerId: "alice.near", Actions: []*pbnear.Action{{Action: &pbnear.Action_Transfer{Transfer: &pbnear.TransferAction{}}}}}},
+				},
+			},
+		}
+
+		for _, receiver := range receivers {
+			shard.ReceiptExecutionOutcomes = append(shard.ReceiptExecutionOutcomes, &pbnear.IndexerExecutionOutcomeWithReceipt{
+				Receipt:          &pbnear.Receipt{ReceiverId: receiver},
+				ExecutionOutcome: failure(&pbnear.ActionError{Kind: &pbnear.ActionError_AccountDoesNotExist{AccountDoesNotExist: &pbnear.AccountDoesNotExistErrorKind{}}}),
+			})
+		}
+
+		return &pbnear.Block{
+			Header: &pbnear.BlockHeader{Height: height, PrevHeight: prevHeight, TimestampNanosec: seconds * 1_000_000_000},
+			Shards: []*pbnear.IndexerShard{shard},
+		}
+	}
+
+	// Two sub ranges merged out of order, as done by parallel workers
+	first := newRangeStats(10, 20)
+	first.addBlock(newBlock(12, 8, 100, "bob.near"))
+	first.addBlock(newBlock(13, 12, 101))
+
+	second := newRangeStats(10, 20)
+	second.addBlock(newBlock(16, 13, 104, "bob.near", "carol.near"))
+
+	stats := newRangeStats(10, 20)
+	stats.merge(second)
+	stats.merge(first)
+	stats.finalize(1)
+
+	assert.Equal(t, uint64(3), stats.Blocks)
+	// Heights 10 and 11 before block 12 then 14 and 15 before block 16
+	assert.Equal(t, uint64(4), stats.SkippedHeights)
+	assert.Equal(t, 2000.0, stats.AverageBlockTimeMS)
+
+	assert.Equal(t, uint64(3), stats.Chunks)
+	assert.Equal(t, uint64(30), stats.GasUsed)
+	assert.Equal(t, uint64(300), stats.GasLimit)
+	assert.Equal(t, uint64(3), stats.Transactions)
+	assert.Equal(t, uint64(3), stats.Receipts)
+	assert.Equal(t, map[string]uint64{"transfer": 3}, stats.Actions)
+	assert.Equal(t, "3", stats.TokensBurnt.Yocto)
+	assert.Equal(t, uint64(3), stats.FailedReceipts)
+	assert.Equal(t, map[string]float64{"account_does_not_exist": 1}, stats.FailureRates)
+	assert.Equal(t, []*accountCount{{Account: "bob.near", Count: 2}}, stats.TopReceivers)
+	assert.Equal(t, []*accountCount{{Account: "alice.near", Count: 3}}, stats.TopSigners)
+
+	if assert.Len(t, stats.Shards, 2) {
+		assert.Equal(t, uint64(0), stats.Shards[0].ShardID)
+		assert.Equal(t, uint64(2), stats.Shards[0].Transactions)
+		assert.Equal(t, []*accountCount{{Account: "bob.near", Count: 2}}, stats.Shards[0].TopReceivers)
+		assert.Equal(t, uint64(1), stats.Shards[1].ShardID)
+		assert.Equal(t, uint64(1), stats.Shards[1].Transactions)
+		assert.Empty(t, stats.Shards[1].TopReceivers)
+	}
+}
+
+func TestRangeStats_MissingPrevHeight(t *testing.T) {
+	newBlock := func(height, prevHeight uint64) *pbnear.Block {
+		return &pbnear.Block{Header: &pbnear.BlockHeader{Height: height, PrevHeight: prevHeight}}
+	}
+
+	stats := newRangeStats(1000, 2000)
+	stats.addBlock(newBlock(1500, 0))
+	stats.addBlock(newBlock(1501, 0))
+	stats.addBlock(newBlock(1504, 0))
+	stats.addBlock(newBlock(1506, 1505))
+
+	// Heights 1502 and 1503 before block 1504 are skipped, the heights before the first block
+	// are not known
+	assert.Equal(t, uint64(4), stats.Blocks)
+	assert.Equal(t, uint64(2), stats.SkippedHeights)
+}
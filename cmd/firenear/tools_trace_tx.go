@@ -0,0 +1,285 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/cli"
+	"github.com/streamingfast/cli/sflags"
+	"github.com/streamingfast/dstore"
+	firecore "github.com/streamingfast/firehose-core"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+)
+
+func newToolsTraceTxCmd[B firecore.Block](chain *firecore.Chain[B]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trace-tx <tx_hash>",
+		Short: "Follow a transaction's receipt tree across blocks and print the outcome of each receipt",
+		Long: cli.Dedent(`
+			Locate the transaction in merged blocks starting at --start-block, then walk the following
+			blocks collecting the outcome of every receipt descending from it (through the outcomes'
+			receipt IDs) until the tree is complete or --block-limit heights have been walked.
+
+			The transaction hash can be given in base58, as displayed by NEAR explorers, or in hex.
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: traceTxE,
+		Example: firecore.ExamplePrefixed(chain, "tools", `
+			# Trace a transaction included at or after block 100000000
+			trace-tx --start-block=100000000 4hCGYU8NGvyfTGuhFnbPvnrqAkKK5Y6Hjmo6h1qMp2Rr
+
+			# Same, as JSON, using 'tx-lookup' first to find the block
+			trace-tx --start-block=100000042 -o json 4hCGYU8NGvyfTGuhFnbPvnrqAkKK5Y6Hjmo6h1qMp2Rr
+		`),
+	}
+
+	cmd.Flags().Uint64("start-block", 0, "Block height at which to start looking for the transaction (required)")
+	cmd.Flags().Uint64("block-limit", 500, "Maximum number of block heights walked, both to find the transaction and then to collect its receipts")
+	cmd.Flags().String("merged-blocks-store-url", "file://./firehose-data/storage/merged-blocks", "Store URL where merged blocks are read from")
+	cmd.Flags().StringP("output", "o", "text", "Output format, one of 'text' or 'json'")
+
+	cmd.MarkFlagRequired("start-block")
+
+	return cmd
+}
+
+type traceNode struct {
+	Kind          string       `json:"kind"`
+	ID            string       `json:"id"`
+	Executed      bool         `json:"executed"`
+	BlockNum      uint64       `json:"block_num"`
+	ShardID       uint64       `json:"shard_id"`
+	PredecessorID string       `json:"predecessor_id,omitempty"`
+	ReceiverID    string       `json:"receiver_id,omitempty"`
+	ExecutorID    string       `json:"executor_id,omitempty"`
+	Actions       []string     `json:"actions,omitempty"`
+	Status        string       `json:"status,omitempty"`
+	GasBurnt      uint64       `json:"gas_burnt"`
+	TokensBurnt   string       `json:"tokens_burnt,omitempty"`
+	Logs          []string     `json:"logs,omitempty"`
+	Children      []*traceNode `json:"children,omitempty"`
+}
+
+type traceResult struct {
+	Complete    bool       `json:"complete"`
+	LastBlock   uint64     `json:"last_block"`
+	Transaction *traceNode `json:"transaction"`
+}
+
+var errTraceDone = errors.New("trace done")
+
+func traceTxE(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	output := sflags.MustGetString(cmd, "output")
+	if err := checkOutputFormat(output, "text", "json"); err != nil {
+		return err
+	}
+
+	hash, err := parseCryptoHash(args[0])
+	if err != nil {
+		return err
+	}
+
+	store, err := dstore.NewDBinStore(sflags.MustGetString(cmd, "merged-blocks-store-url"))
+	if err != nil {
+		return fmt.Errorf("unable to create merged blocks store: %w", err)
+	}
+
+	result, err := traceTransaction(ctx, store, hash, sflags.MustGetUint64(cmd, "start-block"), sflags.MustGetUint64(cmd, "block-limit"))
+	if err != nil {
+		return err
+	}
+
+	if output == "json" {
+		content, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return fmt.Errorf("marshal trace: %w", err)
+		}
+
+		_, err = fmt.Fprintln(cmd.OutOrStdout(), string(content))
+		return err
+	}
+
+	return printTraceResult(cmd.OutOrStdout(), result)
+}
+
+// traceTransaction walks the merged blocks from startBlock to find the transaction and then
+// the receipts descending from it. Receipts created by an outcome are not executed before the
+// block of the outcome, they can however be executed in the same block, which is why a block is
+// scanned until no more of its outcomes belong to the tree.
+func traceTransaction(ctx context.Context, store dstore.Store, hash []byte, startBlock, blockLimit uint64) (*traceResult, error) {
+	result := &traceResult{}
+	pending := make(map[string]*traceNode)
+
+	var txBlockNum uint64
+	processBlock := func(block *pbnear.Block) error {
+		if block.Num() < startBlock {
+			return nil
+		}
+
+		if result.Transaction == nil {
+			if block.Num() > startBlock+blockLimit {
+				return fmt.Errorf("transaction %s not found between blocks #%d and #%d", base58Hash(hash), startBlock, startBlock+blockLimit)
+			}
+
+			result.Transaction = findTraceTransaction(block, hash, pending)
+			if result.Transaction == nil {
+				return nil
+			}
+			txBlockNum = block.Num()
+		}
+
+		if block.Num() > txBlockNum+blockLimit {
+			return errTraceDone
+		}
+
+		result.LastBlock = block.Num()
+		for collectTraceReceipts(block, pending) {
+		}
+
+		if len(pending) == 0 {
+			return errTraceDone
+		}
+		return nil
+	}
+
+	for base := mergedBlocksBundleBase(startBlock); ; base += mergedBlocksBundleSize {
+		err := readMergedBlocksBundle(ctx, store, base, processBlock)
+		if errors.Is(err, errTraceDone) {
+			break
+		}
+
+		if errors.Is(err, dstore.ErrNotFound) {
+			if result.Transaction == nil {
+				return nil, fmt.Errorf("transaction %s not found before the end of available merged blocks", base58Hash(hash))
+			}
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result.Complete = len(pending) == 0
+	return result, nil
+}
+
+func findTraceTransaction(block *pbnear.Block, hash []byte, pending map[string]*traceNode) *traceNode {
+	for _, shard := range block.Shards {
+		if shard.Chunk == nil {
+			continue
+		}
+
+		for _, trx := range shard.Chunk.Transactions {
+			if !bytes.Equal(trx.Transaction.GetHash().GetBytes(), hash) {
+				continue
+			}
+
+			node := &traceNode{
+				Kind:          "transaction",
+				ID:            base58Hash(hash),
+				PredecessorID: trx.Transaction.SignerId,
+				ReceiverID:    trx.Transaction.ReceiverId,
+			}
+			fillTraceNode(node, block, shard.ShardId, trx.Transaction.Actions, trx.Outcome.GetExecutionOutcome().GetOutcome(), pending)
+
+			return node
+		}
+	}
+
+	return nil
+}
+
+// collectTraceReceipts fills the pending receipts executed in the block, returns true if any was found
+func collectTraceReceipts(block *pbnear.Block, pending map[string]*traceNode) (found bool) {
+	for _, shard := range block.Shards {
+		for _, outcome := range shard.ReceiptExecutionOutcomes {
+			receiptID := outcome.Receipt.GetReceiptId().AsBase58String()
+			node, isPending := pending[receiptID]
+			if !isPending {
+				continue
+			}
+
+			delete(pending, receiptID)
+			found = true
+
+			node.PredecessorID = outcome.Receipt.PredecessorId
+			node.ReceiverID = outcome.Receipt.ReceiverId
+			fillTraceNode(node, block, shard.ShardId, outcome.Receipt.GetAction().GetActions(), outcome.ExecutionOutcome.GetOutcome(), pending)
+		}
+	}
+
+	return
+}
+
+func fillTraceNode(node *traceNode, block *pbnear.Block, shardID uint64, actions []*pbnear.Action, outcome *pbnear.ExecutionOutcome, pending map[string]*traceNode) {
+	node.Executed = true
+	node.BlockNum = block.Num()
+	node.ShardID = shardID
+	node.ExecutorID = outcome.GetExecutorId()
+	node.Status = "unknown"
+	node.GasBurnt = outcome.GetGasBurnt()
+	node.TokensBurnt = outcome.GetTokensBurnt().AsBigInt().String()
+	node.Logs = outcome.GetLogs()
+
+	if outcome != nil {
+		node.Status = outcomeStatus(outcome)
+	}
+
+	for _, action := range actions {
+		node.Actions = append(node.Actions, actionSummary(action))
+	}
+
+	for _, receiptID := range outcome.GetReceiptIds() {
+		child := &traceNode{Kind: "receipt", ID: receiptID.AsBase58String()}
+		node.Children = append(node.Children, child)
+		pending[child.ID] = child
+	}
+}
+
+func printTraceResult(out io.Writer, result *traceResult) error {
+	var lines []string
+	var printNode func(node *traceNode, depth int)
+	printNode = func(node *traceNode, depth int) {
+		indent := strings.Repeat("  ", depth)
+		if !node.Executed {
+			lines = append(lines, fmt.Sprintf("%s- %s %s (not executed before block #%d)", indent, node.Kind, node.ID, result.LastBlock))
+			return
+		}
+
+		lines = append(lines,
+			fmt.Sprintf("%s- %s %s [block #%d, shard %d] %s -> %s", indent, node.Kind, node.ID, node.BlockNum, node.ShardID, node.PredecessorID, node.ReceiverID),
+			fmt.Sprintf("%s  executor: %s, status: %s", indent, node.ExecutorID, node.Status),
+			fmt.Sprintf("%s  gas burnt: %d, tokens burnt: %s yoctoNEAR", indent, node.GasBurnt, node.TokensBurnt),
+		)
+
+		for _, action := range node.Actions {
+			lines = append(lines, fmt.Sprintf("%s  action: %s", indent, action))
+		}
+
+		for _, log := range node.Logs {
+			lines = append(lines, fmt.Sprintf("%s  log: %s", indent, log))
+		}
+
+		for _, child := range node.Children {
+			printNode(child, depth+1)
+		}
+	}
+
+	printNode(result.Transaction, 0)
+
+	if !result.Complete {
+		lines = append(lines, fmt.Sprintf("Trace incomplete, some receipts were not executed up to block #%d, increase --block-limit to walk more blocks", result.LastBlock))
+	}
+
+	_, err := fmt.Fprintln(out, strings.Join(lines, "\n"))
+	return err
+}
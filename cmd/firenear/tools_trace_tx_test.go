@@ -0,0 +1,126 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraceTransaction(t *testing.T) {
+	id := func(b byte) *pbnear.CryptoHash {
+		return &pbnear.CryptoHash{Bytes: bytes.Repeat([]byte{b}, 32)}
+	}
+
+	outcome := func(executor string, gasBurnt uint64, receiptIDs ...byte) *pbnear.ExecutionOutcome {
+		out := &pbnear.ExecutionOutcome{
+			ExecutorId:  executor,
+			GasBurnt:    gasBurnt,
+			TokensBurnt: &pbnear.BigInt{Bytes: []byte{1}},
+			Status:      &pbnear.ExecutionOutcome_SuccessValue{SuccessValue: &pbnear.SuccessValueExecutionStatus{}},
+		}
+		for _, receiptID := range receiptIDs {
+			out.ReceiptIds = append(out.ReceiptIds, id(receiptID))
+		}
+		return out
+	}
+
+	receiptOutcome := func(receiptID byte, predecessor, receiver string, out *pbnear.ExecutionOutcome) *pbnear.IndexerExecutionOutcomeWithReceipt {
+		return &pbnear.IndexerExecutionOutcomeWithReceipt{
+			ExecutionOutcome: &pbnear.ExecutionOutcomeWithId{Outcome: out},
+			Receipt:          &pbnear.Receipt{ReceiptId: id(receiptID), PredecessorId: predecessor, ReceiverId: receiver},
+		}
+	}
+
+	transaction := func(hash byte, out *pbnear.ExecutionOutcome) *pbnear.IndexerTransactionWithOutcome {
+		trx := &pbnear.IndexerTransactionWithOutcome{
+			Transaction: &pbnear.SignedTransaction{Hash: id(hash), SignerId: "alice.near", ReceiverId: "app.near"},
+		}
+		if out != nil {
+			trx.Outcome = &pbnear.IndexerExecutionOutcomeWithOptionalReceipt{ExecutionOutcome: &pbnear.ExecutionOutcomeWithId{Outcome: out}}
+		}
+		return trx
+	}
+
+	block := func(height uint64, shards ...*pbnear.IndexerShard) *pbnear.Block {
+		return &pbnear.Block{Header: &pbnear.BlockHeader{Height: height, Hash: id(byte(height))}, Shards: shards}
+	}
+
+	store := newTestMergedBlocksStore(t,
+		block(98),
+		block(99,
+			// Transaction 1 creates receipts 10 and 11, transaction 2 has no outcome
+			&pbnear.IndexerShard{ShardId: 0, Chunk: &pbnear.IndexerChunk{Transactions: []*pbnear.IndexerTransactionWithOutcome{
+				transaction(1, outcome("alice.near", 10, 10, 11)),
+				transaction(2, nil),
+			}}},
+			// Receipt 10 is executed in the same block as the transaction, creating receipts 12 and 13
+			&pbnear.IndexerShard{ShardId: 1, ReceiptExecutionOutcomes: []*pbnear.IndexerExecutionOutcomeWithReceipt{
+				receiptOutcome(10, "alice.near", "app.near", outcome("app.near", 20, 12, 13)),
+			}},
+		),
+		// Receipts 11 and 12 are executed in the next merged blocks file, receipt 13 never is
+		block(100, &pbnear.IndexerShard{ShardId: 0, ReceiptExecutionOutcomes: []*pbnear.IndexerExecutionOutcomeWithReceipt{
+			receiptOutcome(11, "alice.near", "app.near", outcome("app.near", 30)),
+		}}),
+		block(102, &pbnear.IndexerShard{ShardId: 1, ReceiptExecutionOutcomes: []*pbnear.IndexerExecutionOutcomeWithReceipt{
+			receiptOutcome(12, "app.near", "token.near", outcome("token.near", 40)),
+		}}),
+		block(103),
+	)
+
+	ctx := context.Background()
+
+	result, err := traceTransaction(ctx, store, id(1).Bytes, 98, 500)
+	require.NoError(t, err)
+	assert.False(t, result.Complete)
+	assert.Equal(t, uint64(103), result.LastBlock)
+
+	trx := result.Transaction
+	assert.Equal(t, "transaction", trx.Kind)
+	assert.Equal(t, uint64(99), trx.BlockNum)
+	require.Len(t, trx.Children, 2)
+
+	first, second := trx.Children[0], trx.Children[1]
+	assert.Equal(t, uint64(99), first.BlockNum)
+	assert.Equal(t, uint64(1), first.ShardID)
+	assert.Equal(t, uint64(100), second.BlockNum)
+	assert.Equal(t, uint64(30), second.GasBurnt)
+	assert.Empty(t, second.Children)
+
+	require.Len(t, first.Children, 2)
+	assert.True(t, first.Children[0].Executed)
+	assert.Equal(t, uint64(102), first.Children[0].BlockNum)
+	assert.Equal(t, "token.near", first.Children[0].ExecutorID)
+	assert.False(t, first.Children[1].Executed)
+	assert.Equal(t, id(13).AsBase58String(), first.Children[1].ID)
+
+	output := &bytes.Buffer{}
+	require.NoError(t, printTraceResult(output, result))
+	assert.Contains(t, output.String(), "- receipt "+id(13).AsBase58String()+" (not executed before block #103)")
+	assert.Contains(t, output.String(), "Trace incomplete")
+
+	// The block limit stops the walk before receipt 12 is executed
+	result, err = traceTransaction(ctx, store, id(1).Bytes, 98, 2)
+	require.NoError(t, err)
+	assert.False(t, result.Complete)
+	assert.Equal(t, uint64(100), result.LastBlock)
+	assert.False(t, result.Transaction.Children[0].Children[0].Executed)
+
+	// A transaction without outcome has no status nor receipts
+	result, err = traceTransaction(ctx, store, id(2).Bytes, 98, 500)
+	require.NoError(t, err)
+	assert.True(t, result.Complete)
+	assert.Equal(t, "unknown", result.Transaction.Status)
+	assert.Equal(t, uint64(0), result.Transaction.GasBurnt)
+	assert.Empty(t, result.Transaction.Children)
+
+	_, err = traceTransaction(ctx, store, id(3).Bytes, 98, 500)
+	assert.ErrorContains(t, err, "not found before the end of available merged blocks")
+
+	_, err = traceTransaction(ctx, store, id(3).Bytes, 98, 3)
+	assert.ErrorContains(t, err, "not found between blocks #98 and #101")
+}
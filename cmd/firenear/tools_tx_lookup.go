@@ -0,0 +1,281 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/cli"
+	"github.com/streamingfast/cli/sflags"
+	"github.com/streamingfast/dstore"
+	firecore "github.com/streamingfast/firehose-core"
+	"github.com/streamingfast/firehose-core/types"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+	nearTransform "github.com/streamingfast/firehose-near/transform"
+)
+
+func newToolsTxLookupCmd[B firecore.Block](chain *firecore.Chain[B]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tx-lookup <hash>",
+		Short: "Find the block, shard and outcome of a transaction or receipt using the transaction index built by the 'index-builder' app",
+		Long: cli.Dedent(`
+			Find the block, shard and outcome of a transaction or receipt using the 'trxid' index
+			built by the 'index-builder' app. The hash can be given in base58, as displayed by
+			NEAR explorers, or in hex.
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: txLookupE,
+		Example: firecore.ExamplePrefixed(chain, "tools", `
+			# Look up a transaction hash in the default local stores
+			tx-lookup 4hCGYU8NGvyfTGuhFnbPvnrqAkKK5Y6Hjmo6h1qMp2Rr
+
+			# Look up a receipt ID in remote stores, restricting the search to a block range
+			tx-lookup --merged-blocks-store-url=gs://bucket/merged-blocks --index-store-url=gs://bucket/index --range=100000000:110000000 8bKtsYiAwmPw3pgEhbBzMGnBXvhkMeHh3FvmH4Dx4pKb
+		`),
+	}
+
+	cmd.Flags().String("merged-blocks-store-url", "file://./firehose-data/storage/merged-blocks", "Store URL where merged blocks are read from")
+	cmd.Flags().String("index-store-url", "file://./firehose-data/storage/index", "Store URL where the 'trxid' index files are read from")
+	cmd.Flags().String("range", "", "Only search index files overlapping this block range, ex: '100000000:110000000'. When empty, every 'trxid' index file of the store is read, which is slow on large stores")
+	cmd.Flags().StringP("output", "o", "text", "Output format, one of 'text' or 'json' (one JSON object per line)")
+
+	return cmd
+}
+
+type txLookupResult struct {
+	Kind          string   `json:"kind"`
+	Hash          string   `json:"hash"`
+	BlockNum      uint64   `json:"block_num"`
+	BlockHash     string   `json:"block_hash"`
+	ShardID       uint64   `json:"shard_id"`
+	PredecessorID string   `json:"predecessor_id"`
+	ReceiverID    string   `json:"receiver_id"`
+	ExecutorID    string   `json:"executor_id"`
+	Status        string   `json:"status"`
+	GasBurnt      uint64   `json:"gas_burnt"`
+	TokensBurnt   string   `json:"tokens_burnt"`
+	Logs          []string `json:"logs"`
+	ReceiptIDs    []string `json:"receipt_ids"`
+}
+
+func txLookupE(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	output := sflags.MustGetString(cmd, "output")
+	if err := checkOutputFormat(output, "text", "json"); err != nil {
+		return err
+	}
+
+	hash, err := parseCryptoHash(args[0])
+	if err != nil {
+		return err
+	}
+
+	blockRange, err := types.GetBlockRangeFromArgDefault(sflags.MustGetString(cmd, "range"), types.NewOpenRange(0))
+	if err != nil {
+		return fmt.Errorf("invalid range: %w", err)
+	}
+
+	mergedBlocksStore, err := dstore.NewDBinStore(sflags.MustGetString(cmd, "merged-blocks-store-url"))
+	if err != nil {
+		return fmt.Errorf("unable to create merged blocks store: %w", err)
+	}
+
+	indexStore, err := dstore.NewStore(sflags.MustGetString(cmd, "index-store-url"), "", "", false)
+	if err != nil {
+		return fmt.Errorf("unable to create index store: %w", err)
+	}
+
+	blockNums, err := lookupTransactionIndex(ctx, indexStore, blockRange, base58Hash(hash))
+	if err != nil {
+		return err
+	}
+
+	var results []*txLookupResult
+	for _, blockNum := range blockNums {
+		block, err := readMergedBlock(ctx, mergedBlocksStore, blockNum)
+		if err != nil {
+			return fmt.Errorf("read block #%d: %w", blockNum, err)
+		}
+
+		if block == nil {
+			return fmt.Errorf("block #%d referenced by the index not found in merged blocks", blockNum)
+		}
+
+		results = append(results, findTransactionOrReceipt(block, hash)...)
+	}
+
+	if len(results) == 0 {
+		return fmt.Errorf("transaction or receipt %s not found in index", args[0])
+	}
+
+	for _, result := range results {
+		if err := printTxLookupResult(cmd.OutOrStdout(), result, output); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func base58Hash(hash []byte) string {
+	return (&pbnear.CryptoHash{Bytes: hash}).AsBase58String()
+}
+
+// lookupTransactionIndex returns the sorted block numbers recorded under key in the `trxid`
+// index files overlapping blockRange, the walk of the index store starting at the index files
+// covering the range's start block and stopping past its stop block.
+func lookupTransactionIndex(ctx context.Context, indexStore dstore.Store, blockRange types.BlockRange, key string) ([]uint64, error) {
+	start := uint64(blockRange.Start)
+
+	blocks := make(map[uint64]bool)
+	indexFileCount := 0
+	err := indexStore.WalkFrom(ctx, "", nearTransform.IndexWalkStartingPoint(start), func(filename string) error {
+		base, size, shortName, err := nearTransform.ParseIndexFilename(filename)
+		if err != nil || shortName != nearTransform.TransactionIndexShortName {
+			return nil
+		}
+
+		if blockRange.IsClosed() && base >= *blockRange.Stop {
+			return dstore.StopIteration
+		}
+
+		if base+size <= start {
+			return nil
+		}
+
+		reader, err := indexStore.OpenObject(ctx, filename)
+		if err != nil {
+			return fmt.Errorf("open index file %q: %w", filename, err)
+		}
+		defer reader.Close()
+
+		index, err := transform.ReadNewBlockIndex(reader)
+		if err != nil {
+			return fmt.Errorf("read index file %q: %w", filename, err)
+		}
+		indexFileCount++
+
+		if bitmap := index.Get(key); bitmap != nil {
+			for _, blockNum := range bitmap.ToArray() {
+				if blockRange.Contains(blockNum, types.RangeBoundaryExclusive) {
+					blocks[blockNum] = true
+				}
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("walk index store: %w", err)
+	}
+
+	if indexFileCount == 0 {
+		return nil, fmt.Errorf("no %q index files found overlapping range %s, they are produced by the 'index-builder' app", nearTransform.TransactionIndexShortName, blockRange)
+	}
+
+	out := make([]uint64, 0, len(blocks))
+	for blockNum := range blocks {
+		out = append(out, blockNum)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+
+	return out, nil
+}
+
+func findTransactionOrReceipt(block *pbnear.Block, hash []byte) (out []*txLookupResult) {
+	newResult := func(kind string, shardID uint64, outcome *pbnear.ExecutionOutcomeWithId) *txLookupResult {
+		result := &txLookupResult{
+			Kind:      kind,
+			Hash:      base58Hash(hash),
+			BlockNum:  block.GetHeader().GetHeight(),
+			BlockHash: block.GetHeader().GetHash().AsBase58String(),
+			ShardID:   shardID,
+			Status:    "unknown",
+		}
+
+		if o := outcome.GetOutcome(); o != nil {
+			result.ExecutorID = o.ExecutorId
+			result.Status = outcomeStatus(o)
+			result.GasBurnt = o.GasBurnt
+			result.TokensBurnt = o.TokensBurnt.AsBigInt().String()
+			result.Logs = o.Logs
+			for _, receiptID := range o.ReceiptIds {
+				result.ReceiptIDs = append(result.ReceiptIDs, receiptID.AsBase58String())
+			}
+		}
+
+		return result
+	}
+
+	for _, shard := range block.Shards {
+		if shard.Chunk != nil {
+			for _, trx := range shard.Chunk.Transactions {
+				if bytes.Equal(trx.Transaction.GetHash().GetBytes(), hash) {
+					result := newResult("transaction", shard.ShardId, trx.GetOutcome().GetExecutionOutcome())
+					result.PredecessorID = trx.GetTransaction().GetSignerId()
+					result.ReceiverID = trx.GetTransaction().GetReceiverId()
+					out = append(out, result)
+				}
+			}
+		}
+
+		for _, outcome := range shard.ReceiptExecutionOutcomes {
+			if bytes.Equal(outcome.Receipt.GetReceiptId().GetBytes(), hash) {
+				result := newResult("receipt", shard.ShardId, outcome.ExecutionOutcome)
+				result.PredecessorID = outcome.GetReceipt().GetPredecessorId()
+				result.ReceiverID = outcome.GetReceipt().GetReceiverId()
+				out = append(out, result)
+			}
+		}
+	}
+
+	return
+}
+
+func printTxLookupResult(out io.Writer, result *txLookupResult, format string) error {
+	if format == "json" {
+		content, err := json.Marshal(result)
+		if err != nil {
+			return fmt.Errorf("marshal result: %w", err)
+		}
+
+		_, err = fmt.Fprintln(out, string(content))
+		return err
+	}
+
+	lines := []string{
+		fmt.Sprintf("%s %s", strings.ToUpper(result.Kind[:1])+result.Kind[1:], result.Hash),
+		fmt.Sprintf("  Block: #%d (%s)", result.BlockNum, result.BlockHash),
+		fmt.Sprintf("  Shard: %d", result.ShardID),
+		fmt.Sprintf("  From: %s", result.PredecessorID),
+		fmt.Sprintf("  To: %s", result.ReceiverID),
+		fmt.Sprintf("  Executor: %s", result.ExecutorID),
+		fmt.Sprintf("  Status: %s", result.Status),
+		fmt.Sprintf("  Gas burnt: %d", result.GasBurnt),
+		fmt.Sprintf("  Tokens burnt: %s yoctoNEAR", result.TokensBurnt),
+	}
+
+	if len(result.ReceiptIDs) > 0 {
+		lines = append(lines, "  Receipts:")
+		for _, receiptID := range result.ReceiptIDs {
+			lines = append(lines, "  - "+receiptID)
+		}
+	}
+
+	if len(result.Logs) > 0 {
+		lines = append(lines, "  Logs:")
+		for _, log := range result.Logs {
+			lines = append(lines, "  - "+log)
+		}
+	}
+
+	_, err := fmt.Fprintln(out, strings.Join(lines, "\n"))
+	return err
+}
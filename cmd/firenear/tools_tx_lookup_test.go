@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/dstore"
+	"github.com/streamingfast/firehose-core/types"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+	nearTransform "github.com/streamingfast/firehose-near/transform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// openRecordingStore records the files opened through it
+type openRecordingStore struct {
+	dstore.Store
+	opened []string
+}
+
+func (s *openRecordingStore) OpenObject(ctx context.Context, name string) (io.ReadCloser, error) {
+	s.opened = append(s.opened, name)
+	return s.Store.OpenObject(ctx, name)
+}
+
+func TestLookupTransactionIndex(t *testing.T) {
+	ctx := context.Background()
+
+	store, err := dstore.NewStore("file://"+t.TempDir(), "", "", false)
+	require.NoError(t, err)
+
+	indexer := transform.NewBlockIndexer(store, 100_000, nearTransform.TransactionIndexShortName)
+	indexer.Add([]string{"trx"}, 0)
+	indexer.Add([]string{"trx"}, 150_000)
+	indexer.Add([]string{"trx"}, 250_000)
+	indexer.Add([]string{"other"}, 300_000)
+
+	// Files of other indexes and unrelated files in the store are ignored
+	others := transform.NewBlockIndexer(store, 100_000, nearTransform.ReceiptAddressIndexShortName)
+	others.Add([]string{"trx"}, 100_000)
+	others.Add(nil, 200_000)
+	require.NoError(t, store.WriteObject(ctx, "0000100000.README", strings.NewReader("not an index")))
+
+	tests := []struct {
+		name         string
+		blockRange   types.BlockRange
+		expected     []uint64
+		expectedRead []string
+		expectedErr  string
+	}{
+		{
+			name:       "open range",
+			blockRange: types.NewOpenRange(0),
+			expected:   []uint64{0, 150_000, 250_000},
+			expectedRead: []string{
+				"0000000000.100000.trxid.idx",
+				"0000100000.100000.trxid.idx",
+				"0000200000.100000.trxid.idx",
+			},
+		},
+		{
+			name:         "open range from a later block",
+			blockRange:   types.NewOpenRange(160_000),
+			expected:     []uint64{250_000},
+			expectedRead: []string{"0000100000.100000.trxid.idx", "0000200000.100000.trxid.idx"},
+		},
+		{
+			name:         "closed range",
+			blockRange:   types.NewClosedRange(120_000, 200_000),
+			expected:     []uint64{150_000},
+			expectedRead: []string{"0000100000.100000.trxid.idx"},
+		},
+		{
+			name:        "range past the index files",
+			blockRange:  types.NewClosedRange(400_000, 500_000),
+			expectedErr: `no "trxid" index files found overlapping range`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			recording := &openRecordingStore{Store: store}
+
+			blockNums, err := lookupTransactionIndex(ctx, recording, test.blockRange, "trx")
+			if test.expectedErr != "" {
+				assert.ErrorContains(t, err, test.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, blockNums)
+			assert.Equal(t, test.expectedRead, recording.opened)
+		})
+	}
+}
+
+func TestFindTransactionOrReceiptPartialBlock(t *testing.T) {
+	hash := []byte{0x01, 0x02}
+
+	block := &pbnear.Block{
+		Shards: []*pbnear.IndexerShard{
+			{
+				ShardId: 1,
+				Chunk: &pbnear.IndexerChunk{
+					Transactions: []*pbnear.IndexerTransactionWithOutcome{
+						{Transaction: &pbnear.SignedTransaction{Hash: &pbnear.CryptoHash{Bytes: hash}}},
+						{},
+					},
+				},
+				ReceiptExecutionOutcomes: []*pbnear.IndexerExecutionOutcomeWithReceipt{{}},
+			},
+		},
+	}
+
+	results := findTransactionOrReceipt(block, hash)
+	require.Len(t, results, 1)
+	assert.Equal(t, "transaction", results[0].Kind)
+	assert.Equal(t, uint64(0), results[0].BlockNum)
+	assert.Equal(t, "", results[0].BlockHash)
+	assert.Equal(t, "unknown", results[0].Status)
+}
@@ -1,10 +1,13 @@
 package main
 
 import (
+	"bytes"
 	"fmt"
 
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/streamingfast/bstream"
 	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
 	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
 )
@@ -23,3 +26,105 @@ func blockUpgrader(block *pbbstream.Block) (*pbbstream.Block, error) {
 
 	return block, nil
 }
+
+// heightsRepairWindow is the amount of most recent block hashes remembered by the heightsRepairer,
+// as much as the console reader keeps to resolve the same heights
+const heightsRepairWindow = 2000
+
+// heightsRepair is a field of a block fixed by the heightsRepairer
+type heightsRepair struct {
+	BlockNum uint64 `json:"block_num"`
+	BlockID  string `json:"block_id"`
+	Field    string `json:"field"`
+	From     uint64 `json:"from"`
+	To       uint64 `json:"to"`
+}
+
+func (r *heightsRepair) String() string {
+	return fmt.Sprintf("#%d (%s) %s: %d -> %d", r.BlockNum, r.BlockID, r.Field, r.From, r.To)
+}
+
+// heightsRepairer repairs the `PrevHeight` and `LastFinalBlockHeight` of blocks that were produced
+// without them (the old console reader format or RPC outages) or with wrong ones, resolving the
+// heights of the previous and last final block hashes from the blocks seen before. Blocks must be
+// given in order, the heights of blocks referencing a block not seen yet (the first blocks of the
+// walk) are kept as is.
+type heightsRepairer struct {
+	heights map[string]uint64
+	// order is the seen block hashes from the oldest, used to forget hashes out of the window
+	order []string
+}
+
+func newHeightsRepairer() *heightsRepairer {
+	return &heightsRepairer{heights: make(map[string]uint64)}
+}
+
+// Observe records the height of the block without repairing it
+func (r *heightsRepairer) Observe(block *pbnear.Block) {
+	id := block.ID()
+	if _, found := r.heights[id]; found {
+		return
+	}
+
+	r.heights[id] = block.Num()
+	r.order = append(r.order, id)
+
+	if len(r.order) > heightsRepairWindow {
+		delete(r.heights, r.order[0])
+		r.order = r.order[1:]
+	}
+}
+
+// Repair fixes the heights of the block in place, returning the fixed fields, then observes it
+func (r *heightsRepairer) Repair(block *pbnear.Block) (repairs []*heightsRepair) {
+	header := block.Header
+
+	if height, found := r.resolve(header.PrevHash); found && header.PrevHeight != height {
+		repairs = append(repairs, &heightsRepair{BlockNum: block.Num(), BlockID: block.ID(), Field: "prev_height", From: header.PrevHeight, To: height})
+		header.PrevHeight = height
+	}
+
+	if height, found := r.resolve(header.LastFinalBlock); found && header.LastFinalBlockHeight != height {
+		repairs = append(repairs, &heightsRepair{BlockNum: block.Num(), BlockID: block.ID(), Field: "last_final_block_height", From: header.LastFinalBlockHeight, To: height})
+		header.LastFinalBlockHeight = height
+	}
+
+	r.Observe(block)
+	return repairs
+}
+
+func (r *heightsRepairer) resolve(hash *pbnear.CryptoHash) (uint64, bool) {
+	// The previous and last final block hash of the blocks following genesis is zero, block id 0
+	// does not exist
+	if id := hash.GetBytes(); len(id) > 0 && len(bytes.Trim(id, "\x00")) == 0 {
+		return bstream.GetProtocolFirstStreamableBlock, true
+	}
+
+	height, found := r.heights[hash.AsString()]
+	return height, found
+}
+
+// Upgrade is a merged block upgrader repairing the heights of the payload before upgrading the
+// envelope like blockUpgrader does, blocks must be given in order
+func (r *heightsRepairer) Upgrade(block *pbbstream.Block) (*pbbstream.Block, []*heightsRepair, error) {
+	nb := &pbnear.Block{}
+	if err := block.Payload.UnmarshalTo(nb); err != nil {
+		return nil, nil, fmt.Errorf("unmarshal block: %w", err)
+	}
+
+	repairs := r.Repair(nb)
+	if len(repairs) > 0 {
+		payload, err := anypb.New(nb)
+		if err != nil {
+			return nil, nil, fmt.Errorf("marshal repaired block: %w", err)
+		}
+		block.Payload = payload
+	}
+
+	block, err := blockUpgrader(block)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return block, repairs, nil
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/dstore"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func TestHeightsRepairer_Upgrade(t *testing.T) {
+	hash := func(height uint64) *pbnear.CryptoHash {
+		out := make([]byte, 32)
+		if height > 0 {
+			out[0], out[1] = byte(height), byte(height>>8)
+		}
+		return &pbnear.CryptoHash{Bytes: out}
+	}
+
+	newBlock := func(height, prev, lastFinal, prevHeight, lastFinalHeight uint64) *pbbstream.Block {
+		payload, err := anypb.New(&pbnear.Block{Header: &pbnear.BlockHeader{
+			Height: height, Hash: hash(height),
+			PrevHash: hash(prev), PrevHeight: prevHeight,
+			LastFinalBlock: hash(lastFinal), LastFinalBlockHeight: lastFinalHeight,
+		}})
+		require.NoError(t, err)
+
+		return &pbbstream.Block{Number: height, Id: hash(height).AsString(), ParentNum: prevHeight, LibNum: lastFinalHeight, Payload: payload}
+	}
+
+	repairer := newHeightsRepairer()
+	blocks := []*pbbstream.Block{
+		// Its previous block was not seen, kept as is
+		newBlock(100, 99, 98, 0, 0),
+		newBlock(101, 100, 99, 100, 0),
+		// Height 102 was skipped
+		newBlock(103, 101, 100, 0, 100),
+		newBlock(104, 103, 101, 103, 7),
+	}
+
+	var repairs []string
+	for i, blk := range blocks {
+		upgraded, blockRepairs, err := repairer.Upgrade(blk)
+		require.NoError(t, err)
+		blocks[i] = upgraded
+
+		for _, repair := range blockRepairs {
+			repairs = append(repairs, repair.String())
+		}
+	}
+
+	assert.Equal(t, []string{
+		"#103 (" + hash(103).AsString() + ") prev_height: 0 -> 101",
+		"#104 (" + hash(104).AsString() + ") last_final_block_height: 7 -> 101",
+	}, repairs)
+
+	store, err := dstore.NewDBinStore("file://" + t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, writeMergedBlocksBundle(context.Background(), store, 100, blocks))
+
+	var heights [][3]uint64
+	err = readMergedBlocksBundleRaw(context.Background(), store, 100, func(blk *pbbstream.Block) error {
+		block := &pbnear.Block{}
+		require.NoError(t, blk.Payload.UnmarshalTo(block))
+		assert.Equal(t, block.Header.PrevHeight, blk.ParentNum)
+		assert.Equal(t, block.Header.LastFinalBlockHeight, blk.LibNum)
+
+		heights = append(heights, [3]uint64{blk.Number, blk.ParentNum, blk.LibNum})
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, [][3]uint64{{100, 0, 0}, {101, 100, 0}, {103, 101, 100}, {104, 103, 101}}, heights)
+}
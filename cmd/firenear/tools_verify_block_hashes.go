@@ -0,0 +1,111 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/cli"
+	"github.com/streamingfast/cli/sflags"
+	"github.com/streamingfast/dstore"
+	firecore "github.com/streamingfast/firehose-core"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+)
+
+func newToolsVerifyBlockHashesCmd[B firecore.Block](chain *firecore.Chain[B]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-block-hashes <start>:<stop>",
+		Short: "Verify that the hash of each merged block is the hash of its header fields",
+		Long: cli.Dedent(`
+			Compute the NEAR block hash of each merged block of the range, the stop block being exclusive,
+			from the Borsh serialization of its header fields and report the blocks whose hash differs,
+			which are tampered or mis-decoded blocks. The command fails when such a block is found.
+
+			Blocks only carry the approvals that were received while NEAR hashes one entry per block
+			approver of the epoch. The missing approvals are tried at every possible position. The
+			number of approvers is fetched from --rpc-endpoint when set, otherwise up to
+			--max-missing-approvals missing approvals are tried until a block of the epoch verifies,
+			which gives the number of approvers of the epoch, the blocks of the epoch that do not verify
+			until then being counted as unverifiable instead of mismatches.
+
+			The header layout depends on the protocol version of the block's epoch, which headers do not
+			carry, so every layout possible for the latest protocol version of the header is tried.
+
+			The hash of some blocks cannot be verified: headers of protocol version 63 and later hash
+			the block body hash, a field merged blocks do not carry, and blocks missing too many
+			approvals have too many possible positions for them. They are counted as unverifiable.
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: verifyBlockHashesE,
+		Example: firecore.ExamplePrefixed(chain, "tools", `
+			# Verify 1000 blocks, learning the number of approvers from the blocks
+			verify-block-hashes 40000000:40001000
+
+			# Get the number of approvers of each epoch from an archive node
+			verify-block-hashes --rpc-endpoint=https://archival-rpc.mainnet.near.org 40000000:40001000
+		`),
+	}
+
+	cmd.Flags().String("merged-blocks-store-url", "file://./firehose-data/storage/merged-blocks", "Store URL where merged blocks are read from")
+	cmd.Flags().Int("max-missing-approvals", 2, "Number of missing approvals tried until the number of approvers of an epoch is known, when --rpc-endpoint is not set")
+	cmd.Flags().String("rpc-endpoint", "", "NEAR JSON-RPC endpoint the number of approvers of each epoch is fetched from with 'EXPERIMENTAL_validators_ordered', an archive node for old blocks")
+	cmd.Flags().Bool("show-unverifiable", false, "Also print the blocks whose hash cannot be verified and why")
+
+	return cmd
+}
+
+func verifyBlockHashesE(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	start, stop, err := parseClosedBlockRange(args[0])
+	if err != nil {
+		return err
+	}
+
+	store, err := dstore.NewDBinStore(sflags.MustGetString(cmd, "merged-blocks-store-url"))
+	if err != nil {
+		return fmt.Errorf("unable to create merged blocks store: %w", err)
+	}
+
+	var opts []pbnear.BlockHashVerifierOption
+	if endpoint := sflags.MustGetString(cmd, "rpc-endpoint"); endpoint != "" {
+		opts = append(opts, pbnear.WithBlockApproversSource(&rpcBlockApproversSource{rpc: newHTTPNearRPC(endpoint)}))
+	}
+
+	verifier := pbnear.NewBlockHashVerifier(sflags.MustGetInt(cmd, "max-missing-approvals"), opts...)
+	showUnverifiable := sflags.MustGetBool(cmd, "show-unverifiable")
+
+	verified, unverifiable, mismatches := 0, 0, 0
+	err = readMergedBlocksRange(ctx, store, start, stop, func(block *pbnear.Block) error {
+		err := verifier.Verify(ctx, block.Header)
+
+		var mismatch *pbnear.BlockHashMismatchError
+		switch {
+		case err == nil:
+			verified++
+		case errors.Is(err, pbnear.ErrBlockHashUnverifiable):
+			unverifiable++
+			if showUnverifiable {
+				fmt.Fprintf(cmd.OutOrStdout(), "#%d unverifiable: %s\n", block.Num(), err)
+			}
+		case errors.As(err, &mismatch):
+			mismatches++
+			fmt.Fprintf(cmd.OutOrStdout(), "#%d (%s) hash mismatch: fields hash to %s\n", block.Num(), block.Header.Hash.AsBase58String(), mismatch.Computed.AsBase58String())
+		default:
+			return fmt.Errorf("verify block #%d: %w", block.Num(), err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	summary := fmt.Sprintf("%d blocks verified, %d unverifiable, %d mismatches", verified, unverifiable, mismatches)
+	if mismatches > 0 {
+		return errors.New(summary)
+	}
+
+	fmt.Fprintln(cmd.ErrOrStderr(), summary)
+	return nil
+}
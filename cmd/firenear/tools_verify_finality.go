@@ -0,0 +1,296 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+
+	"github.com/mr-tron/base58"
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/cli"
+	"github.com/streamingfast/cli/sflags"
+	"github.com/streamingfast/dstore"
+	firecore "github.com/streamingfast/firehose-core"
+	"github.com/streamingfast/firehose-near/finality"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+)
+
+func newToolsVerifyFinalityCmd[B firecore.Block](chain *firecore.Chain[B]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-finality <start>:<stop>",
+		Short: "Verify the approvals of each merged block and prove which blocks are final",
+		Long: cli.Dedent(`
+			Verify the approval signatures of each merged block of the range, the stop block being
+			exclusive, like a NEAR light client does: the approvals must be signed by block producers of
+			the block's epoch holding more than two thirds of the epoch's stake. A block is proven final
+			when the next two blocks each endorse their previous block with such a quorum.
+
+			The block producers of the first epoch are trusted from --genesis-file, the neard genesis of
+			a chain verified from its first block, or from --checkpoint-file, a JSON document of the form:
+
+			  {"epoch_id": "<base58>", "block_producers": [{"account_id": "...", "public_key": "...", "stake": "..."}]}
+
+			the block producers being the output of the 'EXPERIMENTAL_validators_ordered' RPC method for
+			a block of the epoch. The block producers of the following epochs are fetched from
+			--rpc-endpoint and trusted only when they hash to the 'next_bp_hash' of an approved block of
+			the previous epoch, the range must then be contiguous from the checkpoint's epoch.
+
+			The hash of each block is verified from its header fields when possible, see
+			'verify-block-hashes'. Approvals sign block hashes, so the header fields of blocks whose hash
+			cannot be verified are not trusted: their 'next_bp_hash' does not make the block producers of
+			the next epoch trusted, and blocks are only proven final when their hash and the hash of
+			their next block are verified, the finality being reported as unproven otherwise. The
+			command fails on a hash mismatch, on untrusted block producers or when some blocks are not
+			approved with quorum.
+
+			The hash of blocks of protocol version 63 and later cannot be verified from their fields.
+			The light client block of each epoch, its last final block, is then fetched from
+			--rpc-endpoint with 'next_light_client_block': the hash of that block is verified from its
+			inner lite fields, which include the 'next_bp_hash', and the 'inner_rest_hash' given by the
+			node, like a light client does, so the block producers of the next epoch are trusted from
+			it. The light client block of the epoch the node is in is its last final block, the range
+			must then end in an epoch before it to cross into the next one.
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: verifyFinalityE,
+		Example: firecore.ExamplePrefixed(chain, "tools", `
+			# Verify a localnet from its genesis
+			verify-finality --genesis-file=./localnet/genesis.json 1:1000
+
+			# Verify mainnet blocks from a checkpoint, as JSONL
+			verify-finality --checkpoint-file=./checkpoint.json --rpc-endpoint=https://archival-rpc.mainnet.near.org -o jsonl 100000000:100100000
+		`),
+	}
+
+	cmd.Flags().String("merged-blocks-store-url", "file://./firehose-data/storage/merged-blocks", "Store URL where merged blocks are read from")
+	cmd.Flags().String("genesis-file", "", "neard genesis file whose validators are the trusted block producers of the first epoch")
+	cmd.Flags().String("checkpoint-file", "", "Checkpoint file with the trusted block producers of an epoch")
+	cmd.Flags().String("rpc-endpoint", "http://localhost:3030", "NEAR JSON-RPC endpoint the block producers of the following epochs are fetched from")
+	cmd.Flags().Int("max-missing-approvals", finality.DefaultMaxMissingApprovals, "Number of missing approvals tried until the number of approvers of an epoch is known, see 'verify-block-hashes'")
+	cmd.Flags().StringP("output", "o", "text", "Output format, one of 'text' or 'jsonl'")
+
+	return cmd
+}
+
+// blockFinality is the finality verification of a block as printed by verify-finality
+type blockFinality struct {
+	BlockNum uint64 `json:"block_num"`
+	BlockID  string `json:"block_id"`
+	// Approval is `endorsement` when the approvals endorse the previous block, `skip` otherwise
+	Approval      string `json:"approval"`
+	Approvals     int    `json:"approvals"`
+	Unmatched     int    `json:"unmatched"`
+	ApprovedStake string `json:"approved_stake"`
+	TotalStake    string `json:"total_stake"`
+	Quorum        bool   `json:"quorum"`
+	HashVerified  bool   `json:"hash_verified"`
+	// InnerLiteVerified is true when the block is the light client block of its epoch and its
+	// hash was verified from its inner lite fields
+	InnerLiteVerified bool `json:"inner_lite_verified,omitempty"`
+	// FinalizedNum and FinalizedID are the block proven final by the approvals of this block and
+	// of its previous block
+	FinalizedNum *uint64 `json:"finalized_block_num,omitempty"`
+	FinalizedID  string  `json:"finalized_block_id,omitempty"`
+	// FinalityUnproven is true when the approvals would prove a block final but the hashes the
+	// proof relies on could not be verified
+	FinalityUnproven bool `json:"finality_unproven,omitempty"`
+}
+
+func newBlockFinality(approvals *finality.BlockApprovals) *blockFinality {
+	out := &blockFinality{
+		BlockNum:          approvals.Height,
+		BlockID:           approvals.Hash.AsBase58String(),
+		Approval:          "skip",
+		Approvals:         approvals.Approvals,
+		Unmatched:         approvals.Unmatched,
+		ApprovedStake:     approvals.ApprovedStake.String(),
+		TotalStake:        approvals.TotalStake.String(),
+		Quorum:            approvals.Quorum,
+		HashVerified:      approvals.HashVerified,
+		InnerLiteVerified: approvals.InnerLiteVerified,
+		FinalityUnproven:  approvals.FinalityUnproven,
+	}
+
+	if approvals.Endorsement {
+		out.Approval = "endorsement"
+	}
+
+	if approvals.Finalized != nil {
+		out.FinalizedNum = &approvals.Finalized.Height
+		out.FinalizedID = approvals.Finalized.Hash.AsBase58String()
+	}
+
+	return out
+}
+
+func (f *blockFinality) String() string {
+	approved, _ := new(big.Int).SetString(f.ApprovedStake, 10)
+	total, _ := new(big.Int).SetString(f.TotalStake, 10)
+
+	percent := 0.0
+	if total.Sign() > 0 {
+		percent, _ = new(big.Rat).SetFrac(new(big.Int).Mul(approved, big.NewInt(100)), total).Float64()
+	}
+
+	line := fmt.Sprintf("#%d (%s) %s, %d approvals", f.BlockNum, f.BlockID, f.Approval, f.Approvals)
+	if f.Unmatched > 0 {
+		line += fmt.Sprintf(" (%d unmatched)", f.Unmatched)
+	}
+	line += fmt.Sprintf(", %.2f%% of stake", percent)
+
+	if f.Quorum {
+		line += ", quorum"
+	} else {
+		line += ", NO QUORUM"
+	}
+
+	switch {
+	case f.InnerLiteVerified:
+		line += ", inner lite verified"
+	case !f.HashVerified:
+		line += ", hash unverifiable"
+	}
+
+	if f.FinalizedNum != nil {
+		line += fmt.Sprintf(", finalizes #%d (%s)", *f.FinalizedNum, f.FinalizedID)
+	}
+
+	if f.FinalityUnproven {
+		line += ", finality unproven"
+	}
+
+	return line
+}
+
+func verifyFinalityE(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	output := sflags.MustGetString(cmd, "output")
+	if err := checkOutputFormat(output, "text", "jsonl"); err != nil {
+		return err
+	}
+
+	start, stop, err := parseClosedBlockRange(args[0])
+	if err != nil {
+		return err
+	}
+
+	checkpoint, err := readFinalityCheckpoint(sflags.MustGetString(cmd, "genesis-file"), sflags.MustGetString(cmd, "checkpoint-file"))
+	if err != nil {
+		return err
+	}
+
+	store, err := dstore.NewDBinStore(sflags.MustGetString(cmd, "merged-blocks-store-url"))
+	if err != nil {
+		return fmt.Errorf("unable to create merged blocks store: %w", err)
+	}
+
+	source := &rpcBlockProducersSource{rpc: newHTTPNearRPC(sflags.MustGetString(cmd, "rpc-endpoint"))}
+	verifier := finality.NewVerifier(checkpoint, source, finality.WithMaxMissingApprovals(sflags.MustGetInt(cmd, "max-missing-approvals")))
+
+	blockCount, withoutQuorum, finalized, unproven := 0, 0, 0, 0
+	err = readMergedBlocksRange(ctx, store, start, stop, func(block *pbnear.Block) error {
+		approvals, err := verifier.Verify(ctx, block.Header)
+		if err != nil {
+			return fmt.Errorf("verify block #%d: %w", block.Num(), err)
+		}
+
+		blockCount++
+		if !approvals.Quorum {
+			withoutQuorum++
+		}
+		if approvals.Finalized != nil {
+			finalized++
+		}
+		if approvals.FinalityUnproven {
+			unproven++
+		}
+
+		return printBlockFinality(cmd.OutOrStdout(), newBlockFinality(approvals), output)
+	})
+	if err != nil {
+		return err
+	}
+
+	if withoutQuorum > 0 {
+		return fmt.Errorf("%d of %d blocks are not approved with quorum", withoutQuorum, blockCount)
+	}
+
+	fmt.Fprintf(cmd.ErrOrStderr(), "%d blocks approved with quorum, %d proven final, %d with unproven finality\n", blockCount, finalized, unproven)
+	return nil
+}
+
+func readFinalityCheckpoint(genesisFile, checkpointFile string) (*finality.Checkpoint, error) {
+	if (genesisFile == "") == (checkpointFile == "") {
+		return nil, fmt.Errorf("exactly one of --genesis-file or --checkpoint-file must be provided")
+	}
+
+	read := finality.ReadCheckpoint
+	file := checkpointFile
+	if genesisFile != "" {
+		read, file = finality.ReadGenesisCheckpoint, genesisFile
+	}
+
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, fmt.Errorf("open checkpoint: %w", err)
+	}
+	defer f.Close()
+
+	checkpoint, err := read(f)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", file, err)
+	}
+
+	return checkpoint, nil
+}
+
+func printBlockFinality(out io.Writer, f *blockFinality, format string) error {
+	line := f.String()
+	if format == "jsonl" {
+		content, err := json.Marshal(f)
+		if err != nil {
+			return fmt.Errorf("marshal block finality: %w", err)
+		}
+		line = string(content)
+	}
+
+	_, err := fmt.Fprintln(out, line)
+	return err
+}
+
+// rpcBlockProducersSource fetches the block producers and the light client block of an epoch
+// from a NEAR RPC node
+type rpcBlockProducersSource struct {
+	rpc nearRPC
+}
+
+func (s *rpcBlockProducersSource) BlockProducers(ctx context.Context, blockHash *pbnear.CryptoHash) ([]*pbnear.ValidatorStake, error) {
+	producers, err := s.rpc.ValidatorsOrdered(ctx, blockHash.AsBase58String())
+	if err != nil {
+		return nil, err
+	}
+
+	return finality.ParseBlockProducers(producers)
+}
+
+func (s *rpcBlockProducersSource) LightClientBlock(ctx context.Context, lastBlockHash *pbnear.CryptoHash) (*finality.LightClientBlock, error) {
+	block, err := s.rpc.NextLightClientBlock(ctx, lastBlockHash.AsBase58String())
+	if err != nil {
+		return nil, err
+	}
+
+	if block == nil || block.InnerRestHash == "" {
+		return nil, nil
+	}
+
+	innerRestHash, err := base58.Decode(block.InnerRestHash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid inner_rest_hash %q: %w", block.InnerRestHash, err)
+	}
+
+	return &finality.LightClientBlock{Height: block.InnerLite.Height, InnerRestHash: &pbnear.CryptoHash{Bytes: innerRestHash}}, nil
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/mr-tron/base58"
+	"github.com/streamingfast/firehose-near/finality"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRPCBlockProducersSource_LightClientBlock(t *testing.T) {
+	known := &pbnear.CryptoHash{Bytes: bytes.Repeat([]byte{1}, 32)}
+	head := &pbnear.CryptoHash{Bytes: bytes.Repeat([]byte{2}, 32)}
+	innerRestHash := bytes.Repeat([]byte{3}, 32)
+
+	endpoint, _ := stubNearRPC(t, map[string]string{
+		`next_light_client_block {"last_block_hash":"` + known.AsBase58String() + `"}`: `{"prev_block_hash": "` + head.AsBase58String() + `", "inner_rest_hash": "` + base58.Encode(innerRestHash) + `", "inner_lite": {"height": 120}}`,
+		`next_light_client_block {"last_block_hash":"` + head.AsBase58String() + `"}`:  `{}`,
+	})
+	source := &rpcBlockProducersSource{rpc: newHTTPNearRPC(endpoint)}
+
+	block, err := source.LightClientBlock(context.Background(), known)
+	require.NoError(t, err)
+	assert.Equal(t, &finality.LightClientBlock{Height: 120, InnerRestHash: &pbnear.CryptoHash{Bytes: innerRestHash}}, block)
+
+	// The node has no light client block after the one of its current epoch
+	block, err = source.LightClientBlock(context.Background(), head)
+	require.NoError(t, err)
+	assert.Nil(t, block)
+}
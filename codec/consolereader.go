@@ -3,6 +3,7 @@ package codec
 import (
 	"bufio"
 	"container/heap"
+	"context"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -33,18 +34,61 @@ type ConsoleReader struct {
 
 	ctx  *parseCtx
 	done chan interface{}
+
+	// rpcCtx bounds the RPC calls made while reading blocks, Close cancels it
+	rpcCtx context.Context
 }
 
-func NewConsoleReader(lines chan string, blockEncoder firecore.BlockEncoder, rpcUrl string) (*ConsoleReader, error) {
+// ConsoleReaderOption configures optional behaviors of the ConsoleReader
+type ConsoleReaderOption func(r *ConsoleReader)
+
+// WithBlockHashVerification makes the ConsoleReader check that the hash of each block read is the
+// hash of its header fields, failing on the first mismatch, the number of block approvers of each
+// epoch being given by approvers (see pbnear.WithBlockApproversSource), learned from the blocks
+// when nil.
+//
+// The ConsoleReader fails on the first block that needs the block body hash to be verified (see
+// pbnear.ErrBlockBodyHashRequired), which is the case of every block produced with protocol
+// version 63 and later: block hashes of such chains cannot be verified. Blocks whose hash cannot
+// be verified for another reason, like too many missing approvals, are accepted, a warning being
+// logged for the first one and then every unverifiableBlockHashesWarnInterval ones.
+func WithBlockHashVerification(approvers pbnear.BlockApproversSource) ConsoleReaderOption {
+	return func(r *ConsoleReader) {
+		var opts []pbnear.BlockHashVerifierOption
+		if approvers != nil {
+			opts = append(opts, pbnear.WithBlockApproversSource(approvers))
+		}
+
+		r.ctx.blockHashVerifier = pbnear.NewBlockHashVerifier(blockHashVerificationMaxMissingApprovals, opts...)
+	}
+}
+
+// blockHashVerificationMaxMissingApprovals is the number of missing approvals tried to verify a
+// block hash until the number of approvers of its epoch is known
+const blockHashVerificationMaxMissingApprovals = 2
+
+// unverifiableBlockHashesWarnInterval is the number of blocks whose hash could not be verified
+// between two warnings
+const unverifiableBlockHashesWarnInterval = 1000
+
+func NewConsoleReader(lines chan string, blockEncoder firecore.BlockEncoder, rpcUrl string, opts ...ConsoleReaderOption) (*ConsoleReader, error) {
+	rpcCtx, cancel := context.WithCancel(context.Background())
+
 	l := &ConsoleReader{
 		lines:        lines,
 		blockEncoder: blockEncoder,
-		close:        func() {},
+		close:        cancel,
+		rpcCtx:       rpcCtx,
 		ctx: &parseCtx{
 			blockMetas: newBlockMetaHeap(NewRPCBlockMetaGetter(rpcUrl)),
 		},
 		done: make(chan interface{}),
 	}
+
+	for _, opt := range opts {
+		opt(l)
+	}
+
 	return l, nil
 }
 
@@ -81,6 +125,11 @@ func (s *parsingStats) log() {
 
 type parseCtx struct {
 	blockMetas *blockMetaHeap
+
+	// blockHashVerifier is nil when block hashes are not verified
+	blockHashVerifier *pbnear.BlockHashVerifier
+	// unverifiableBlockHashes counts the blocks whose hash could not be verified
+	unverifiableBlockHashes uint64
 }
 
 func (r *ConsoleReader) ReadBlock() (out *pbbstream.Block, err error) {
@@ -125,6 +174,9 @@ func (r *ConsoleReader) next(readType int) (out *pbnear.Block, err error) {
 		}
 
 		if out != nil {
+			if err := ctx.verifyBlockHash(r.rpcCtx, out); err != nil {
+				return nil, err
+			}
 			return out, nil
 		}
 	}
@@ -156,6 +208,36 @@ func (r *ConsoleReader) buildScanner(reader io.Reader) *bufio.Scanner {
 	return scanner
 }
 
+// verifyBlockHash checks the hash of the block when block hashes are verified
+func (ctx *parseCtx) verifyBlockHash(rpcCtx context.Context, block *pbnear.Block) error {
+	if ctx.blockHashVerifier == nil {
+		return nil
+	}
+
+	err := ctx.blockHashVerifier.Verify(rpcCtx, block.Header)
+	if errors.Is(err, pbnear.ErrBlockBodyHashRequired) {
+		return fmt.Errorf("block hash verification is enabled but the hash of block #%d cannot be verified, blocks produced with protocol version 63 and later hash the block body hash which blocks do not carry, disable block hash verification for this chain: %w", block.Num(), err)
+	}
+	if errors.Is(err, pbnear.ErrBlockHashUnverifiable) {
+		ctx.unverifiableBlockHashes++
+		if ctx.unverifiableBlockHashes%unverifiableBlockHashesWarnInterval == 1 {
+			zlog.Warn("block hash verification is enabled but block hashes cannot be verified, blocks are accepted without verification",
+				zap.Uint64("block_num", block.Num()),
+				zap.Uint64("unverifiable_block_hashes", ctx.unverifiableBlockHashes),
+				zap.Error(err),
+			)
+		} else {
+			zlog.Debug("block hash cannot be verified", zap.Uint64("block_num", block.Num()), zap.Error(err))
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("invalid block: %w", err)
+	}
+
+	return nil
+}
+
 // Formats
 // FIRE BLOCK {height} {hash} {parent_height} {parent_hash} {lib} {timestamp} {hex}
 func (ctx *parseCtx) readBlock(line string) (*pbnear.Block, error) {
@@ -15,6 +15,7 @@ package codec
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"io/ioutil"
@@ -24,8 +25,10 @@ import (
 	"testing"
 	"time"
 
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestParseFromFile(t *testing.T) {
@@ -111,8 +114,9 @@ func testReaderConsoleReader(t *testing.T, lines chan string, closer func()) *Co
 	t.Helper()
 
 	l := &ConsoleReader{
-		lines: lines,
-		close: closer,
+		lines:  lines,
+		close:  closer,
+		rpcCtx: context.Background(),
 		ctx: &parseCtx{
 			blockMetas: newBlockMetaHeap(blockMetaGetterFunc(func(id string) (*blockMeta, error) {
 				return &blockMeta{
@@ -141,3 +145,91 @@ func unifiedDiff(t *testing.T, cnt1, cnt2 []byte) string {
 
 	return string(out)
 }
+
+func TestParseFromFile_BlockHashVerification(t *testing.T) {
+	cr := testFileConsoleReader(t, "testdata/old.firelog")
+	WithBlockHashVerification(nil)(cr)
+
+	// The blocks of old.firelog are version 2 headers whose hash must verify, not only be accepted
+	verifier := pbnear.NewBlockHashVerifier(blockHashVerificationMaxMissingApprovals)
+
+	var last *pbnear.Block
+	for {
+		block, err := cr.next(readBlock)
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		require.NoError(t, verifier.Verify(context.Background(), block.Header), "block #%d", block.Num())
+		last = block
+	}
+	require.NotNil(t, last)
+	assert.Zero(t, cr.ctx.unverifiableBlockHashes)
+
+	// Blocks whose hash cannot be verified are accepted, here a block that can be a version 3
+	// header while it carries no block ordinal
+	unverifiable := proto.Clone(last).(*pbnear.Block)
+	unverifiable.Header.LatestProtocolVersion = 50
+	unverifiable.Header.GasPrice = &pbnear.BigInt{Bytes: []byte{1}}
+	require.NoError(t, cr.ctx.verifyBlockHash(context.Background(), unverifiable))
+	assert.Equal(t, uint64(1), cr.ctx.unverifiableBlockHashes)
+
+	// Blocks that need the block body hash to be verified are rejected
+	withBodyHash := proto.Clone(last).(*pbnear.Block)
+	withBodyHash.Header.LatestProtocolVersion = 70
+	assert.ErrorIs(t, cr.ctx.verifyBlockHash(context.Background(), withBodyHash), pbnear.ErrBlockBodyHashRequired)
+
+	last.Header.GasPrice = &pbnear.BigInt{Bytes: []byte{1}}
+	var mismatch *pbnear.BlockHashMismatchError
+	assert.ErrorAs(t, cr.ctx.verifyBlockHash(context.Background(), last), &mismatch)
+}
+
+func TestParseFromFile_BlockHashVerification_BlockBodyHashRequired(t *testing.T) {
+	// The blocks of full.firelog are produced with protocol version 70, their header hashes the
+	// block body hash, the reader fails on the first one
+	cr := testFileConsoleReader(t, "testdata/full.firelog")
+	WithBlockHashVerification(nil)(cr)
+
+	_, err := cr.next(readBlock)
+	assert.ErrorIs(t, err, pbnear.ErrBlockBodyHashRequired)
+	assert.Zero(t, cr.ctx.unverifiableBlockHashes)
+}
+
+func TestParseFromFile_BlockHashVerification_ApproversSource(t *testing.T) {
+	cr := testFileConsoleReader(t, "testdata/old.firelog")
+
+	var blocks []*pbnear.Block
+	for {
+		block, err := cr.next(readBlock)
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		blocks = append(blocks, block)
+	}
+	require.NotEmpty(t, blocks)
+
+	// The source gives the number of approvers of the first block
+	approvers := len(blocks[0].Header.Approvals)
+	for ; blocks[0].Header.VerifyHash(approvers) != nil; approvers++ {
+		require.LessOrEqual(t, approvers, len(blocks[0].Header.Approvals)+blockHashVerificationMaxMissingApprovals)
+	}
+
+	// The first block read after a restart is tampered, it is a mismatch and not accepted as
+	// unverifiable
+	restarted := testReaderConsoleReader(t, make(chan string), func() {})
+	WithBlockHashVerification(testBlockApproversSource(approvers))(restarted)
+
+	tampered := proto.Clone(blocks[0]).(*pbnear.Block)
+	tampered.Header.TotalSupply = &pbnear.BigInt{Bytes: []byte{1}}
+	var mismatch *pbnear.BlockHashMismatchError
+	assert.ErrorAs(t, restarted.ctx.verifyBlockHash(context.Background(), tampered), &mismatch)
+	require.NoError(t, restarted.ctx.verifyBlockHash(context.Background(), blocks[0]))
+}
+
+// testBlockApproversSource gives the same number of approvers for every block
+type testBlockApproversSource int
+
+func (s testBlockApproversSource) BlockApprovers(_ context.Context, _ *pbnear.CryptoHash) (int, error) {
+	return int(s), nil
+}
@@ -0,0 +1,107 @@
+package finality
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/mr-tron/base58"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+)
+
+// Checkpoint is a trusted set of block producers of an epoch, verification of the blocks of
+// later epochs chaining from it through the `next_bp_hash` of the blocks.
+type Checkpoint struct {
+	EpochID        *pbnear.CryptoHash
+	BlockProducers []*pbnear.ValidatorStake
+}
+
+// BlockProducer is a block producer as listed by the `EXPERIMENTAL_validators_ordered` RPC
+// method and in checkpoint files, the stake being in yoctoNEAR
+type BlockProducer struct {
+	AccountID string `json:"account_id"`
+	PublicKey string `json:"public_key"`
+	Stake     string `json:"stake"`
+}
+
+// ReadCheckpoint reads a checkpoint JSON document:
+//
+//	{"epoch_id": "<base58>", "block_producers": [{"account_id": "...", "public_key": "ed25519:...", "stake": "<yocto>"}]}
+//
+// the block producers being the ones returned by the `EXPERIMENTAL_validators_ordered` RPC
+// method for a block of the epoch.
+func ReadCheckpoint(reader io.Reader) (*Checkpoint, error) {
+	var document struct {
+		EpochID        string           `json:"epoch_id"`
+		BlockProducers []*BlockProducer `json:"block_producers"`
+	}
+	if err := json.NewDecoder(reader).Decode(&document); err != nil {
+		return nil, fmt.Errorf("unmarshal checkpoint: %w", err)
+	}
+
+	epochID, err := base58.Decode(document.EpochID)
+	if err != nil || len(epochID) != 32 {
+		return nil, fmt.Errorf("invalid checkpoint epoch_id %q, expected a base58 hash", document.EpochID)
+	}
+
+	producers, err := ParseBlockProducers(document.BlockProducers)
+	if err != nil {
+		return nil, fmt.Errorf("invalid checkpoint: %w", err)
+	}
+
+	return &Checkpoint{EpochID: &pbnear.CryptoHash{Bytes: epochID}, BlockProducers: producers}, nil
+}
+
+// ReadGenesisCheckpoint reads the checkpoint of the first epoch from a neard `genesis.json`
+// document, whose validators are the block producers of the epoch (the chain must have as many
+// block producer seats as genesis validators).
+func ReadGenesisCheckpoint(reader io.Reader) (*Checkpoint, error) {
+	var genesis struct {
+		Validators []*struct {
+			AccountID string `json:"account_id"`
+			PublicKey string `json:"public_key"`
+			Amount    string `json:"amount"`
+		} `json:"validators"`
+	}
+	if err := json.NewDecoder(reader).Decode(&genesis); err != nil {
+		return nil, fmt.Errorf("unmarshal genesis: %w", err)
+	}
+
+	if len(genesis.Validators) == 0 {
+		return nil, fmt.Errorf("genesis has no validators")
+	}
+
+	producers := make([]*BlockProducer, len(genesis.Validators))
+	for i, validator := range genesis.Validators {
+		producers[i] = &BlockProducer{AccountID: validator.AccountID, PublicKey: validator.PublicKey, Stake: validator.Amount}
+	}
+
+	parsed, err := ParseBlockProducers(producers)
+	if err != nil {
+		return nil, fmt.Errorf("invalid genesis validators: %w", err)
+	}
+
+	// The epoch of the genesis and of the blocks following it is the default (zero) hash
+	return &Checkpoint{EpochID: &pbnear.CryptoHash{Bytes: make([]byte, 32)}, BlockProducers: parsed}, nil
+}
+
+// ParseBlockProducers converts block producers to validator stakes, in order
+func ParseBlockProducers(in []*BlockProducer) ([]*pbnear.ValidatorStake, error) {
+	out := make([]*pbnear.ValidatorStake, len(in))
+	for i, producer := range in {
+		publicKey, err := pbnear.ParsePublicKey(producer.PublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("block producer %s public key: %w", producer.AccountID, err)
+		}
+
+		stake, ok := new(big.Int).SetString(producer.Stake, 10)
+		if !ok || stake.Sign() < 0 {
+			return nil, fmt.Errorf("block producer %s has invalid stake %q", producer.AccountID, producer.Stake)
+		}
+
+		out[i] = &pbnear.ValidatorStake{AccountId: producer.AccountID, PublicKey: publicKey, Stake: &pbnear.BigInt{Bytes: stake.Bytes()}}
+	}
+
+	return out, nil
+}
@@ -0,0 +1,49 @@
+package finality
+
+import (
+	"strings"
+	"testing"
+
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadCheckpoint(t *testing.T) {
+	publicKey := pbnear.SecretKeyFromSeed("node0").PublicKey().AsKeyString()
+
+	checkpoint, err := ReadCheckpoint(strings.NewReader(`{
+		"epoch_id": "11111111111111111111111111111111",
+		"block_producers": [{"account_id": "node0", "public_key": "` + publicKey + `", "stake": "50000000000000000000000000000000"}]
+	}`))
+	require.NoError(t, err)
+
+	assert.Equal(t, make([]byte, 32), checkpoint.EpochID.Bytes)
+	require.Len(t, checkpoint.BlockProducers, 1)
+	assert.Equal(t, "node0", checkpoint.BlockProducers[0].AccountId)
+	assert.Equal(t, publicKey, checkpoint.BlockProducers[0].PublicKey.AsKeyString())
+	assert.Equal(t, "50000000000000000000000000000000", checkpoint.BlockProducers[0].Stake.AsBigInt().String())
+
+	_, err = ReadCheckpoint(strings.NewReader(`{"epoch_id": "abc", "block_producers": []}`))
+	assert.ErrorContains(t, err, "invalid checkpoint epoch_id")
+
+	_, err = ReadCheckpoint(strings.NewReader(`{"epoch_id": "11111111111111111111111111111111", "block_producers": [{"account_id": "node0", "public_key": "` + publicKey + `", "stake": "-1"}]}`))
+	assert.ErrorContains(t, err, "invalid stake")
+}
+
+func TestReadGenesisCheckpoint(t *testing.T) {
+	publicKey := pbnear.SecretKeyFromSeed("node0").PublicKey().AsKeyString()
+
+	checkpoint, err := ReadGenesisCheckpoint(strings.NewReader(`{
+		"chain_id": "localnet",
+		"validators": [{"account_id": "node0", "public_key": "` + publicKey + `", "amount": "50000000000000000000000000000000"}]
+	}`))
+	require.NoError(t, err)
+
+	assert.Equal(t, make([]byte, 32), checkpoint.EpochID.Bytes)
+	require.Len(t, checkpoint.BlockProducers, 1)
+	assert.Equal(t, "node0", checkpoint.BlockProducers[0].AccountId)
+
+	_, err = ReadGenesisCheckpoint(strings.NewReader(`{"validators": []}`))
+	assert.ErrorContains(t, err, "genesis has no validators")
+}
@@ -0,0 +1,338 @@
+// Package finality verifies the approvals of NEAR blocks like a light client does, proving that
+// blocks were approved by two thirds of the stake of their epoch's block producers and that they
+// are final.
+package finality
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+)
+
+// DefaultMaxMissingApprovals is the number of missing approvals tried when verifying the hash of
+// the headers of an epoch whose number of approvers is not known yet, see
+// pbnear.BlockHashVerifier
+const DefaultMaxMissingApprovals = 2
+
+// ErrUntrustedBlockProducers is returned when the block producers of an epoch cannot be
+// trusted, either because no block of the previous epoch committed to them or because the
+// block producers given by the source do not hash to the committed `next_bp_hash`
+var ErrUntrustedBlockProducers = errors.New("untrusted block producers")
+
+// BlockProducersSource gives the ordered block producers of the epoch of a block, like the
+// `EXPERIMENTAL_validators_ordered` RPC method does. They don't need to be trusted, the Verifier
+// checks them against the `next_bp_hash` of the blocks of the previous epoch.
+type BlockProducersSource interface {
+	BlockProducers(ctx context.Context, blockHash *pbnear.CryptoHash) ([]*pbnear.ValidatorStake, error)
+}
+
+// LightClientBlockSource gives the light client block of an epoch, like the
+// `next_light_client_block` RPC method does for a block of the previous epoch: the last final
+// block of the epoch. A BlockProducersSource also implementing it makes the Verifier trust the
+// inner lite fields of the light client blocks whose hash cannot be verified from their fields,
+// their `next_bp_hash` included, see BlockApprovals.InnerLiteVerified.
+type LightClientBlockSource interface {
+	// LightClientBlock returns the light client block of the epoch following the epoch of the
+	// block, nil when there is none
+	LightClientBlock(ctx context.Context, lastBlockHash *pbnear.CryptoHash) (*LightClientBlock, error)
+}
+
+// LightClientBlock is the part of a light client block the Verifier uses. It does not need to be
+// trusted, the Verifier checks that the header of the block hashes to its approved hash with it.
+type LightClientBlock struct {
+	Height        uint64
+	InnerRestHash *pbnear.CryptoHash
+}
+
+// BlockApprovals is the result of the verification of the approvals of a block
+type BlockApprovals struct {
+	Height uint64
+	Hash   *pbnear.CryptoHash
+	// Endorsement is true when the approvals endorse the previous block, false when they skip it
+	Endorsement bool
+	// Approvals is the number of approvals signed by a block producer of the epoch
+	Approvals int
+	// Unmatched is the number of approvals not signed by a block producer of the epoch, either
+	// invalid or signed by a block producer of the next epoch only, which are only approvers of
+	// the last blocks of an epoch
+	Unmatched int
+	// ApprovedStake is the stake of the block producers of the epoch that approved the block and
+	// TotalStake the stake of all of them
+	ApprovedStake *big.Int
+	TotalStake    *big.Int
+	// Quorum is true when more than two thirds of the stake approved the block
+	Quorum bool
+	// HashVerified is false when the hash of the block could not be computed from its fields, see
+	// pbnear.ErrBlockHashUnverifiable
+	HashVerified bool
+	// InnerLiteVerified is true when the hash of the block could not be computed from its fields
+	// but the block is the light client block of its epoch and its hash was computed from its
+	// inner lite fields, its previous block hash and the light client block's `inner_rest_hash`,
+	// see pbnear.BlockHeader.ComputeLightClientHash. These fields are then trusted.
+	InnerLiteVerified bool
+	// Finalized is the header of the block proven final by the approvals of the block and of its
+	// previous block, nil when none is
+	Finalized *pbnear.BlockHeader
+	// FinalityUnproven is true when the approvals of the block and of its previous block have
+	// quorum but the hash of the previous two blocks could not be verified, not even from their
+	// inner lite fields: their fields, which link them to the approved hashes, cannot be trusted
+	// so no block is proven final
+	FinalityUnproven bool
+}
+
+// Verifier verifies the approvals of consecutive block headers, tracking the block producers of
+// each epoch from a trusted checkpoint. The block producers of the following epochs are fetched
+// from the source and trusted when they hash to the `next_bp_hash` of an approved block of the
+// previous epoch.
+//
+// A block is final when its next block endorses it with quorum and the block after endorses that
+// next block with quorum (Doomslug finality), the approvals being reported on the block carrying
+// them.
+//
+// Approvals sign block hashes, the fields of a header, like its `next_bp_hash` or its previous
+// block hash, are only trusted when its hash is verified from them (see
+// pbnear.ErrBlockHashUnverifiable): the block producers of the next epoch are only trusted from
+// verified headers and a block is only proven final when it and its next block are verified. The
+// hash of the headers of protocol version 63 and later cannot be verified, the light client block
+// of each epoch is then fetched when the source is a LightClientBlockSource: its inner lite
+// fields, which include the `next_bp_hash`, are verified with its `inner_rest_hash`.
+type Verifier struct {
+	source       BlockProducersSource
+	hashVerifier *pbnear.BlockHashVerifier
+
+	// producers is the trusted block producers by epoch id
+	producers map[string][]*pbnear.ValidatorStake
+	// nextProducersHashes is the `next_bp_hash` of approved blocks whose hash is verified by next
+	// epoch id
+	nextProducersHashes map[string]*pbnear.CryptoHash
+	// lightClientBlocks is the light client block by epoch id, nil when the source has none
+	lightClientBlocks map[string]*LightClientBlock
+
+	// last is the last verified header and its approvals, previous the one before
+	last, previous *verifiedHeader
+}
+
+type verifiedHeader struct {
+	header    *pbnear.BlockHeader
+	approvals *BlockApprovals
+}
+
+type VerifierOption func(v *Verifier)
+
+// WithMaxMissingApprovals overrides DefaultMaxMissingApprovals
+func WithMaxMissingApprovals(maxMissingApprovals int) VerifierOption {
+	return func(v *Verifier) {
+		v.hashVerifier = pbnear.NewBlockHashVerifier(maxMissingApprovals)
+	}
+}
+
+// NewVerifier returns a Verifier trusting the block producers of the checkpoint, source may be
+// nil in which case only the blocks of the checkpoint's epoch can be verified
+func NewVerifier(checkpoint *Checkpoint, source BlockProducersSource, opts ...VerifierOption) *Verifier {
+	v := &Verifier{
+		source:              source,
+		hashVerifier:        pbnear.NewBlockHashVerifier(DefaultMaxMissingApprovals),
+		producers:           map[string][]*pbnear.ValidatorStake{checkpoint.EpochID.AsString(): checkpoint.BlockProducers},
+		nextProducersHashes: make(map[string]*pbnear.CryptoHash),
+		lightClientBlocks:   make(map[string]*LightClientBlock),
+	}
+
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	return v
+}
+
+// Verify verifies the approvals of the header, headers must be given in order. An error is
+// returned when the header does not follow the previous one, when its previous height is not
+// known (see prevHeight), when its hash does not match its fields or when the block producers of its epoch cannot be trusted, a block without quorum is
+// not an error but is reported as such.
+func (v *Verifier) Verify(ctx context.Context, header *pbnear.BlockHeader) (*BlockApprovals, error) {
+	if v.last != nil && !bytes.Equal(header.PrevHash.GetBytes(), v.last.header.Hash.GetBytes()) {
+		return nil, fmt.Errorf("block #%d previous block %s is not the last verified block #%d %s", header.Height, header.PrevHash.AsBase58String(), v.last.header.Height, v.last.header.Hash.AsBase58String())
+	}
+
+	prevHeight, err := v.prevHeight(header)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &BlockApprovals{Height: header.Height, Hash: header.Hash, Endorsement: header.IsEndorsementAfter(prevHeight)}
+
+	err = v.hashVerifier.Verify(ctx, header)
+	switch {
+	case err == nil:
+		out.HashVerified = true
+	case !errors.Is(err, pbnear.ErrBlockHashUnverifiable):
+		return nil, err
+	default:
+		if out.InnerLiteVerified, err = v.verifyInnerLite(ctx, header); err != nil {
+			return nil, err
+		}
+	}
+
+	producers, err := v.blockProducers(ctx, header)
+	if err != nil {
+		return nil, err
+	}
+
+	out.Approvals, out.Unmatched, out.ApprovedStake, out.TotalStake = matchApprovals(header.ApprovalMessageAfter(prevHeight), header.Approvals, producers)
+	out.Quorum = hasQuorum(out.ApprovedStake, out.TotalStake)
+
+	if out.Quorum && out.Endorsement && v.last != nil {
+		// The previous block is approved, the block producers of its next epoch it commits to
+		// can be trusted when its fields are the ones it was approved for
+		if v.last.approvals.fieldsTrusted() {
+			v.nextProducersHashes[v.last.header.NextEpochId.AsString()] = v.last.header.NextBpHash
+		}
+
+		if v.previous != nil && v.last.approvals.Quorum && v.last.approvals.Endorsement {
+			if v.previous.approvals.fieldsTrusted() && v.last.approvals.fieldsTrusted() {
+				out.Finalized = v.previous.header
+			} else {
+				out.FinalityUnproven = true
+			}
+		}
+	}
+
+	v.previous, v.last = v.last, &verifiedHeader{header: header, approvals: out}
+	return out, nil
+}
+
+// prevHeight returns the height of the previous block of the header, the height of the last
+// verified header it follows when there is one. Old format blocks and blocks fetched during RPC
+// outages do not carry their previous height, the first header verified must then carry it.
+func (v *Verifier) prevHeight(header *pbnear.BlockHeader) (uint64, error) {
+	switch {
+	case v.last != nil:
+		return v.last.header.Height, nil
+	case header.PrevHeight != 0 || header.Height == 1:
+		return header.PrevHeight, nil
+	}
+
+	return 0, fmt.Errorf("block #%d does not carry its previous height and follows no verified block, start from a block carrying it", header.Height)
+}
+
+// fieldsTrusted returns whether the approved hash of the block proves its inner lite fields and
+// its previous block hash
+func (a *BlockApprovals) fieldsTrusted() bool {
+	return a.HashVerified || a.InnerLiteVerified
+}
+
+// verifyInnerLite returns whether the header is the light client block of its epoch and hashes to
+// its hash with the light client block's `inner_rest_hash`, the light client block being fetched
+// from the source the first time the epoch is seen
+func (v *Verifier) verifyInnerLite(ctx context.Context, header *pbnear.BlockHeader) (bool, error) {
+	source, ok := v.source.(LightClientBlockSource)
+	if !ok {
+		return false, nil
+	}
+
+	epoch := header.EpochId.AsString()
+	block, found := v.lightClientBlocks[epoch]
+	if !found {
+		// The next epoch id is the hash of the last block of the previous epoch
+		var err error
+		if block, err = source.LightClientBlock(ctx, header.NextEpochId); err != nil {
+			return false, fmt.Errorf("light client block of epoch %s: %w", header.EpochId.AsBase58String(), err)
+		}
+		v.lightClientBlocks[epoch] = block
+	}
+
+	if block == nil || block.Height != header.Height {
+		return false, nil
+	}
+
+	return bytes.Equal(header.ComputeLightClientHash(block.InnerRestHash).Bytes, header.Hash.GetBytes()), nil
+}
+
+// blockProducers returns the trusted block producers of the epoch of the header, fetching them
+// from the source the first time the epoch is seen
+func (v *Verifier) blockProducers(ctx context.Context, header *pbnear.BlockHeader) ([]*pbnear.ValidatorStake, error) {
+	epoch := header.EpochId.AsString()
+	if producers, found := v.producers[epoch]; found {
+		return producers, nil
+	}
+
+	expected, found := v.nextProducersHashes[epoch]
+	if !found {
+		return nil, fmt.Errorf("%w: block #%d epoch %s does not follow an approved block of the previous epoch whose hash or inner lite fields are verified, start from a checkpoint of the epoch", ErrUntrustedBlockProducers, header.Height, header.EpochId.AsBase58String())
+	}
+
+	if v.source == nil {
+		return nil, fmt.Errorf("block #%d epoch %s is not the checkpoint's epoch and no block producers source is configured", header.Height, header.EpochId.AsBase58String())
+	}
+
+	producers, err := v.source.BlockProducers(ctx, header.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("block producers of block #%d epoch %s: %w", header.Height, header.EpochId.AsBase58String(), err)
+	}
+
+	// Validator stakes are versioned in the `next_bp_hash` since protocol version 49, both
+	// encodings are tried as the version the previous epoch was running is not known
+	for _, versioned := range []bool{true, false} {
+		hash, err := pbnear.BlockProducersHash(producers, versioned)
+		if err != nil {
+			return nil, fmt.Errorf("block producers of epoch %s: %w", header.EpochId.AsBase58String(), err)
+		}
+
+		if bytes.Equal(hash.Bytes, expected.GetBytes()) {
+			v.producers[epoch] = producers
+			return producers, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: block producers of epoch %s do not hash to the next_bp_hash %s of the previous epoch", ErrUntrustedBlockProducers, header.EpochId.AsBase58String(), expected.AsBase58String())
+}
+
+// matchApprovals matches the approvals to the block producers that signed them. The header
+// carries the received approvals in the order of the block producers (the ones not received
+// being dropped), so producers are searched from the last matched one. A block producer listed
+// more than once is only counted once.
+func matchApprovals(message []byte, approvals []*pbnear.Signature, producers []*pbnear.ValidatorStake) (matched, unmatched int, approvedStake, totalStake *big.Int) {
+	approvedStake, totalStake = new(big.Int), new(big.Int)
+
+	seen := make(map[string]bool, len(producers))
+	var unique []*pbnear.ValidatorStake
+	for _, producer := range producers {
+		if !seen[producer.AccountId] {
+			seen[producer.AccountId] = true
+			unique = append(unique, producer)
+			totalStake.Add(totalStake, new(big.Int).SetBytes(producer.Stake.GetBytes()))
+		}
+	}
+
+	next := 0
+	for _, approval := range approvals {
+		found := false
+		for i := next; i < len(unique); i++ {
+			if unique[i].PublicKey.Verify(message, approval) {
+				approvedStake.Add(approvedStake, new(big.Int).SetBytes(unique[i].Stake.GetBytes()))
+				next, found = i+1, true
+				break
+			}
+		}
+
+		if found {
+			matched++
+		} else {
+			unmatched++
+		}
+	}
+
+	return
+}
+
+// hasQuorum returns whether the approved stake is more than two thirds of the total stake, like
+// nearcore checks it
+func hasQuorum(approvedStake, totalStake *big.Int) bool {
+	threshold := new(big.Int).Mul(totalStake, big.NewInt(2))
+	threshold.Quo(threshold, big.NewInt(3))
+
+	return approvedStake.Cmp(threshold) > 0
+}
@@ -0,0 +1,347 @@
+package finality
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"testing"
+
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEpoch struct {
+	id        *pbnear.CryptoHash
+	keys      []*pbnear.SecretKey
+	producers []*pbnear.ValidatorStake
+}
+
+func newTestEpoch(t *testing.T, id byte, stakes ...byte) *testEpoch {
+	epoch := &testEpoch{id: testHash(id)}
+	for i, stake := range stakes {
+		key, err := pbnear.GenerateSecretKey(pbnear.CurveKind_ED25519, rand.Reader)
+		require.NoError(t, err)
+
+		epoch.keys = append(epoch.keys, key)
+		epoch.producers = append(epoch.producers, &pbnear.ValidatorStake{
+			AccountId: fmt.Sprintf("validator%d.near", i),
+			PublicKey: key.PublicKey(),
+			Stake:     &pbnear.BigInt{Bytes: []byte{stake}},
+		})
+	}
+	return epoch
+}
+
+func testHash(b byte) *pbnear.CryptoHash {
+	return &pbnear.CryptoHash{Bytes: bytes.Repeat([]byte{b}, 32)}
+}
+
+// testHeader returns a header following prev approved by the given block producers of the epoch,
+// the header being of a protocol version whose hash is verified from its fields
+func testHeader(t *testing.T, prev *pbnear.BlockHeader, height uint64, epoch *testEpoch, approvers ...int) *pbnear.BlockHeader {
+	header := &pbnear.BlockHeader{
+		Height:                height,
+		PrevHeight:            prev.Height,
+		PrevHash:              prev.Hash,
+		EpochId:               epoch.id,
+		NextEpochId:           testHash(100 + epoch.id.Bytes[0]),
+		LatestProtocolVersion: 47,
+	}
+
+	for _, approver := range approvers {
+		approval, err := epoch.keys[approver].Sign(header.ApprovalMessage())
+		require.NoError(t, err)
+		header.Approvals = append(header.Approvals, approval)
+	}
+
+	sealTestHeader(t, header)
+	return header
+}
+
+// sealTestHeader sets the hash of the header to the hash of its fields
+func sealTestHeader(t *testing.T, header *pbnear.BlockHeader) {
+	hash, err := header.ComputeHash(pbnear.BlockHeaderV2)
+	require.NoError(t, err)
+	header.Hash = hash
+}
+
+// unverifiableTestHeader is testHeader with a protocol version whose hash cannot be verified
+func unverifiableTestHeader(t *testing.T, prev *pbnear.BlockHeader, height uint64, epoch *testEpoch, approvers ...int) *pbnear.BlockHeader {
+	header := testHeader(t, prev, height, epoch, approvers...)
+	header.LatestProtocolVersion = 63
+	header.Hash = testHash(byte(height))
+	return header
+}
+
+// testSource gives the same block producers for every block
+type testSource []*pbnear.ValidatorStake
+
+func (s testSource) BlockProducers(_ context.Context, _ *pbnear.CryptoHash) ([]*pbnear.ValidatorStake, error) {
+	if s == nil {
+		return nil, errors.New("unknown block")
+	}
+	return s, nil
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	ctx := context.Background()
+	epoch := newTestEpoch(t, 1, 10, 10, 10, 10)
+	verifier := NewVerifier(&Checkpoint{EpochID: epoch.id, BlockProducers: epoch.producers}, nil)
+
+	genesis := &pbnear.BlockHeader{Height: 9, Hash: testHash(9)}
+	b10 := testHeader(t, genesis, 10, epoch, 0, 1, 2)
+	b11 := testHeader(t, b10, 11, epoch, 0, 2, 3)
+	b12 := testHeader(t, b11, 12, epoch, 1, 2, 3)
+
+	approvals, err := verifier.Verify(ctx, b10)
+	require.NoError(t, err)
+	assert.Equal(t, 3, approvals.Approvals)
+	assert.Equal(t, "30", approvals.ApprovedStake.String())
+	assert.Equal(t, "40", approvals.TotalStake.String())
+	assert.True(t, approvals.Quorum)
+	assert.True(t, approvals.Endorsement)
+	assert.True(t, approvals.HashVerified)
+	assert.Nil(t, approvals.Finalized)
+
+	approvals, err = verifier.Verify(ctx, b11)
+	require.NoError(t, err)
+	assert.Nil(t, approvals.Finalized)
+
+	// b11 and b12 endorse their previous block with quorum, b10 is final
+	approvals, err = verifier.Verify(ctx, b12)
+	require.NoError(t, err)
+	assert.True(t, approvals.Quorum)
+	assert.Equal(t, b10, approvals.Finalized)
+	assert.False(t, approvals.FinalityUnproven)
+
+	// Half of the stake is not a quorum, an approval signed by an unknown key is unmatched
+	b14 := testHeader(t, b12, 14, epoch, 0, 3)
+	intruder, err := pbnear.GenerateSecretKey(pbnear.CurveKind_ED25519, rand.Reader)
+	require.NoError(t, err)
+	forged, err := intruder.Sign(b14.ApprovalMessage())
+	require.NoError(t, err)
+	b14.Approvals = append(b14.Approvals, forged)
+	sealTestHeader(t, b14)
+
+	approvals, err = verifier.Verify(ctx, b14)
+	require.NoError(t, err)
+	assert.False(t, approvals.Endorsement)
+	assert.Equal(t, 2, approvals.Approvals)
+	assert.Equal(t, 1, approvals.Unmatched)
+	assert.False(t, approvals.Quorum)
+
+	_, err = verifier.Verify(ctx, testHeader(t, b12, 15, epoch, 0, 1, 2))
+	assert.ErrorContains(t, err, "is not the last verified block")
+}
+
+func TestVerifier_EpochTransition(t *testing.T) {
+	ctx := context.Background()
+	first := newTestEpoch(t, 1, 10, 10, 10)
+	second := newTestEpoch(t, 2, 5, 10, 20)
+
+	nextBpHash, err := pbnear.BlockProducersHash(second.producers, true)
+	require.NoError(t, err)
+
+	run := func(source testSource) error {
+		verifier := NewVerifier(&Checkpoint{EpochID: first.id, BlockProducers: first.producers}, source)
+
+		prev := &pbnear.BlockHeader{Height: 9, Hash: testHash(9)}
+		for height := uint64(10); height < 13; height++ {
+			header := testHeader(t, prev, height, first, 0, 1, 2)
+			header.NextEpochId = second.id
+			header.NextBpHash = nextBpHash
+			sealTestHeader(t, header)
+
+			if _, err := verifier.Verify(ctx, header); err != nil {
+				return err
+			}
+			prev = header
+		}
+
+		approvals, err := verifier.Verify(ctx, testHeader(t, prev, 13, second, 1, 2))
+		if err != nil {
+			return err
+		}
+
+		assert.True(t, approvals.Quorum)
+		assert.Equal(t, "35", approvals.TotalStake.String())
+		return nil
+	}
+
+	require.NoError(t, run(testSource(second.producers)))
+
+	tampered := append([]*pbnear.ValidatorStake{}, second.producers...)
+	tampered[0] = first.producers[0]
+	assert.ErrorIs(t, run(testSource(tampered)), ErrUntrustedBlockProducers)
+}
+
+func TestVerifier_ForgedNextBlockProducersHash(t *testing.T) {
+	ctx := context.Background()
+	first := newTestEpoch(t, 1, 10, 10, 10)
+	second := newTestEpoch(t, 2, 10)
+	forged := newTestEpoch(t, 2, 10)
+
+	forgedBpHash, err := pbnear.BlockProducersHash(forged.producers, true)
+	require.NoError(t, err)
+
+	genesis := &pbnear.BlockHeader{Height: 9, Hash: testHash(9)}
+
+	// The next_bp_hash of a verifiable header is forged after its producer hashed it, its hash
+	// does not match anymore
+	verifier := NewVerifier(&Checkpoint{EpochID: first.id, BlockProducers: first.producers}, testSource(forged.producers))
+	b10 := testHeader(t, genesis, 10, first, 0, 1, 2)
+	_, err = verifier.Verify(ctx, b10)
+	require.NoError(t, err)
+
+	b11 := testHeader(t, b10, 11, first, 0, 1, 2)
+	b11.NextBpHash = forgedBpHash
+
+	var mismatch *pbnear.BlockHashMismatchError
+	_, err = verifier.Verify(ctx, b11)
+	assert.ErrorAs(t, err, &mismatch)
+
+	// The hash of the headers cannot be verified, the approved hashes do not prove their
+	// next_bp_hash nor that they follow each other
+	verifier = NewVerifier(&Checkpoint{EpochID: first.id, BlockProducers: first.producers}, testSource(forged.producers))
+
+	prev := genesis
+	for height := uint64(10); height < 13; height++ {
+		header := unverifiableTestHeader(t, prev, height, first, 0, 1, 2)
+		header.NextEpochId = second.id
+		header.NextBpHash = forgedBpHash
+
+		approvals, err := verifier.Verify(ctx, header)
+		require.NoError(t, err)
+		assert.True(t, approvals.Quorum)
+		assert.False(t, approvals.HashVerified)
+		assert.Nil(t, approvals.Finalized)
+		assert.Equal(t, height == 12, approvals.FinalityUnproven)
+		prev = header
+	}
+
+	_, err = verifier.Verify(ctx, unverifiableTestHeader(t, prev, 13, forged, 0))
+	assert.ErrorIs(t, err, ErrUntrustedBlockProducers)
+}
+
+func TestVerifier_UntrustedEpoch(t *testing.T) {
+	first := newTestEpoch(t, 1, 10)
+	other := newTestEpoch(t, 3, 10)
+
+	verifier := NewVerifier(&Checkpoint{EpochID: first.id, BlockProducers: first.producers}, testSource(nil))
+	_, err := verifier.Verify(context.Background(), testHeader(t, &pbnear.BlockHeader{Height: 9, Hash: testHash(9)}, 10, other, 0))
+	assert.ErrorIs(t, err, ErrUntrustedBlockProducers)
+}
+
+// testLightClientSource is a testSource giving the light client block of every epoch, recording
+// the blocks asked for
+type testLightClientSource struct {
+	testSource
+	block           *LightClientBlock
+	lastBlockHashes []*pbnear.CryptoHash
+}
+
+func (s *testLightClientSource) LightClientBlock(_ context.Context, lastBlockHash *pbnear.CryptoHash) (*LightClientBlock, error) {
+	s.lastBlockHashes = append(s.lastBlockHashes, lastBlockHash)
+	return s.block, nil
+}
+
+func TestVerifier_LightClientBlockEpochTransition(t *testing.T) {
+	ctx := context.Background()
+	first := newTestEpoch(t, 1, 10, 10, 10)
+	second := newTestEpoch(t, 2, 5, 10, 20)
+
+	nextBpHash, err := pbnear.BlockProducersHash(second.producers, true)
+	require.NoError(t, err)
+
+	innerRestHash := testHash(200)
+
+	// Headers of protocol version 63 hash the block body hash, block #12 is the light client
+	// block of the first epoch, its hash is computed from its inner lite fields
+	run := func(source *testLightClientSource) ([]*BlockApprovals, error) {
+		verifier := NewVerifier(&Checkpoint{EpochID: first.id, BlockProducers: first.producers}, source)
+
+		var out []*BlockApprovals
+		prev := &pbnear.BlockHeader{Height: 9, Hash: testHash(9)}
+		for height := uint64(10); height < 14; height++ {
+			header := unverifiableTestHeader(t, prev, height, first, 0, 1, 2)
+			header.NextEpochId = second.id
+			header.NextBpHash = nextBpHash
+			if height == 12 {
+				header.Hash = header.ComputeLightClientHash(innerRestHash)
+			}
+
+			approvals, err := verifier.Verify(ctx, header)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, approvals)
+			prev = header
+		}
+
+		approvals, err := verifier.Verify(ctx, unverifiableTestHeader(t, prev, 14, second, 1, 2))
+		if err != nil {
+			return nil, err
+		}
+		return append(out, approvals), nil
+	}
+
+	source := &testLightClientSource{testSource: second.producers, block: &LightClientBlock{Height: 12, InnerRestHash: innerRestHash}}
+	approvals, err := run(source)
+	require.NoError(t, err)
+
+	// The light client block of each epoch is fetched once, from the next epoch id of its blocks,
+	// the hash of the last block of the previous epoch
+	assert.Equal(t, []*pbnear.CryptoHash{second.id, testHash(102)}, source.lastBlockHashes)
+
+	for i, height := range []uint64{10, 11, 12, 13, 14} {
+		assert.Equal(t, height, approvals[i].Height)
+		assert.False(t, approvals[i].HashVerified)
+		assert.Equal(t, height == 12, approvals[i].InnerLiteVerified)
+		assert.True(t, approvals[i].Quorum)
+		assert.Nil(t, approvals[i].Finalized)
+	}
+	assert.Equal(t, "35", approvals[4].TotalStake.String())
+
+	// An inner rest hash that does not hash to the approved hash proves nothing
+	source = &testLightClientSource{testSource: second.producers, block: &LightClientBlock{Height: 12, InnerRestHash: testHash(201)}}
+	_, err = run(source)
+	assert.ErrorIs(t, err, ErrUntrustedBlockProducers)
+
+	// Without light client block, the next_bp_hash of the first epoch is not trusted
+	_, err = run(&testLightClientSource{testSource: second.producers})
+	assert.ErrorIs(t, err, ErrUntrustedBlockProducers)
+}
+
+func TestVerifier_MissingPrevHeight(t *testing.T) {
+	ctx := context.Background()
+	epoch := newTestEpoch(t, 1, 10, 10, 10)
+
+	// withoutPrevHeight is the header as old format blocks carry it, without previous height
+	withoutPrevHeight := func(header *pbnear.BlockHeader) *pbnear.BlockHeader {
+		header.PrevHeight = 0
+		sealTestHeader(t, header)
+		return header
+	}
+
+	genesis := &pbnear.BlockHeader{Height: 9, Hash: testHash(9)}
+	b10 := testHeader(t, genesis, 10, epoch, 0, 1, 2)
+	b11 := withoutPrevHeight(testHeader(t, b10, 11, epoch, 0, 1, 2))
+	b13 := withoutPrevHeight(testHeader(t, b11, 13, epoch, 0, 1, 2))
+
+	// The previous height is the height of the last verified header
+	verifier := NewVerifier(&Checkpoint{EpochID: epoch.id, BlockProducers: epoch.producers}, nil)
+	for _, header := range []*pbnear.BlockHeader{b10, b11, b13} {
+		approvals, err := verifier.Verify(ctx, header)
+		require.NoError(t, err)
+		assert.True(t, approvals.Quorum, "block #%d", header.Height)
+		assert.Equal(t, header != b13, approvals.Endorsement, "block #%d", header.Height)
+	}
+
+	// Without last verified header, the approvals cannot be verified
+	verifier = NewVerifier(&Checkpoint{EpochID: epoch.id, BlockProducers: epoch.producers}, nil)
+	_, err := verifier.Verify(ctx, b11)
+	assert.ErrorContains(t, err, "block #11 does not carry its previous height")
+}
@@ -0,0 +1,253 @@
+package nodeconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Config is a neard `config.json` document. It's kept as a generic JSON object so that
+// settings unknown to us are preserved when a config is read, modified and written back.
+type Config map[string]interface{}
+
+// RPCPort is the port on which the codec expects the node's JSON-RPC to be reachable on
+// localhost, the console reader resolving previous and final block metadata through it.
+const RPCPort = 3030
+
+type Network string
+
+const (
+	NetworkMainnet Network = "mainnet"
+	NetworkTestnet Network = "testnet"
+)
+
+// Networks lists the networks for which a config can be generated
+var Networks = []Network{NetworkMainnet, NetworkTestnet}
+
+var defaultBootNodes = map[Network][]string{
+	NetworkMainnet: {
+		"ed25519:86EtEy7epneKyrcJwSWP7zsisTkfDRH5CFVszt4qiQYw@35.195.32.249:24567",
+		"ed25519:BFB78VTDBBfCY4jCP99zWxhXUcFAZqR22oSx2KEr8UM1@35.229.222.235:24567",
+		"ed25519:Cw1YyiX9cybvz3yZcbYdG7oDV6D7Eihdfc8eM1e1KKoh@35.195.27.104:24567",
+		"ed25519:33g3PZRdDvzdRpRpFRZLyscJdbMxUA3j3Rf2ktSYwwF8@34.94.132.112:24567",
+		"ed25519:CDQFcD9bHUWdc31rDfRi4ZrJczxg8derCzybcac142tK@35.196.209.192:24567",
+	},
+	NetworkTestnet: {
+		"ed25519:4k9csx6zMiXy4waUvRMPTkEtAS2RFKLVScocR5HwN53P@34.73.25.182:24567",
+		"ed25519:D2t1KTLJuwKDhbcD9tMXcXaydMNykA99Cedz7SkJkdj2@35.234.138.23:24567",
+		"ed25519:CAzhtaUPrxCuwJoFzceebiThD9wBofzqqEMCiupZ4M3E@34.94.177.51:24567",
+	},
+}
+
+func ParseNetwork(in string) (Network, error) {
+	for _, network := range Networks {
+		if string(network) == in {
+			return network, nil
+		}
+	}
+
+	return "", fmt.Errorf("unknown network %q, expected one of %s", in, strings.Join(networkNames(), ", "))
+}
+
+// ChainID returns the chain ID of the network's genesis, which for NEAR networks is the
+// network's name.
+func (n Network) ChainID() string {
+	return string(n)
+}
+
+func networkNames() (out []string) {
+	for _, network := range Networks {
+		out = append(out, string(network))
+	}
+	return
+}
+
+type GenerateOptions struct {
+	// BootNodes overrides the network's default boot nodes when non-empty
+	BootNodes []string
+	// RPCAddr is the address the JSON-RPC server listens on, defaults to `127.0.0.1:3030`
+	RPCAddr string
+	// TrackedShards are the shards tracked by the node, defaults to `[0]` which makes the
+	// node track all shards.
+	TrackedShards []uint64
+}
+
+// Generate returns a neard config suitable to run a Firehose reader node on the network.
+// Settings not present in the config get neard's defaults.
+func Generate(network Network, opts GenerateOptions) (Config, error) {
+	bootNodes := opts.BootNodes
+	if len(bootNodes) == 0 {
+		var found bool
+		if bootNodes, found = defaultBootNodes[network]; !found {
+			return nil, fmt.Errorf("unknown network %q, expected one of %s", network, strings.Join(networkNames(), ", "))
+		}
+	}
+
+	rpcAddr := opts.RPCAddr
+	if rpcAddr == "" {
+		rpcAddr = fmt.Sprintf("127.0.0.1:%d", RPCPort)
+	}
+
+	trackedShards := opts.TrackedShards
+	if len(trackedShards) == 0 {
+		// Tracking any shard makes neard track all of them, which the reader needs to
+		// produce blocks with every chunk.
+		trackedShards = []uint64{0}
+	}
+
+	// The document is round tripped through JSON so that the returned config only holds JSON
+	// types, the same as a config read with Parse.
+	return roundTrip(map[string]interface{}{
+		"genesis_file":       "genesis.json",
+		"node_key_file":      "node_key.json",
+		"validator_key_file": "validator_key.json",
+		"rpc": map[string]interface{}{
+			"addr":                 rpcAddr,
+			"cors_allowed_origins": []string{"*"},
+		},
+		"network": map[string]interface{}{
+			"addr":       "0.0.0.0:24567",
+			"boot_nodes": strings.Join(bootNodes, ","),
+		},
+		"tracked_accounts":  []string{},
+		"tracked_shards":    trackedShards,
+		"archive":           true,
+		"save_trie_changes": true,
+		"store": map[string]interface{}{
+			"max_open_files":    10000,
+			"enable_statistics": false,
+		},
+	})
+}
+
+func roundTrip(in interface{}) (Config, error) {
+	content, err := json.Marshal(in)
+	if err != nil {
+		return nil, fmt.Errorf("marshal config: %w", err)
+	}
+
+	return Parse(content)
+}
+
+func Parse(content []byte) (Config, error) {
+	config := Config{}
+	if err := json.Unmarshal(content, &config); err != nil {
+		return nil, fmt.Errorf("unmarshal config: %w", err)
+	}
+
+	return config, nil
+}
+
+func (c Config) Marshal() ([]byte, error) {
+	return json.MarshalIndent(c, "", "  ")
+}
+
+// Lookup returns the value at the dot separated path, ex: `rpc.addr`, and whether it's present
+func (c Config) Lookup(path string) (interface{}, bool) {
+	var current interface{} = map[string]interface{}(c)
+	for _, segment := range strings.Split(path, ".") {
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+
+		if current, ok = object[segment]; !ok {
+			return nil, false
+		}
+	}
+
+	return current, true
+}
+
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// Issue is a config setting that breaks (SeverityError) or may break (SeverityWarning) a
+// Firehose reader node.
+type Issue struct {
+	Severity Severity `json:"severity"`
+	Path     string   `json:"path"`
+	Message  string   `json:"message"`
+}
+
+func (i *Issue) String() string {
+	return fmt.Sprintf("%s: %s: %s", i.Severity, i.Path, i.Message)
+}
+
+// minGCEpochsToKeep is the minimum number of epochs a non-archival reader should keep, the codec
+// fetching through RPC the previous and final blocks it has not seen, for example after a restart.
+const minGCEpochsToKeep = 3
+
+// Validate checks the settings of the config a Firehose reader node depends on, issues are
+// sorted by path.
+func (c Config) Validate() (issues []*Issue) {
+	add := func(severity Severity, path string, message string, args ...interface{}) {
+		issues = append(issues, &Issue{Severity: severity, Path: path, Message: fmt.Sprintf(message, args...)})
+	}
+
+	rpcAddr, found := c.Lookup("rpc.addr")
+	if rpc, rpcFound := c.Lookup("rpc"); !rpcFound || rpc == nil || !found || rpcAddr == "" {
+		add(SeverityError, "rpc", "RPC is disabled but the codec resolves previous and final blocks through http://localhost:%d", RPCPort)
+	} else if addr, ok := rpcAddr.(string); !ok {
+		add(SeverityError, "rpc.addr", "expected a string, got %v", rpcAddr)
+	} else {
+		validateRPCAddr(addr, add)
+	}
+
+	archive, _ := c.Lookup("archive")
+	isArchive := archive == true
+	if !isArchive {
+		add(SeverityWarning, "archive", "node is not archival, the reader can only produce blocks from the node's sync point onward")
+
+		if value, found := c.Lookup("gc_num_epochs_to_keep"); found {
+			if epochs, ok := value.(float64); ok && epochs < minGCEpochsToKeep {
+				add(SeverityError, "gc_num_epochs_to_keep", "keeping %v epochs is too aggressive, at least %d are needed for the codec to resolve previous and final blocks after a restart", epochs, minGCEpochsToKeep)
+			}
+		}
+
+		if value, found := c.Lookup("save_trie_changes"); found && value == false {
+			add(SeverityError, "save_trie_changes", "non-archival nodes must save trie changes to garbage collect, neard refuses to start otherwise")
+		}
+	}
+
+	trackedShards, _ := c.Lookup("tracked_shards")
+	shards, _ := trackedShards.([]interface{})
+	if _, found := c.Lookup("tracked_shards_config"); !found && len(shards) == 0 {
+		add(SeverityError, "tracked_shards", "no shard is tracked, blocks would be produced without their chunks, use [0] to track all shards")
+	}
+
+	bootNodes, _ := c.Lookup("network.boot_nodes")
+	if bootNodes == nil || bootNodes == "" {
+		add(SeverityWarning, "network.boot_nodes", "no boot nodes, the node will not find peers unless they are given on the command line with --boot-nodes")
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool { return issues[i].Path < issues[j].Path })
+	return issues
+}
+
+func validateRPCAddr(addr string, add func(severity Severity, path string, message string, args ...interface{})) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		add(SeverityError, "rpc.addr", "invalid address %q: %s", addr, err)
+		return
+	}
+
+	if portNum, err := strconv.Atoi(port); err != nil || portNum != RPCPort {
+		add(SeverityError, "rpc.addr", "RPC listens on port %s but the codec expects it on port %d", port, RPCPort)
+	}
+
+	switch host {
+	case "127.0.0.1", "::1", "localhost":
+	case "", "0.0.0.0", "::":
+		add(SeverityWarning, "rpc.addr", "RPC listens on all interfaces, prefer 127.0.0.1:%d to keep it private to the reader", RPCPort)
+	default:
+		add(SeverityError, "rpc.addr", "RPC listens on %s only, which the codec cannot reach through localhost", host)
+	}
+}
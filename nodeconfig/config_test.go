@@ -0,0 +1,115 @@
+package nodeconfig
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	for _, network := range Networks {
+		t.Run(string(network), func(t *testing.T) {
+			config, err := Generate(network, GenerateOptions{})
+			require.NoError(t, err)
+
+			assert.Empty(t, config.Validate())
+
+			addr, _ := config.Lookup("rpc.addr")
+			assert.Equal(t, "127.0.0.1:3030", addr)
+
+			archive, _ := config.Lookup("archive")
+			assert.Equal(t, true, archive)
+
+			bootNodes, _ := config.Lookup("network.boot_nodes")
+			assert.NotEmpty(t, bootNodes)
+		})
+	}
+
+	config, err := Generate(NetworkTestnet, GenerateOptions{BootNodes: []string{"ed25519:abc@127.0.0.1:24567"}, TrackedShards: []uint64{1, 2}})
+	require.NoError(t, err)
+
+	bootNodes, _ := config.Lookup("network.boot_nodes")
+	assert.Equal(t, "ed25519:abc@127.0.0.1:24567", bootNodes)
+
+	trackedShards, _ := config.Lookup("tracked_shards")
+	assert.Equal(t, []interface{}{1.0, 2.0}, trackedShards)
+
+	_, err = Generate(Network("unknown"), GenerateOptions{})
+	assert.Error(t, err)
+}
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   string
+		expected []*Issue
+	}{
+		{
+			"devel mainnet-sync config",
+			mustReadFile(t, "../devel/mainnet-sync/mindreader/config.json"),
+			[]*Issue{
+				{SeverityWarning, "rpc.addr", "RPC listens on all interfaces, prefer 127.0.0.1:3030 to keep it private to the reader"},
+			},
+		},
+		{
+			"devel battlefield config",
+			mustReadFile(t, "../devel/battlefield/reader/config.json"),
+			[]*Issue{
+				{SeverityError, "rpc.addr", "RPC listens on port 3034 but the codec expects it on port 3030"},
+				{SeverityWarning, "rpc.addr", "RPC listens on all interfaces, prefer 127.0.0.1:3030 to keep it private to the reader"},
+			},
+		},
+		{
+			"rpc disabled",
+			`{"archive": true, "tracked_shards": [0], "network": {"boot_nodes": "a"}}`,
+			[]*Issue{
+				{SeverityError, "rpc", "RPC is disabled but the codec resolves previous and final blocks through http://localhost:3030"},
+			},
+		},
+		{
+			"rpc unreachable",
+			`{"rpc": {"addr": "10.0.0.1:3031"}, "archive": true, "tracked_shards": [0], "network": {"boot_nodes": "a"}}`,
+			[]*Issue{
+				{SeverityError, "rpc.addr", "RPC listens on port 3031 but the codec expects it on port 3030"},
+				{SeverityError, "rpc.addr", "RPC listens on 10.0.0.1 only, which the codec cannot reach through localhost"},
+			},
+		},
+		{
+			"aggressive gc",
+			`{"rpc": {"addr": "127.0.0.1:3030"}, "gc_num_epochs_to_keep": 2, "save_trie_changes": false, "tracked_shards_config": "AllShards"}`,
+			[]*Issue{
+				{SeverityWarning, "archive", "node is not archival, the reader can only produce blocks from the node's sync point onward"},
+				{SeverityError, "gc_num_epochs_to_keep", "keeping 2 epochs is too aggressive, at least 3 are needed for the codec to resolve previous and final blocks after a restart"},
+				{SeverityWarning, "network.boot_nodes", "no boot nodes, the node will not find peers unless they are given on the command line with --boot-nodes"},
+				{SeverityError, "save_trie_changes", "non-archival nodes must save trie changes to garbage collect, neard refuses to start otherwise"},
+			},
+		},
+		{
+			"no tracked shards",
+			`{"rpc": {"addr": "127.0.0.1:3030"}, "archive": true, "tracked_shards": [], "network": {"boot_nodes": "a"}}`,
+			[]*Issue{
+				{SeverityError, "tracked_shards", "no shard is tracked, blocks would be produced without their chunks, use [0] to track all shards"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, err := Parse([]byte(tt.config))
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, config.Validate())
+		})
+	}
+}
+
+func mustReadFile(t *testing.T, path string) string {
+	t.Helper()
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	return string(content)
+}
@@ -0,0 +1,148 @@
+package nodeconfig
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Genesis holds the identity fields of a neard `genesis.json` document
+type Genesis struct {
+	ChainID         string `json:"chain_id"`
+	GenesisHeight   uint64 `json:"genesis_height"`
+	ProtocolVersion uint64 `json:"protocol_version"`
+
+	// SHA256 is the hex encoded sha256 of the genesis file content, empty when not computed
+	SHA256 string `json:"-"`
+}
+
+// ReadGenesis reads the identity fields of a genesis document. The top-level fields are scanned
+// as a stream of tokens, stopping as soon as all identity fields are found, so that the genesis
+// records, which are huge on mainnet, are usually neither read nor decoded.
+func ReadGenesis(reader io.Reader) (*Genesis, error) {
+	decoder := json.NewDecoder(reader)
+	if token, err := decoder.Token(); err != nil {
+		return nil, fmt.Errorf("read genesis: %w", err)
+	} else if token != json.Delim('{') {
+		return nil, fmt.Errorf("genesis is not a JSON object")
+	}
+
+	genesis := &Genesis{}
+	fields := map[string]any{
+		"chain_id":         &genesis.ChainID,
+		"genesis_height":   &genesis.GenesisHeight,
+		"protocol_version": &genesis.ProtocolVersion,
+	}
+
+	for len(fields) > 0 && decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, fmt.Errorf("read genesis: %w", err)
+		}
+
+		// Object keys are always strings
+		key := token.(string)
+		if field, found := fields[key]; found {
+			if err := decoder.Decode(field); err != nil {
+				return nil, fmt.Errorf("unmarshal genesis %s: %w", key, err)
+			}
+			delete(fields, key)
+			continue
+		}
+
+		if err := skipJSONValue(decoder); err != nil {
+			return nil, fmt.Errorf("read genesis %s: %w", key, err)
+		}
+	}
+
+	if genesis.ChainID == "" {
+		return nil, fmt.Errorf("genesis has no chain_id")
+	}
+
+	return genesis, nil
+}
+
+// GenesisSHA256 returns the hex encoded sha256 of a genesis file content
+func GenesisSHA256(reader io.Reader) (string, error) {
+	checksum := sha256.New()
+	if _, err := io.Copy(checksum, reader); err != nil {
+		return "", fmt.Errorf("read genesis: %w", err)
+	}
+
+	return hex.EncodeToString(checksum.Sum(nil)), nil
+}
+
+// skipJSONValue reads the next value of the decoder without decoding it
+func skipJSONValue(decoder *json.Decoder) error {
+	depth := 0
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+
+		switch token {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+
+		if depth == 0 {
+			return nil
+		}
+	}
+}
+
+// BootNodesNetwork returns the network whose default boot nodes are used by the config, if any
+func (c Config) BootNodesNetwork() (Network, bool) {
+	value, _ := c.Lookup("network.boot_nodes")
+	bootNodes, _ := value.(string)
+
+	for _, bootNode := range strings.Split(bootNodes, ",") {
+		bootNode = strings.TrimSpace(bootNode)
+		if bootNode == "" {
+			continue
+		}
+
+		for _, network := range Networks {
+			for _, candidate := range defaultBootNodes[network] {
+				if candidate == bootNode {
+					return network, true
+				}
+			}
+		}
+	}
+
+	return "", false
+}
+
+// IdentityExpectations are the expected identity of the network a node is bootstrapped for,
+// empty fields are not checked.
+type IdentityExpectations struct {
+	ChainID       string
+	GenesisSHA256 string
+}
+
+// CheckIdentity verifies that the genesis matches the expectations and that the config, when
+// not nil, does not point to another network than the genesis' one.
+func CheckIdentity(genesis *Genesis, config Config, expected IdentityExpectations) error {
+	if expected.ChainID != "" && genesis.ChainID != expected.ChainID {
+		return fmt.Errorf("genesis is for chain %q but the node is expected to run on %q", genesis.ChainID, expected.ChainID)
+	}
+
+	if expected.GenesisSHA256 != "" && !strings.EqualFold(genesis.SHA256, expected.GenesisSHA256) {
+		return fmt.Errorf("genesis of chain %q has sha256 %s but %s is expected, the genesis file is probably stale", genesis.ChainID, genesis.SHA256, expected.GenesisSHA256)
+	}
+
+	if config != nil {
+		if network, found := config.BootNodesNetwork(); found && network.ChainID() != genesis.ChainID {
+			return fmt.Errorf("config uses %s boot nodes but genesis is for chain %q", network, genesis.ChainID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package nodeconfig
+
+import (
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadGenesis(t *testing.T) {
+	file, err := os.Open("../devel/mainnet-sync/mindreader/genesis.json")
+	require.NoError(t, err)
+	defer file.Close()
+
+	genesis, err := ReadGenesis(file)
+	require.NoError(t, err)
+
+	assert.Equal(t, &Genesis{
+		ChainID:         "mainnet",
+		GenesisHeight:   9820210,
+		ProtocolVersion: 29,
+	}, genesis)
+
+	_, err = file.Seek(0, io.SeekStart)
+	require.NoError(t, err)
+
+	checksum, err := GenesisSHA256(file)
+	require.NoError(t, err)
+	assert.Equal(t, "d7a09dc768cea5f307b7411a93626b4279164304bf13ad6b3687c0e822515a05", checksum)
+
+	// Values before the identity fields are skipped
+	genesis, err = ReadGenesis(strings.NewReader(`{"records": [{"Account": {"account_id": "a", "amount": [1, 2]}}], "validators": {}, "chain_id": "testnet", "genesis_height": 42}`))
+	require.NoError(t, err)
+	assert.Equal(t, &Genesis{ChainID: "testnet", GenesisHeight: 42}, genesis)
+
+	// Reading stops once all identity fields are found
+	genesis, err = ReadGenesis(io.MultiReader(
+		strings.NewReader(`{"chain_id": "mainnet", "genesis_height": 1, "protocol_version": 2, "records": [`),
+		iotest.ErrReader(errors.New("records must not be read")),
+	))
+	require.NoError(t, err)
+	assert.Equal(t, &Genesis{ChainID: "mainnet", GenesisHeight: 1, ProtocolVersion: 2}, genesis)
+
+	_, err = ReadGenesis(strings.NewReader(`{"genesis_height": 1}`))
+	assert.EqualError(t, err, "genesis has no chain_id")
+
+	_, err = ReadGenesis(strings.NewReader(`["mainnet"]`))
+	assert.EqualError(t, err, "genesis is not a JSON object")
+
+	_, err = ReadGenesis(strings.NewReader(`{"records": [1, 2`))
+	assert.Error(t, err)
+}
+
+func TestCheckIdentity(t *testing.T) {
+	mainnetGenesis := &Genesis{ChainID: "mainnet", SHA256: "d7a09dc768cea5f307b7411a93626b4279164304bf13ad6b3687c0e822515a05"}
+
+	mainnetConfig, err := Generate(NetworkMainnet, GenerateOptions{})
+	require.NoError(t, err)
+
+	testnetConfig, err := Generate(NetworkTestnet, GenerateOptions{})
+	require.NoError(t, err)
+
+	customConfig, err := Generate(NetworkMainnet, GenerateOptions{BootNodes: []string{"ed25519:58Ra6ybSsjtAo8aHJk65Ed5mfUkVekR2aMBZo5Q8C8ir@127.0.0.1:24560"}})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		genesis       *Genesis
+		config        Config
+		expected      IdentityExpectations
+		expectedError string
+	}{
+		{"no expectations", mainnetGenesis, nil, IdentityExpectations{}, ""},
+		{"matching", mainnetGenesis, mainnetConfig, IdentityExpectations{ChainID: "mainnet", GenesisSHA256: strings.ToUpper(mainnetGenesis.SHA256)}, ""},
+		{"custom boot nodes", mainnetGenesis, customConfig, IdentityExpectations{ChainID: "mainnet"}, ""},
+		{"chain mismatch", mainnetGenesis, mainnetConfig, IdentityExpectations{ChainID: "testnet"}, `genesis is for chain "mainnet" but the node is expected to run on "testnet"`},
+		{"stale genesis", mainnetGenesis, mainnetConfig, IdentityExpectations{GenesisSHA256: "aa"}, `genesis of chain "mainnet" has sha256 d7a09dc768cea5f307b7411a93626b4279164304bf13ad6b3687c0e822515a05 but aa is expected, the genesis file is probably stale`},
+		{"boot nodes mismatch", mainnetGenesis, testnetConfig, IdentityExpectations{ChainID: "mainnet"}, `config uses testnet boot nodes but genesis is for chain "mainnet"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckIdentity(tt.genesis, tt.config, tt.expected)
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedError)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package nodeconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Patch is a JSON merge patch (RFC 7386) of a config: objects are merged recursively, `null`
+// removes the setting and any other value replaces it.
+type Patch map[string]interface{}
+
+func ParsePatch(content []byte) (Patch, error) {
+	patch := Patch{}
+	if err := json.Unmarshal(content, &patch); err != nil {
+		return nil, fmt.Errorf("unmarshal patch: %w", err)
+	}
+
+	return patch, nil
+}
+
+// ParseSetPatch parses a `path=value` setting, ex: `rpc.addr=127.0.0.1:3030`, into a patch. The
+// value is used as JSON when it's valid JSON (`true`, `42`, `[0]`, `null` to remove the
+// setting) and as a string otherwise.
+func ParseSetPatch(in string) (Patch, error) {
+	path, rawValue, found := strings.Cut(in, "=")
+	if !found || path == "" {
+		return nil, fmt.Errorf("invalid config setting %q, expected <path>=<value>", in)
+	}
+
+	var value interface{} = rawValue
+	if err := json.Unmarshal([]byte(rawValue), &value); err != nil {
+		value = rawValue
+	}
+
+	segments := strings.Split(path, ".")
+	patch := Patch{}
+	current := map[string]interface{}(patch)
+	for i, segment := range segments {
+		if segment == "" {
+			return nil, fmt.Errorf("invalid config setting path %q", path)
+		}
+
+		if i == len(segments)-1 {
+			current[segment] = value
+			break
+		}
+
+		next := map[string]interface{}{}
+		current[segment] = next
+		current = next
+	}
+
+	return patch, nil
+}
+
+// ExpandStrings returns a copy of the patch with expand applied to each of its string values
+func (p Patch) ExpandStrings(expand func(in string) (string, error)) (Patch, error) {
+	out, err := expandStrings(map[string]interface{}(p), expand)
+	if err != nil {
+		return nil, err
+	}
+
+	return Patch(out.(map[string]interface{})), nil
+}
+
+func expandStrings(in interface{}, expand func(in string) (string, error)) (interface{}, error) {
+	switch value := in.(type) {
+	case string:
+		return expand(value)
+
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(value))
+		for key, element := range value {
+			expanded, err := expandStrings(element, expand)
+			if err != nil {
+				return nil, err
+			}
+			out[key] = expanded
+		}
+		return out, nil
+
+	case []interface{}:
+		out := make([]interface{}, len(value))
+		for i, element := range value {
+			expanded, err := expandStrings(element, expand)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = expanded
+		}
+		return out, nil
+	}
+
+	return in, nil
+}
+
+// Apply returns a copy of the config with the patch applied, the config itself is left untouched
+func (c Config) Apply(patch Patch) Config {
+	return Config(mergePatch(map[string]interface{}(c), map[string]interface{}(patch)).(map[string]interface{}))
+}
+
+func mergePatch(target interface{}, patch interface{}) interface{} {
+	patchObject, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	targetObject, _ := target.(map[string]interface{})
+	out := make(map[string]interface{}, len(targetObject)+len(patchObject))
+	for key, value := range targetObject {
+		out[key] = value
+	}
+
+	for key, value := range patchObject {
+		if value == nil {
+			delete(out, key)
+			continue
+		}
+
+		out[key] = mergePatch(out[key], value)
+	}
+
+	return out
+}
+
+// Change is a setting differing between two configs, From or To being nil when the setting is
+// absent from the corresponding config.
+type Change struct {
+	Path string
+	From interface{}
+	To   interface{}
+}
+
+func (c *Change) String() string {
+	return fmt.Sprintf("%s: %s -> %s", c.Path, changeValue(c.From), changeValue(c.To))
+}
+
+func changeValue(in interface{}) string {
+	if in == nil {
+		return "<unset>"
+	}
+
+	content, err := json.Marshal(in)
+	if err != nil {
+		return fmt.Sprintf("%v", in)
+	}
+	return string(content)
+}
+
+// Diff returns the settings changed from the config to the other config, objects being compared
+// setting by setting and other values as a whole. Changes are sorted by path.
+func (c Config) Diff(other Config) (changes []*Change) {
+	diffValues("", map[string]interface{}(c), map[string]interface{}(other), &changes)
+
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Path < changes[j].Path })
+	return changes
+}
+
+func diffValues(path string, from, to interface{}, changes *[]*Change) {
+	fromObject, fromIsObject := from.(map[string]interface{})
+	toObject, toIsObject := to.(map[string]interface{})
+	if !fromIsObject || !toIsObject {
+		if !reflect.DeepEqual(from, to) {
+			*changes = append(*changes, &Change{Path: path, From: from, To: to})
+		}
+		return
+	}
+
+	for key, fromValue := range fromObject {
+		diffValues(joinPath(path, key), fromValue, toObject[key], changes)
+	}
+
+	for key, toValue := range toObject {
+		if _, found := fromObject[key]; !found {
+			diffValues(joinPath(path, key), nil, toValue, changes)
+		}
+	}
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
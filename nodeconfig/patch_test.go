@@ -0,0 +1,90 @@
+package nodeconfig
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSetPatch(t *testing.T) {
+	tests := []struct {
+		in            string
+		expected      Patch
+		expectedError bool
+	}{
+		{"archive=true", Patch{"archive": true}, false},
+		{"rpc.addr=127.0.0.1:3030", Patch{"rpc": map[string]interface{}{"addr": "127.0.0.1:3030"}}, false},
+		{"tracked_shards=[0, 1]", Patch{"tracked_shards": []interface{}{0.0, 1.0}}, false},
+		{"store.path=null", Patch{"store": map[string]interface{}{"path": nil}}, false},
+		{"network.boot_nodes=", Patch{"network": map[string]interface{}{"boot_nodes": ""}}, false},
+		{"archive", nil, true},
+		{"=true", nil, true},
+		{"rpc..addr=a", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			patch, err := ParseSetPatch(tt.in)
+			if tt.expectedError {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, patch)
+		})
+	}
+}
+
+func TestConfig_Apply(t *testing.T) {
+	config, err := Parse([]byte(`{"archive": false, "rpc": {"addr": "0.0.0.0:3030", "cors_allowed_origins": ["*"]}, "store": {"path": "data"}}`))
+	require.NoError(t, err)
+
+	patch, err := ParsePatch([]byte(`{"archive": true, "rpc": {"addr": "127.0.0.1:3030"}, "store": null, "tracked_shards": [0]}`))
+	require.NoError(t, err)
+
+	patched := config.Apply(patch)
+
+	expected, err := Parse([]byte(`{"archive": true, "rpc": {"addr": "127.0.0.1:3030", "cors_allowed_origins": ["*"]}, "tracked_shards": [0]}`))
+	require.NoError(t, err)
+	assert.Equal(t, expected, patched)
+
+	// The original config is left untouched
+	addr, _ := config.Lookup("rpc.addr")
+	assert.Equal(t, "0.0.0.0:3030", addr)
+
+	assert.Equal(t, []string{
+		`archive: false -> true`,
+		`rpc.addr: "0.0.0.0:3030" -> "127.0.0.1:3030"`,
+		`store: {"path":"data"} -> <unset>`,
+		`tracked_shards: <unset> -> [0]`,
+	}, changeStrings(config.Diff(patched)))
+}
+
+func TestPatch_ExpandStrings(t *testing.T) {
+	patch, err := ParsePatch([]byte(`{"store": {"path": "/data/{hostname}"}, "network": {"boot_nodes": ["{hostname}:1", 2]}}`))
+	require.NoError(t, err)
+
+	expanded, err := patch.ExpandStrings(func(in string) (string, error) {
+		return strings.ReplaceAll(in, "{hostname}", "reader-0"), nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, Patch{
+		"store":   map[string]interface{}{"path": "/data/reader-0"},
+		"network": map[string]interface{}{"boot_nodes": []interface{}{"reader-0:1", 2.0}},
+	}, expanded)
+
+	_, err = patch.ExpandStrings(func(in string) (string, error) { return "", fmt.Errorf("failed") })
+	assert.Error(t, err)
+}
+
+func changeStrings(changes []*Change) (out []string) {
+	for _, change := range changes {
+		out = append(out, change.String())
+	}
+	return
+}
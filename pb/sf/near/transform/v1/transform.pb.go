@@ -20,14 +20,121 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type AccountClassPredicate_Role int32
+
+const (
+	AccountClassPredicate_RECEIVER    AccountClassPredicate_Role = 0
+	AccountClassPredicate_SIGNER      AccountClassPredicate_Role = 1
+	AccountClassPredicate_PREDECESSOR AccountClassPredicate_Role = 2
+)
+
+// Enum value maps for AccountClassPredicate_Role.
+var (
+	AccountClassPredicate_Role_name = map[int32]string{
+		0: "RECEIVER",
+		1: "SIGNER",
+		2: "PREDECESSOR",
+	}
+	AccountClassPredicate_Role_value = map[string]int32{
+		"RECEIVER":    0,
+		"SIGNER":      1,
+		"PREDECESSOR": 2,
+	}
+)
+
+func (x AccountClassPredicate_Role) Enum() *AccountClassPredicate_Role {
+	p := new(AccountClassPredicate_Role)
+	*p = x
+	return p
+}
+
+func (x AccountClassPredicate_Role) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccountClassPredicate_Role) Descriptor() protoreflect.EnumDescriptor {
+	return file_sf_near_transform_v1_transform_proto_enumTypes[0].Descriptor()
+}
+
+func (AccountClassPredicate_Role) Type() protoreflect.EnumType {
+	return &file_sf_near_transform_v1_transform_proto_enumTypes[0]
+}
+
+func (x AccountClassPredicate_Role) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccountClassPredicate_Role.Descriptor instead.
+func (AccountClassPredicate_Role) EnumDescriptor() ([]byte, []int) {
+	return file_sf_near_transform_v1_transform_proto_rawDescGZIP(), []int{3, 0}
+}
+
+type AccountClassPredicate_Class int32
+
+const (
+	AccountClassPredicate_NAMED        AccountClassPredicate_Class = 0
+	AccountClassPredicate_IMPLICIT     AccountClassPredicate_Class = 1
+	AccountClassPredicate_ETH_IMPLICIT AccountClassPredicate_Class = 2
+)
+
+// Enum value maps for AccountClassPredicate_Class.
+var (
+	AccountClassPredicate_Class_name = map[int32]string{
+		0: "NAMED",
+		1: "IMPLICIT",
+		2: "ETH_IMPLICIT",
+	}
+	AccountClassPredicate_Class_value = map[string]int32{
+		"NAMED":        0,
+		"IMPLICIT":     1,
+		"ETH_IMPLICIT": 2,
+	}
+)
+
+func (x AccountClassPredicate_Class) Enum() *AccountClassPredicate_Class {
+	p := new(AccountClassPredicate_Class)
+	*p = x
+	return p
+}
+
+func (x AccountClassPredicate_Class) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccountClassPredicate_Class) Descriptor() protoreflect.EnumDescriptor {
+	return file_sf_near_transform_v1_transform_proto_enumTypes[1].Descriptor()
+}
+
+func (AccountClassPredicate_Class) Type() protoreflect.EnumType {
+	return &file_sf_near_transform_v1_transform_proto_enumTypes[1]
+}
+
+func (x AccountClassPredicate_Class) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccountClassPredicate_Class.Descriptor instead.
+func (AccountClassPredicate_Class) EnumDescriptor() ([]byte, []int) {
+	return file_sf_near_transform_v1_transform_proto_rawDescGZIP(), []int{3, 1}
+}
+
 // BasicReceiptFilter applies a logical OR everywhere it can
 type BasicReceiptFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Accounts             []string            `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
-	PrefixAndSuffixPairs []*PrefixSuffixPair `protobuf:"bytes,2,rep,name=prefix_and_suffix_pairs,json=prefixAndSuffixPairs,proto3" json:"prefix_and_suffix_pairs,omitempty"`
+	Accounts               []string                 `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	PrefixAndSuffixPairs   []*PrefixSuffixPair      `protobuf:"bytes,2,rep,name=prefix_and_suffix_pairs,json=prefixAndSuffixPairs,proto3" json:"prefix_and_suffix_pairs,omitempty"`
+	AccountMatchers        []*AccountMatcher        `protobuf:"bytes,3,rep,name=account_matchers,json=accountMatchers,proto3" json:"account_matchers,omitempty"`
+	AccountClassPredicates []*AccountClassPredicate `protobuf:"bytes,4,rep,name=account_class_predicates,json=accountClassPredicates,proto3" json:"account_class_predicates,omitempty"`
+	// When true, `accounts`, `prefix_and_suffix_pairs` and `account_matchers` are also matched against the inner
+	// receiver of NEP-366 delegate actions (meta-transactions), so that an account called through a relayer matches
+	// even though the outer receipt's receiver is the delegate action's sender.
+	UnwrapDelegateActions bool `protobuf:"varint,5,opt,name=unwrap_delegate_actions,json=unwrapDelegateActions,proto3" json:"unwrap_delegate_actions,omitempty"`
+	// Matches action receipts carrying a NEP-366 delegate action (meta-transaction) whose inner sender, the account
+	// that signed the delegate action and whose relayer submitted it, is one of these accounts.
+	DelegateSenders []string `protobuf:"bytes,6,rep,name=delegate_senders,json=delegateSenders,proto3" json:"delegate_senders,omitempty"`
 }
 
 func (x *BasicReceiptFilter) Reset() {
@@ -76,6 +183,34 @@ func (x *BasicReceiptFilter) GetPrefixAndSuffixPairs() []*PrefixSuffixPair {
 	return nil
 }
 
+func (x *BasicReceiptFilter) GetAccountMatchers() []*AccountMatcher {
+	if x != nil {
+		return x.AccountMatchers
+	}
+	return nil
+}
+
+func (x *BasicReceiptFilter) GetAccountClassPredicates() []*AccountClassPredicate {
+	if x != nil {
+		return x.AccountClassPredicates
+	}
+	return nil
+}
+
+func (x *BasicReceiptFilter) GetUnwrapDelegateActions() bool {
+	if x != nil {
+		return x.UnwrapDelegateActions
+	}
+	return false
+}
+
+func (x *BasicReceiptFilter) GetDelegateSenders() []string {
+	if x != nil {
+		return x.DelegateSenders
+	}
+	return nil
+}
+
 // PrefixSuffixPair applies a logical AND to prefix and suffix when both fields are non-empty.
 // * {prefix="hello",suffix="world"} will match "hello.world" but not "hello.friend"
 // * {prefix="hello",suffix=""}      will match both "hello.world" and "hello.friend"
@@ -138,15 +273,202 @@ func (x *PrefixSuffixPair) GetSuffix() string {
 	return ""
 }
 
+// AccountMatcher matches NEAR account IDs following the account hierarchy, each dot-separated segment
+// being a level, so that a parent account is never confused with an account that merely ends with the
+// same characters.
+// * {exact="foo.near"}          will match "foo.near" only
+// * {subaccount_of="foo.near"}  will match "foo.near", "app.foo.near" and "v1.app.foo.near" but not "barfoo.near"
+// * {direct_child_of="near"}    will match "foo.near" but neither "near", "app.foo.near" nor "foonear"
+// * {glob="app.*.near"}         will match "app.foo.near" but neither "app.near" nor "app.foo.bar.near"
+// * {glob="**.sweat"}           will match "foo.sweat" and "a.b.sweat" but not "sweat"
+//
+// In a glob, `*` matches exactly one segment (or part of one, ex: "pool-*.near"), `?` matches a single
+// character and `**` matches one or more segments. Accounts and patterns must respect NEAR account ID rules.
+type AccountMatcher struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Matcher:
+	//	*AccountMatcher_Exact
+	//	*AccountMatcher_SubaccountOf
+	//	*AccountMatcher_DirectChildOf
+	//	*AccountMatcher_Glob
+	Matcher isAccountMatcher_Matcher `protobuf_oneof:"matcher"`
+}
+
+func (x *AccountMatcher) Reset() {
+	*x = AccountMatcher{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_near_transform_v1_transform_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountMatcher) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountMatcher) ProtoMessage() {}
+
+func (x *AccountMatcher) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_near_transform_v1_transform_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountMatcher.ProtoReflect.Descriptor instead.
+func (*AccountMatcher) Descriptor() ([]byte, []int) {
+	return file_sf_near_transform_v1_transform_proto_rawDescGZIP(), []int{2}
+}
+
+func (m *AccountMatcher) GetMatcher() isAccountMatcher_Matcher {
+	if m != nil {
+		return m.Matcher
+	}
+	return nil
+}
+
+func (x *AccountMatcher) GetExact() string {
+	if x, ok := x.GetMatcher().(*AccountMatcher_Exact); ok {
+		return x.Exact
+	}
+	return ""
+}
+
+func (x *AccountMatcher) GetSubaccountOf() string {
+	if x, ok := x.GetMatcher().(*AccountMatcher_SubaccountOf); ok {
+		return x.SubaccountOf
+	}
+	return ""
+}
+
+func (x *AccountMatcher) GetDirectChildOf() string {
+	if x, ok := x.GetMatcher().(*AccountMatcher_DirectChildOf); ok {
+		return x.DirectChildOf
+	}
+	return ""
+}
+
+func (x *AccountMatcher) GetGlob() string {
+	if x, ok := x.GetMatcher().(*AccountMatcher_Glob); ok {
+		return x.Glob
+	}
+	return ""
+}
+
+type isAccountMatcher_Matcher interface {
+	isAccountMatcher_Matcher()
+}
+
+type AccountMatcher_Exact struct {
+	Exact string `protobuf:"bytes,1,opt,name=exact,proto3,oneof"`
+}
+
+type AccountMatcher_SubaccountOf struct {
+	SubaccountOf string `protobuf:"bytes,2,opt,name=subaccount_of,json=subaccountOf,proto3,oneof"`
+}
+
+type AccountMatcher_DirectChildOf struct {
+	DirectChildOf string `protobuf:"bytes,3,opt,name=direct_child_of,json=directChildOf,proto3,oneof"`
+}
+
+type AccountMatcher_Glob struct {
+	Glob string `protobuf:"bytes,4,opt,name=glob,proto3,oneof"`
+}
+
+func (*AccountMatcher_Exact) isAccountMatcher_Matcher() {}
+
+func (*AccountMatcher_SubaccountOf) isAccountMatcher_Matcher() {}
+
+func (*AccountMatcher_DirectChildOf) isAccountMatcher_Matcher() {}
+
+func (*AccountMatcher_Glob) isAccountMatcher_Matcher() {}
+
+// AccountClassPredicate matches action receipts for which the account playing `role` is of the given
+// `class`, ex: {role=RECEIVER,class=IMPLICIT} matches receipts sent to implicit accounts, which includes
+// all implicit account creations, and {role=SIGNER,class=ETH_IMPLICIT} matches receipts signed by an
+// ETH-implicit account.
+//
+// * NAMED is a human readable account like "alice.near"
+// * IMPLICIT is 64 lowercase hex characters (an ed25519 public key)
+// * ETH_IMPLICIT is "0x" followed by 40 lowercase hex characters (an Ethereum address)
+//
+// The `acctclass` index only records IMPLICIT and ETH_IMPLICIT classes, a filter with a NAMED predicate
+// does not use the index and inspects every block.
+type AccountClassPredicate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Role  AccountClassPredicate_Role  `protobuf:"varint,1,opt,name=role,proto3,enum=sf.near.transform.v1.AccountClassPredicate_Role" json:"role,omitempty"`
+	Class AccountClassPredicate_Class `protobuf:"varint,2,opt,name=class,proto3,enum=sf.near.transform.v1.AccountClassPredicate_Class" json:"class,omitempty"`
+}
+
+func (x *AccountClassPredicate) Reset() {
+	*x = AccountClassPredicate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_near_transform_v1_transform_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountClassPredicate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountClassPredicate) ProtoMessage() {}
+
+func (x *AccountClassPredicate) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_near_transform_v1_transform_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountClassPredicate.ProtoReflect.Descriptor instead.
+func (*AccountClassPredicate) Descriptor() ([]byte, []int) {
+	return file_sf_near_transform_v1_transform_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AccountClassPredicate) GetRole() AccountClassPredicate_Role {
+	if x != nil {
+		return x.Role
+	}
+	return AccountClassPredicate_RECEIVER
+}
+
+func (x *AccountClassPredicate) GetClass() AccountClassPredicate_Class {
+	if x != nil {
+		return x.Class
+	}
+	return AccountClassPredicate_NAMED
+}
+
 // HeaderOnly returns only the block's header and few top-level core information for the block. Useful
 // for cases where no transactions information is required at all.
 //
 // The structure that would will have access to after:
 //
 // ```
-// Block {
-//  BlockHeader header = 2;
-// }
+//
+//	Block {
+//	 BlockHeader header = 2;
+//	}
+//
 // ```
 //
 // Everything else will be empty.
@@ -159,7 +481,7 @@ type HeaderOnly struct {
 func (x *HeaderOnly) Reset() {
 	*x = HeaderOnly{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sf_near_transform_v1_transform_proto_msgTypes[2]
+		mi := &file_sf_near_transform_v1_transform_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -172,7 +494,7 @@ func (x *HeaderOnly) String() string {
 func (*HeaderOnly) ProtoMessage() {}
 
 func (x *HeaderOnly) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_near_transform_v1_transform_proto_msgTypes[2]
+	mi := &file_sf_near_transform_v1_transform_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -185,7 +507,207 @@ func (x *HeaderOnly) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HeaderOnly.ProtoReflect.Descriptor instead.
 func (*HeaderOnly) Descriptor() ([]byte, []int) {
-	return file_sf_near_transform_v1_transform_proto_rawDescGZIP(), []int{2}
+	return file_sf_near_transform_v1_transform_proto_rawDescGZIP(), []int{4}
+}
+
+// LightBlock returns the block's header, author and chunk headers along with a trimmed down view
+// of each shard that is enough to list transactions and receipts and check their presence. It is
+// much lighter than the full block while carrying more than [HeaderOnly].
+//
+// The structure that you will have access to after:
+//
+// ```
+//
+//	Block {
+//	 string author = 1;
+//	 BlockHeader header = 2;
+//	 repeated ChunkHeader chunk_headers = 3;
+//	 repeated IndexerShard shards = 4;
+//	}
+//
+// ```
+//
+// Each shard only retains:
+// * `chunk.transactions[].transaction` with `hash`, `signer_id` and `receiver_id`
+// * `chunk.transactions[].outcome.execution_outcome` with `id` and `outcome.status`
+// * `chunk.receipts[]` with `receipt_id` and `receiver_id`
+// * `receipt_execution_outcomes[].receipt` with `receipt_id` and `receiver_id`
+// * `receipt_execution_outcomes[].execution_outcome` with `id` and `outcome.status`
+//
+// Actions payloads, logs, proofs and state changes are all removed.
+type LightBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LightBlock) Reset() {
+	*x = LightBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_near_transform_v1_transform_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LightBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LightBlock) ProtoMessage() {}
+
+func (x *LightBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_near_transform_v1_transform_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LightBlock.ProtoReflect.Descriptor instead.
+func (*LightBlock) Descriptor() ([]byte, []int) {
+	return file_sf_near_transform_v1_transform_proto_rawDescGZIP(), []int{5}
+}
+
+// StripPayloads replaces bulky payloads of the block with their sha256 content hash and their size in
+// bytes, each kind of payload being individually selectable. It does not remove any transaction, receipt or
+// outcome, so the set of receipts seen stays the same as without it.
+//
+// * `contract_code` strips `DeployContractAction.code` and `StateChangeValue.ContractCodeUpdate.code` (populating `code_hash` and `code_size`)
+// * `function_call_args` strips `FunctionCallAction.args` (populating `args_hash` and `args_size`)
+// * `proofs` strips `ExecutionOutcomeWithId.proof` (populating `proof_hash` and `proof_size`)
+//
+// Actions nested in delegate actions are stripped as well. At least one kind must be selected.
+type StripPayloads struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContractCode     bool `protobuf:"varint,1,opt,name=contract_code,json=contractCode,proto3" json:"contract_code,omitempty"`
+	FunctionCallArgs bool `protobuf:"varint,2,opt,name=function_call_args,json=functionCallArgs,proto3" json:"function_call_args,omitempty"`
+	Proofs           bool `protobuf:"varint,3,opt,name=proofs,proto3" json:"proofs,omitempty"`
+}
+
+func (x *StripPayloads) Reset() {
+	*x = StripPayloads{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_near_transform_v1_transform_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StripPayloads) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StripPayloads) ProtoMessage() {}
+
+func (x *StripPayloads) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_near_transform_v1_transform_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StripPayloads.ProtoReflect.Descriptor instead.
+func (*StripPayloads) Descriptor() ([]byte, []int) {
+	return file_sf_near_transform_v1_transform_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StripPayloads) GetContractCode() bool {
+	if x != nil {
+		return x.ContractCode
+	}
+	return false
+}
+
+func (x *StripPayloads) GetFunctionCallArgs() bool {
+	if x != nil {
+		return x.FunctionCallArgs
+	}
+	return false
+}
+
+func (x *StripPayloads) GetProofs() bool {
+	if x != nil {
+		return x.Proofs
+	}
+	return false
+}
+
+// ShardFilter keeps only the requested shards of the block, other shards are removed from `shards`
+// and `chunk_headers`. The header's `chunk_mask` is reduced to the entries of the kept chunk headers
+// (in the same order) and `chunks_included` is recomputed accordingly, which means the header's hash
+// cannot be recomputed from a filtered block.
+//
+// When `filter_state_changes` is true, only the state changes caused by a transaction or a receipt of
+// the kept shards are retained, state changes without such a cause (initial state, validator accounts
+// update, delayed receipts update, migration, etc.) are removed.
+//
+// It can be composed with [BasicReceiptFilter], in any order.
+type ShardFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShardIds           []uint64 `protobuf:"varint,1,rep,packed,name=shard_ids,json=shardIds,proto3" json:"shard_ids,omitempty"`
+	FilterStateChanges bool     `protobuf:"varint,2,opt,name=filter_state_changes,json=filterStateChanges,proto3" json:"filter_state_changes,omitempty"`
+}
+
+func (x *ShardFilter) Reset() {
+	*x = ShardFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_near_transform_v1_transform_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShardFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShardFilter) ProtoMessage() {}
+
+func (x *ShardFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_near_transform_v1_transform_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShardFilter.ProtoReflect.Descriptor instead.
+func (*ShardFilter) Descriptor() ([]byte, []int) {
+	return file_sf_near_transform_v1_transform_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ShardFilter) GetShardIds() []uint64 {
+	if x != nil {
+		return x.ShardIds
+	}
+	return nil
+}
+
+func (x *ShardFilter) GetFilterStateChanges() bool {
+	if x != nil {
+		return x.FilterStateChanges
+	}
+	return false
 }
 
 var File_sf_near_transform_v1_transform_proto protoreflect.FileDescriptor
@@ -194,7 +716,7 @@ var file_sf_near_transform_v1_transform_proto_rawDesc = []byte{
 	0x0a, 0x24, 0x73, 0x66, 0x2f, 0x6e, 0x65, 0x61, 0x72, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
 	0x6f, 0x72, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x22, 0x8f, 0x01, 0x0a,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x22, 0xaa, 0x03, 0x0a,
 	0x12, 0x42, 0x61, 0x73, 0x69, 0x63, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x46, 0x69, 0x6c,
 	0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18,
 	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12,
@@ -203,18 +725,77 @@ var file_sf_near_transform_v1_transform_proto_rawDesc = []byte{
 	0x32, 0x26, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73,
 	0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x53, 0x75,
 	0x66, 0x66, 0x69, 0x78, 0x50, 0x61, 0x69, 0x72, 0x52, 0x14, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
-	0x41, 0x6e, 0x64, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x50, 0x61, 0x69, 0x72, 0x73, 0x22, 0x42,
-	0x0a, 0x10, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x50, 0x61,
-	0x69, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75,
-	0x66, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x75, 0x66, 0x66,
-	0x69, 0x78, 0x22, 0x0c, 0x0a, 0x0a, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4f, 0x6e, 0x6c, 0x79,
-	0x42, 0x52, 0x5a, 0x50, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73,
+	0x41, 0x6e, 0x64, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x4f,
+	0x0a, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65,
+	0x61, 0x72, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x52, 0x0f,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x73, 0x12,
+	0x65, 0x0a, 0x18, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73,
+	0x5f, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x43, 0x6c, 0x61, 0x73, 0x73, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x16,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x50, 0x72, 0x65, 0x64,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x75, 0x6e, 0x77, 0x72, 0x61, 0x70,
+	0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x75, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x44,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29,
+	0x0a, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x22, 0x42, 0x0a, 0x10, 0x50, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x50, 0x61, 0x69, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x22, 0x9a, 0x01,
+	0x0a, 0x0e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72,
+	0x12, 0x16, 0x0a, 0x05, 0x65, 0x78, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x05, 0x65, 0x78, 0x61, 0x63, 0x74, 0x12, 0x25, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x12,
+	0x28, 0x0a, 0x0f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f,
+	0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x4f, 0x66, 0x12, 0x14, 0x0a, 0x04, 0x67, 0x6c, 0x6f,
+	0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x67, 0x6c, 0x6f, 0x62, 0x42,
+	0x09, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x22, 0x8d, 0x02, 0x0a, 0x15, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x50, 0x72, 0x65, 0x64, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x30, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x2e,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x47, 0x0a, 0x05, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x73, 0x66, 0x2e, 0x6e,
+	0x65, 0x61, 0x72, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x50, 0x72, 0x65,
+	0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x05, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x22, 0x31, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x52,
+	0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x49, 0x47,
+	0x4e, 0x45, 0x52, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x52, 0x45, 0x44, 0x45, 0x43, 0x45,
+	0x53, 0x53, 0x4f, 0x52, 0x10, 0x02, 0x22, 0x32, 0x0a, 0x05, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12,
+	0x09, 0x0a, 0x05, 0x4e, 0x41, 0x4d, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4d,
+	0x50, 0x4c, 0x49, 0x43, 0x49, 0x54, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x45, 0x54, 0x48, 0x5f,
+	0x49, 0x4d, 0x50, 0x4c, 0x49, 0x43, 0x49, 0x54, 0x10, 0x02, 0x22, 0x0c, 0x0a, 0x0a, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x0c, 0x0a, 0x0a, 0x4c, 0x69, 0x67, 0x68,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x7a, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x69, 0x70, 0x50,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2c, 0x0a, 0x12,
+	0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x61, 0x72,
+	0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x41, 0x72, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6f,
+	0x66, 0x73, 0x22, 0x5c, 0x0a, 0x0b, 0x53, 0x68, 0x61, 0x72, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x04, 0x52, 0x08, 0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x73, 0x12, 0x30,
+	0x0a, 0x14, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x42, 0x4c, 0x5a, 0x4a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73,
 	0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x66, 0x61, 0x73, 0x74, 0x2f, 0x66, 0x69, 0x72,
-	0x65, 0x68, 0x6f, 0x73, 0x65, 0x2d, 0x6e, 0x65, 0x61, 0x72, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2f, 0x70, 0x62, 0x2f, 0x73, 0x66, 0x2f, 0x6e, 0x65, 0x61, 0x72, 0x2f, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x62, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x66, 0x6f, 0x72, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x68, 0x6f, 0x73, 0x65, 0x2d, 0x6e, 0x65, 0x61, 0x72, 0x2f, 0x70, 0x62, 0x2f, 0x73, 0x66,
+	0x2f, 0x6e, 0x65, 0x61, 0x72, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x2f,
+	0x76, 0x31, 0x3b, 0x70, 0x62, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -229,19 +810,31 @@ func file_sf_near_transform_v1_transform_proto_rawDescGZIP() []byte {
 	return file_sf_near_transform_v1_transform_proto_rawDescData
 }
 
-var file_sf_near_transform_v1_transform_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_sf_near_transform_v1_transform_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_sf_near_transform_v1_transform_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_sf_near_transform_v1_transform_proto_goTypes = []interface{}{
-	(*BasicReceiptFilter)(nil), // 0: sf.near.transform.v1.BasicReceiptFilter
-	(*PrefixSuffixPair)(nil),   // 1: sf.near.transform.v1.PrefixSuffixPair
-	(*HeaderOnly)(nil),         // 2: sf.near.transform.v1.HeaderOnly
+	(AccountClassPredicate_Role)(0),  // 0: sf.near.transform.v1.AccountClassPredicate.Role
+	(AccountClassPredicate_Class)(0), // 1: sf.near.transform.v1.AccountClassPredicate.Class
+	(*BasicReceiptFilter)(nil),       // 2: sf.near.transform.v1.BasicReceiptFilter
+	(*PrefixSuffixPair)(nil),         // 3: sf.near.transform.v1.PrefixSuffixPair
+	(*AccountMatcher)(nil),           // 4: sf.near.transform.v1.AccountMatcher
+	(*AccountClassPredicate)(nil),    // 5: sf.near.transform.v1.AccountClassPredicate
+	(*HeaderOnly)(nil),               // 6: sf.near.transform.v1.HeaderOnly
+	(*LightBlock)(nil),               // 7: sf.near.transform.v1.LightBlock
+	(*StripPayloads)(nil),            // 8: sf.near.transform.v1.StripPayloads
+	(*ShardFilter)(nil),              // 9: sf.near.transform.v1.ShardFilter
 }
 var file_sf_near_transform_v1_transform_proto_depIdxs = []int32{
-	1, // 0: sf.near.transform.v1.BasicReceiptFilter.prefix_and_suffix_pairs:type_name -> sf.near.transform.v1.PrefixSuffixPair
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	3, // 0: sf.near.transform.v1.BasicReceiptFilter.prefix_and_suffix_pairs:type_name -> sf.near.transform.v1.PrefixSuffixPair
+	4, // 1: sf.near.transform.v1.BasicReceiptFilter.account_matchers:type_name -> sf.near.transform.v1.AccountMatcher
+	5, // 2: sf.near.transform.v1.BasicReceiptFilter.account_class_predicates:type_name -> sf.near.transform.v1.AccountClassPredicate
+	0, // 3: sf.near.transform.v1.AccountClassPredicate.role:type_name -> sf.near.transform.v1.AccountClassPredicate.Role
+	1, // 4: sf.near.transform.v1.AccountClassPredicate.class:type_name -> sf.near.transform.v1.AccountClassPredicate.Class
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_sf_near_transform_v1_transform_proto_init() }
@@ -275,6 +868,30 @@ func file_sf_near_transform_v1_transform_proto_init() {
 			}
 		}
 		file_sf_near_transform_v1_transform_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountMatcher); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_near_transform_v1_transform_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountClassPredicate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_near_transform_v1_transform_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*HeaderOnly); i {
 			case 0:
 				return &v.state
@@ -286,19 +903,62 @@ func file_sf_near_transform_v1_transform_proto_init() {
 				return nil
 			}
 		}
+		file_sf_near_transform_v1_transform_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LightBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_near_transform_v1_transform_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StripPayloads); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_near_transform_v1_transform_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShardFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_sf_near_transform_v1_transform_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*AccountMatcher_Exact)(nil),
+		(*AccountMatcher_SubaccountOf)(nil),
+		(*AccountMatcher_DirectChildOf)(nil),
+		(*AccountMatcher_Glob)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_sf_near_transform_v1_transform_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   3,
+			NumEnums:      2,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_sf_near_transform_v1_transform_proto_goTypes,
 		DependencyIndexes: file_sf_near_transform_v1_transform_proto_depIdxs,
+		EnumInfos:         file_sf_near_transform_v1_transform_proto_enumTypes,
 		MessageInfos:      file_sf_near_transform_v1_transform_proto_msgTypes,
 	}.Build()
 	File_sf_near_transform_v1_transform_proto = out.File
@@ -0,0 +1,119 @@
+package pbnear
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// ContractABI is the subset of a near-sdk ABI (the `near_abi` JSON schema, as produced by
+// `cargo near abi`) needed to decode function call arguments.
+type ContractABI struct {
+	SchemaVersion string `json:"schema_version"`
+	Metadata      struct {
+		Name    string `json:"name"`
+		Version string `json:"version"`
+	} `json:"metadata"`
+	Body struct {
+		Functions []*ABIFunction `json:"functions"`
+	} `json:"body"`
+}
+
+type ABIFunction struct {
+	Name   string     `json:"name"`
+	Kind   string     `json:"kind"`
+	Params *ABIParams `json:"params"`
+}
+
+type ABIParams struct {
+	// SerializationType is either `json` or `borsh`
+	SerializationType string      `json:"serialization_type"`
+	Args              []*ABIParam `json:"args"`
+}
+
+type ABIParam struct {
+	Name string `json:"name"`
+	// TypeSchema is a JSON schema when the params are serialized as JSON, a Borsh schema
+	// container otherwise.
+	TypeSchema json.RawMessage `json:"type_schema"`
+}
+
+func ParseContractABI(content []byte) (*ContractABI, error) {
+	abi := &ContractABI{}
+	if err := json.Unmarshal(content, abi); err != nil {
+		return nil, fmt.Errorf("unmarshal ABI: %w", err)
+	}
+
+	if abi.SchemaVersion == "" {
+		return nil, fmt.Errorf("not a near_abi document, schema_version is missing")
+	}
+
+	for _, function := range abi.Body.Functions {
+		if function.Params == nil {
+			continue
+		}
+
+		switch function.Params.SerializationType {
+		case "json":
+		case "borsh":
+			for _, param := range function.Params.Args {
+				if _, err := parseBorshSchema(param.TypeSchema); err != nil {
+					return nil, fmt.Errorf("function %q param %q: %w", function.Name, param.Name, err)
+				}
+			}
+		default:
+			return nil, fmt.Errorf("function %q: unknown serialization type %q", function.Name, function.Params.SerializationType)
+		}
+	}
+
+	return abi, nil
+}
+
+// Function returns the ABI of the contract's function, nil if the ABI does not define it
+func (a *ContractABI) Function(name string) *ABIFunction {
+	if a == nil {
+		return nil
+	}
+
+	for _, function := range a.Body.Functions {
+		if function.Name == name {
+			return function
+		}
+	}
+	return nil
+}
+
+// ABIRegistry holds the ABI of contracts by account ID, it is safe for concurrent use
+type ABIRegistry struct {
+	lock      sync.RWMutex
+	contracts map[string]*ContractABI
+}
+
+func NewABIRegistry() *ABIRegistry {
+	return &ABIRegistry{contracts: make(map[string]*ContractABI)}
+}
+
+func (r *ABIRegistry) Register(accountID string, abi *ContractABI) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.contracts[accountID] = abi
+}
+
+// Get returns the ABI registered for the account, nil if none is
+func (r *ABIRegistry) Get(accountID string) *ContractABI {
+	if r == nil {
+		return nil
+	}
+
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	return r.contracts[accountID]
+}
+
+// DecodeFunctionCallArgs decodes the args of a function call made on the receiver contract
+// using the receiver's registered ABI, if any.
+func (r *ABIRegistry) DecodeFunctionCallArgs(receiverID string, call *FunctionCallAction) (*DecodedArgs, error) {
+	return call.DecodeArgs(r.Get(receiverID))
+}
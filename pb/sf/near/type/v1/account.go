@@ -0,0 +1,97 @@
+package pbnear
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	MinAccountIDLen = 2
+	MaxAccountIDLen = 64
+)
+
+// accountIDRegexp is the NEAR account ID grammar, see https://nomicon.io/DataStructures/Account#account-id-rules
+var accountIDRegexp = regexp.MustCompile(`^(([a-z\d]+[\-_])*[a-z\d]+\.)*([a-z\d]+[\-_])*[a-z\d]+$`)
+
+// accountIDSegmentRegexp is the grammar of a single dot-separated segment of a NEAR account ID
+var accountIDSegmentRegexp = regexp.MustCompile(`^([a-z\d]+[\-_])*[a-z\d]+$`)
+
+// ValidateAccountID returns an error if the received account ID does not respect NEAR account ID
+// rules: between 2 and 64 characters made of lowercase alphanumeric characters separated by a
+// single `-`, `_` or `.` (where `.` separates the account from its parent account).
+func ValidateAccountID(accountID string) error {
+	if len(accountID) < MinAccountIDLen || len(accountID) > MaxAccountIDLen {
+		return fmt.Errorf("invalid account ID %q: length must be between %d and %d characters", accountID, MinAccountIDLen, MaxAccountIDLen)
+	}
+
+	if !accountIDRegexp.MatchString(accountID) {
+		return fmt.Errorf("invalid account ID %q: must be made of lowercase alphanumeric characters separated by a single '-', '_' or '.'", accountID)
+	}
+
+	return nil
+}
+
+// IsValidAccountIDSegment returns true if the received value is valid as a single dot-separated
+// segment of a NEAR account ID, like `foo` in `foo.near`.
+func IsValidAccountIDSegment(segment string) bool {
+	return accountIDSegmentRegexp.MatchString(segment)
+}
+
+// AccountClass is the class of a NEAR account ID, see https://docs.near.org/concepts/protocol/account-id
+type AccountClass int
+
+const (
+	// NamedAccount is a human readable account like `alice.near`
+	NamedAccount AccountClass = iota
+
+	// ImplicitAccount is an account whose ID is the 64 lowercase hex characters of an ed25519 public key
+	ImplicitAccount
+
+	// EthImplicitAccount is an account whose ID is `0x` followed by the 40 lowercase hex characters of an Ethereum address
+	EthImplicitAccount
+)
+
+func (c AccountClass) String() string {
+	switch c {
+	case NamedAccount:
+		return "named"
+	case ImplicitAccount:
+		return "implicit"
+	case EthImplicitAccount:
+		return "eth-implicit"
+	}
+
+	return fmt.Sprintf("unknown(%d)", int(c))
+}
+
+// ClassifyAccountID returns the class of the received account ID, which is assumed to be valid
+func ClassifyAccountID(accountID string) AccountClass {
+	switch {
+	case IsImplicitAccountID(accountID):
+		return ImplicitAccount
+	case IsEthImplicitAccountID(accountID):
+		return EthImplicitAccount
+	}
+
+	return NamedAccount
+}
+
+// IsImplicitAccountID returns true if the account ID is made of exactly 64 lowercase hex characters
+func IsImplicitAccountID(accountID string) bool {
+	return len(accountID) == 64 && isLowerHex(accountID)
+}
+
+// IsEthImplicitAccountID returns true if the account ID is `0x` followed by exactly 40 lowercase hex characters
+func IsEthImplicitAccountID(accountID string) bool {
+	return len(accountID) == 42 && strings.HasPrefix(accountID, "0x") && isLowerHex(accountID[2:])
+}
+
+func isLowerHex(in string) bool {
+	for _, c := range in {
+		if !(c >= '0' && c <= '9') && !(c >= 'a' && c <= 'f') {
+			return false
+		}
+	}
+	return true
+}
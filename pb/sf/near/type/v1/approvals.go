@@ -0,0 +1,66 @@
+package pbnear
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+)
+
+// ApprovalMessage returns the data the approvals of the header sign: the Borsh serialization of
+// the approval, an endorsement of the previous block hash when the block directly follows it or
+// a skip of the previous height otherwise, followed by the block height (little-endian).
+func (x *BlockHeader) ApprovalMessage() []byte {
+	return x.ApprovalMessageAfter(x.PrevHeight)
+}
+
+// ApprovalMessageAfter is ApprovalMessage with the height of the previous block given, for
+// headers that do not carry it (old format blocks and blocks fetched during RPC outages have a
+// zero PrevHeight)
+func (x *BlockHeader) ApprovalMessageAfter(prevHeight uint64) []byte {
+	w := &borshWriter{}
+	if x.IsEndorsementAfter(prevHeight) {
+		// ApprovalInner::Endorsement
+		w.u8(0)
+		w.hash(x.PrevHash)
+	} else {
+		// ApprovalInner::Skip
+		w.u8(1)
+		w.u64(prevHeight)
+	}
+
+	return binary.LittleEndian.AppendUint64(w.Bytes(), x.Height)
+}
+
+// IsEndorsement returns whether the approvals of the header endorse its previous block, which is
+// the case when the block directly follows it
+func (x *BlockHeader) IsEndorsement() bool {
+	return x.IsEndorsementAfter(x.PrevHeight)
+}
+
+// IsEndorsementAfter is IsEndorsement with the height of the previous block given, see
+// ApprovalMessageAfter
+func (x *BlockHeader) IsEndorsementAfter(prevHeight uint64) bool {
+	return prevHeight+1 == x.Height
+}
+
+// BlockProducersHash returns the hash of the ordered block producers of an epoch, the
+// `next_bp_hash` of the blocks of the previous epoch. versioned is true for epochs following
+// protocol version 49 epochs and later, whose validator stakes are serialized as versioned
+// (`ValidatorStake::V1`) values.
+func BlockProducersHash(producers []*ValidatorStake, versioned bool) (*CryptoHash, error) {
+	w := &borshWriter{}
+	w.u32(uint32(len(producers)))
+	for _, producer := range producers {
+		if versioned {
+			w.u8(0)
+		}
+		w.string(producer.AccountId)
+		if err := w.publicKey(producer.PublicKey); err != nil {
+			return nil, fmt.Errorf("block producer %s: %w", producer.AccountId, err)
+		}
+		w.u128(producer.Stake)
+	}
+
+	hash := sha256.Sum256(w.Bytes())
+	return &CryptoHash{Bytes: hash[:]}, nil
+}
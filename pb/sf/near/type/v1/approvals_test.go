@@ -0,0 +1,25 @@
+package pbnear
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockHeader_ApprovalMessage(t *testing.T) {
+	prevHash := &CryptoHash{Bytes: bytes.Repeat([]byte{7}, 32)}
+
+	endorsement := &BlockHeader{Height: 11, PrevHeight: 10, PrevHash: prevHash}
+	assert.True(t, endorsement.IsEndorsement())
+	assert.Equal(t, append(append([]byte{0}, prevHash.Bytes...), 11, 0, 0, 0, 0, 0, 0, 0), endorsement.ApprovalMessage())
+
+	skip := &BlockHeader{Height: 12, PrevHeight: 10, PrevHash: prevHash}
+	assert.False(t, skip.IsEndorsement())
+	assert.Equal(t, []byte{1, 10, 0, 0, 0, 0, 0, 0, 0, 12, 0, 0, 0, 0, 0, 0, 0}, skip.ApprovalMessage())
+
+	// Old format blocks do not carry their previous height
+	withoutPrevHeight := &BlockHeader{Height: 11, PrevHash: prevHash}
+	assert.True(t, withoutPrevHeight.IsEndorsementAfter(10))
+	assert.Equal(t, endorsement.ApprovalMessage(), withoutPrevHeight.ApprovalMessageAfter(10))
+}
@@ -0,0 +1,79 @@
+package pbnear
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type ArgsEncoding string
+
+const (
+	ArgsEncodingEmpty   ArgsEncoding = "empty"
+	ArgsEncodingJSON    ArgsEncoding = "json"
+	ArgsEncodingBorsh   ArgsEncoding = "borsh"
+	ArgsEncodingUnknown ArgsEncoding = "unknown"
+)
+
+// DecodedArgs are function call args along with their detected encoding, JSON holds the args
+// as JSON for the `json` and `borsh` encodings and is nil otherwise.
+type DecodedArgs struct {
+	Encoding ArgsEncoding
+	JSON     json.RawMessage
+}
+
+// DecodeArgs decodes the function call args. When the ABI (possibly nil) of the called contract
+// defines the function, its params serialization type is used, Borsh args being decoded with
+// the ABI's Borsh schemas. Otherwise, args are detected as JSON when they are valid JSON and
+// are left undecoded, with the `unknown` encoding, when they are not.
+func (x *FunctionCallAction) DecodeArgs(abi *ContractABI) (*DecodedArgs, error) {
+	args := x.GetArgs()
+
+	function := abi.Function(x.GetMethodName())
+	if function == nil || function.Params == nil {
+		if len(args) == 0 {
+			return &DecodedArgs{Encoding: ArgsEncodingEmpty}, nil
+		}
+
+		if json.Valid(args) {
+			return &DecodedArgs{Encoding: ArgsEncodingJSON, JSON: json.RawMessage(args)}, nil
+		}
+
+		return &DecodedArgs{Encoding: ArgsEncodingUnknown}, nil
+	}
+
+	if function.Params.SerializationType == "json" {
+		if !json.Valid(args) {
+			return nil, fmt.Errorf("method %q expects JSON args per ABI but args are not valid JSON", x.MethodName)
+		}
+
+		return &DecodedArgs{Encoding: ArgsEncodingJSON, JSON: json.RawMessage(args)}, nil
+	}
+
+	decoder := &borshDecoder{data: args}
+	out := make(orderedObject, 0, len(function.Params.Args))
+	for _, param := range function.Params.Args {
+		schema, err := parseBorshSchema(param.TypeSchema)
+		if err != nil {
+			return nil, fmt.Errorf("param %q: %w", param.Name, err)
+		}
+
+		decoder.definitions = schema.Definitions
+		value, err := decoder.decode(schema.Declaration, 0)
+		if err != nil {
+			return nil, fmt.Errorf("decode param %q of method %q: %w", param.Name, x.MethodName, err)
+		}
+
+		out = append(out, orderedField{Name: param.Name, Value: value})
+	}
+
+	if remaining := len(args) - decoder.pos; remaining != 0 {
+		return nil, fmt.Errorf("decode method %q args: %d trailing bytes", x.MethodName, remaining)
+	}
+
+	content, err := json.Marshal(out)
+	if err != nil {
+		return nil, fmt.Errorf("marshal decoded args: %w", err)
+	}
+
+	return &DecodedArgs{Encoding: ArgsEncodingBorsh, JSON: content}, nil
+}
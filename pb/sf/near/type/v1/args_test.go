@@ -0,0 +1,114 @@
+package pbnear
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testABI = `{
+  "schema_version": "0.4.0",
+  "metadata": {"name": "token", "version": "1.0.0"},
+  "body": {
+    "functions": [
+      {
+        "name": "ft_transfer",
+        "kind": "call",
+        "params": {
+          "serialization_type": "json",
+          "args": [{"name": "receiver_id", "type_schema": {"$ref": "#/definitions/AccountId"}}]
+        }
+      },
+      {
+        "name": "borsh_transfer",
+        "kind": "call",
+        "params": {
+          "serialization_type": "borsh",
+          "args": [
+            {"name": "receiver_id", "type_schema": {"declaration": "AccountId", "definitions": {"AccountId": {"Struct": {"fields": {"UnnamedFields": ["string"]}}}}}},
+            {"name": "amount", "type_schema": {"declaration": "u128", "definitions": {}}},
+            {"name": "memo", "type_schema": {"declaration": "Option<string>", "definitions": {"Option<string>": {"Enum": {"variants": [["None", "()"], ["Some", "string"]]}}}}},
+            {"name": "flags", "type_schema": {"declaration": "Vec<bool>", "definitions": {}}},
+            {"name": "offset", "type_schema": {"declaration": "i32", "definitions": {}}},
+            {"name": "kind", "type_schema": {"declaration": "Kind", "definitions": {
+              "Kind": {"Enum": {"tag_width": 1, "variants": [[0, "Plain", "()"], [5, "Tagged", "Tag"]]}},
+              "Tag": {"Struct": {"fields": {"NamedFields": [["name", "String"], ["id", "u64"]]}}},
+              "String": {"Sequence": {"length_width": 4, "length_range": {"start": 0, "end": 4294967295}, "elements": "u8"}}
+            }}}
+          ]
+        }
+      }
+    ]
+  }
+}`
+
+func TestFunctionCallAction_DecodeArgs(t *testing.T) {
+	abi, err := ParseContractABI([]byte(testABI))
+	require.NoError(t, err)
+
+	registry := NewABIRegistry()
+	registry.Register("token.near", abi)
+
+	borshString := func(in string) []byte {
+		return append(binary.LittleEndian.AppendUint32(nil, uint32(len(in))), in...)
+	}
+
+	var borshArgs []byte
+	borshArgs = append(borshArgs, borshString("bob.near")...)
+	borshArgs = append(borshArgs, 0x40, 0x42, 0x0f, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0) // 1_000_000
+	borshArgs = append(borshArgs, 1)
+	borshArgs = append(borshArgs, borshString("hi")...)
+	borshArgs = append(borshArgs, 2, 0, 0, 0, 1, 0)
+	borshArgs = append(borshArgs, 0xfe, 0xff, 0xff, 0xff) // -2
+	borshArgs = append(borshArgs, 5)
+	borshArgs = append(borshArgs, borshString("x")...)
+	borshArgs = append(borshArgs, 7, 0, 0, 0, 0, 0, 0, 0)
+
+	tests := []struct {
+		name             string
+		receiverID       string
+		call             *FunctionCallAction
+		expectedEncoding ArgsEncoding
+		expectedJSON     string
+		expectErr        bool
+	}{
+		{"empty", "other.near", &FunctionCallAction{MethodName: "new"}, ArgsEncodingEmpty, "", false},
+		{"detected json", "other.near", &FunctionCallAction{MethodName: "ft_transfer", Args: []byte(`{"amount":"1"}`)}, ArgsEncodingJSON, `{"amount":"1"}`, false},
+		{"unknown", "other.near", &FunctionCallAction{MethodName: "ft_transfer", Args: []byte{0x01, 0x02}}, ArgsEncodingUnknown, "", false},
+		{"abi json", "token.near", &FunctionCallAction{MethodName: "ft_transfer", Args: []byte(`{"receiver_id":"bob.near"}`)}, ArgsEncodingJSON, `{"receiver_id":"bob.near"}`, false},
+		{"abi json invalid", "token.near", &FunctionCallAction{MethodName: "ft_transfer", Args: []byte{0x01}}, "", "", true},
+		{"abi borsh", "token.near", &FunctionCallAction{MethodName: "borsh_transfer", Args: borshArgs}, ArgsEncodingBorsh,
+			`{"receiver_id":"bob.near","amount":"1000000","memo":"hi","flags":[true,false],"offset":-2,"kind":{"Tagged":{"name":"x","id":"7"}}}`, false},
+		{"abi borsh truncated", "token.near", &FunctionCallAction{MethodName: "borsh_transfer", Args: borshArgs[:20]}, "", "", true},
+		{"abi borsh trailing bytes", "token.near", &FunctionCallAction{MethodName: "borsh_transfer", Args: append(append([]byte{}, borshArgs...), 0)}, "", "", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			decoded, err := registry.DecodeFunctionCallArgs(test.receiverID, test.call)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedEncoding, decoded.Encoding)
+			if test.expectedJSON == "" {
+				assert.Nil(t, decoded.JSON)
+			} else {
+				assert.JSONEq(t, test.expectedJSON, string(decoded.JSON))
+				assert.Equal(t, test.expectedJSON, string(decoded.JSON), "fields order must be preserved")
+			}
+		})
+	}
+}
+
+func TestParseContractABI_Invalid(t *testing.T) {
+	_, err := ParseContractABI([]byte(`{"body": {}}`))
+	assert.Error(t, err)
+
+	_, err = ParseContractABI([]byte(`{"schema_version": "0.4.0", "body": {"functions": [{"name": "f", "params": {"serialization_type": "xml"}}]}}`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,535 @@
+package pbnear
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// BlockHeaderVersion is the version of a NEAR block header, which defines the layout of its inner
+// rest part. The version depends on the protocol version of the block's epoch.
+type BlockHeaderVersion int
+
+const (
+	BlockHeaderV1 BlockHeaderVersion = iota + 1
+	// BlockHeaderV2 drops the number of included chunks
+	BlockHeaderV2
+	// BlockHeaderV3 adds the block ordinal, previous height and epoch sync data hash, and
+	// serializes the validator proposals as versioned validator stakes
+	BlockHeaderV3
+	// BlockHeaderV4 adds the block body hash, a field the BlockHeader does not carry
+	BlockHeaderV4
+)
+
+// blockHeaderVersionsProtocolVersion is the first protocol version of each block header version
+var blockHeaderVersionsProtocolVersion = map[BlockHeaderVersion]uint32{
+	BlockHeaderV1: 0,
+	BlockHeaderV2: 30,
+	BlockHeaderV3: 49,
+	BlockHeaderV4: 63,
+}
+
+// maxApprovalsPlacements bounds the placements of the missing approvals tried by VerifyHash
+const maxApprovalsPlacements = 1 << 16
+
+// ErrBlockHashUnverifiable is returned when the hash of a header cannot be verified because the
+// BlockHeader does not carry all the fields of its version, or because too many approvals are
+// missing to find where they were
+var ErrBlockHashUnverifiable = errors.New("block hash is unverifiable")
+
+// ErrBlockBodyHashRequired wraps ErrBlockHashUnverifiable for headers that do not verify with the
+// versions the BlockHeader carries all the fields of while they can be a BlockHeaderV4, which
+// hashes the block body hash. Every block produced with protocol version 63 and later is such a
+// block.
+var ErrBlockBodyHashRequired = fmt.Errorf("%w: the block body hash is required", ErrBlockHashUnverifiable)
+
+// BlockHashMismatchError is returned by VerifyHash when the computed hash differs from the
+// header's hash
+type BlockHashMismatchError struct {
+	Height   uint64
+	Expected *CryptoHash
+	Computed *CryptoHash
+}
+
+func (e *BlockHashMismatchError) Error() string {
+	return fmt.Sprintf("block #%d hash mismatch, header has %s but its fields hash to %s", e.Height, e.Expected.AsBase58String(), e.Computed.AsBase58String())
+}
+
+// ComputeHash returns the NEAR block hash of the header laid out as version:
+//
+//	sha256(sha256(sha256(borsh(inner_lite)) ++ sha256(borsh(inner_rest))) ++ prev_hash)
+//
+// the approvals being the ones carried by the header, see VerifyHash for headers with missing
+// approvals. ErrBlockHashUnverifiable is returned for versions with fields the BlockHeader does
+// not carry (BlockHeaderV4, or BlockHeaderV3 without block ordinal).
+func (x *BlockHeader) ComputeHash(version BlockHeaderVersion) (*CryptoHash, error) {
+	return x.computeHash(version, x.Approvals)
+}
+
+func (x *BlockHeader) computeHash(version BlockHeaderVersion, approvals []*Signature) (*CryptoHash, error) {
+	innerRest, err := x.innerRestBorsh(version, approvals)
+	if err != nil {
+		return nil, err
+	}
+
+	innerRestHash := sha256.Sum256(innerRest)
+	return x.ComputeLightClientHash(&CryptoHash{Bytes: innerRestHash[:]}), nil
+}
+
+// ComputeLightClientHash returns the NEAR block hash of the header from its inner lite part, its
+// previous block hash and the given hash of its inner rest part, like light clients compute it:
+//
+//	sha256(sha256(sha256(borsh(inner_lite)) ++ inner_rest_hash) ++ prev_hash)
+//
+// It does not depend on the version of the header: when it matches the header's hash, the inner
+// lite fields and the previous block hash are the ones that were hashed, whatever the inner rest
+// fields the BlockHeader carries (see ErrBlockBodyHashRequired).
+func (x *BlockHeader) ComputeLightClientHash(innerRestHash *CryptoHash) *CryptoHash {
+	innerLiteHash := sha256.Sum256(x.InnerLiteBorsh())
+	innerHash := sha256.Sum256(append(innerLiteHash[:], borshHash(innerRestHash)...))
+
+	hash := sha256.Sum256(append(innerHash[:], borshHash(x.PrevHash)...))
+	return &CryptoHash{Bytes: hash[:]}
+}
+
+// PossibleVersions returns the versions the header can have, newest first. The version depends
+// on the protocol version of the block's epoch, which the header does not carry, but which is at
+// most the latest protocol version supported by the block producer.
+func (x *BlockHeader) PossibleVersions() []BlockHeaderVersion {
+	var versions []BlockHeaderVersion
+	for version := BlockHeaderV4; version >= BlockHeaderV1; version-- {
+		if blockHeaderVersionsProtocolVersion[version] <= x.LatestProtocolVersion {
+			versions = append(versions, version)
+		}
+	}
+	return versions
+}
+
+// VerifyHash checks that the header's hash is the hash of its fields laid out as one of its
+// possible versions, returning a *BlockHashMismatchError when it is not.
+//
+// NEAR hashes one approval entry per block approver of the epoch, the ones not received being
+// empty, while the header only carries the received approvals. approvers is the number of block
+// approvers, the missing approvals being tried at every possible position; 0 means that no
+// approval is missing.
+//
+// ErrBlockHashUnverifiable is returned when there are too many possible positions, or when the
+// hash matches none of the versions the BlockHeader carries all the fields of while the header
+// can have a version it does not carry all the fields of (see ComputeHash).
+func (x *BlockHeader) VerifyHash(approvers int) error {
+	if approvers == 0 {
+		approvers = len(x.Approvals)
+	}
+
+	if approvers < len(x.Approvals) {
+		return fmt.Errorf("block #%d has %d approvals, more than its %d approvers", x.Height, len(x.Approvals), approvers)
+	}
+
+	var versions []BlockHeaderVersion
+	var unverifiable error
+	for _, version := range x.PossibleVersions() {
+		if err := x.checkVersionFields(version); err != nil {
+			if unverifiable == nil {
+				unverifiable = err
+			}
+			continue
+		}
+		versions = append(versions, version)
+	}
+
+	if len(versions) == 0 {
+		return unverifiable
+	}
+
+	if placements := binomial(approvers, approvers-len(x.Approvals)); placements > maxApprovalsPlacements/len(versions) {
+		return fmt.Errorf("%w: %d approvals missing out of %d", ErrBlockHashUnverifiable, approvers-len(x.Approvals), approvers)
+	}
+
+	var computed *CryptoHash
+	err := forEachApprovalsPlacement(x.Approvals, approvers, func(approvals []*Signature) (bool, error) {
+		for _, version := range versions {
+			hash, err := x.computeHash(version, approvals)
+			if err != nil {
+				return false, err
+			}
+
+			if computed == nil {
+				computed = hash
+			}
+			if bytes.Equal(hash.Bytes, x.Hash.GetBytes()) {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	if errors.Is(err, errApprovalsPlaced) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if unverifiable != nil {
+		return unverifiable
+	}
+
+	return &BlockHashMismatchError{Height: x.Height, Expected: x.Hash, Computed: computed}
+}
+
+// checkVersionFields returns ErrBlockHashUnverifiable when the BlockHeader does not carry all the
+// fields hashed by version
+func (x *BlockHeader) checkVersionFields(version BlockHeaderVersion) error {
+	switch {
+	case version >= BlockHeaderV4:
+		return fmt.Errorf("%w, block #%d can be a version %d header, which hashes it", ErrBlockBodyHashRequired, x.Height, version)
+	case version >= BlockHeaderV3 && x.BlockOrdinal == 0:
+		return fmt.Errorf("%w: block #%d can be a version %d header, which hashes the block ordinal, but it is missing", ErrBlockHashUnverifiable, x.Height, version)
+	}
+	return nil
+}
+
+var errApprovalsPlaced = errors.New("approvals placed")
+
+// forEachApprovalsPlacement calls fn with the approvals spread over approvers entries, in order,
+// for every placement of the missing (nil) approvals, until fn returns true
+// (errApprovalsPlaced is then returned) or an error.
+func forEachApprovalsPlacement(approvals []*Signature, approvers int, fn func(approvals []*Signature) (bool, error)) error {
+	placed := make([]*Signature, approvers)
+
+	var place func(entry, next int) error
+	place = func(entry, next int) error {
+		missing := (approvers - entry) - (len(approvals) - next)
+		if entry == approvers {
+			found, err := fn(placed)
+			if err != nil {
+				return err
+			}
+			if found {
+				return errApprovalsPlaced
+			}
+			return nil
+		}
+
+		if next < len(approvals) {
+			placed[entry] = approvals[next]
+			if err := place(entry+1, next+1); err != nil {
+				return err
+			}
+		}
+
+		if missing > 0 {
+			placed[entry] = nil
+			if err := place(entry+1, next); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return place(0, 0)
+}
+
+// binomial returns n choose k, saturated at math.MaxInt
+func binomial(n, k int) int {
+	out := big.NewInt(0).Binomial(int64(n), int64(k))
+	if !out.IsInt64() {
+		return math.MaxInt
+	}
+	return int(out.Int64())
+}
+
+// BlockApproversSource gives the number of block approvers of the block following a block, the
+// number of block producers the `EXPERIMENTAL_validators_ordered` RPC method returns for it
+type BlockApproversSource interface {
+	BlockApprovers(ctx context.Context, blockHash *CryptoHash) (int, error)
+}
+
+// BlockHashVerifier verifies the hash of headers, knowing the number of block approvers of each
+// epoch from its BlockApproversSource or learning it from the headers it verified. Headers must
+// be given in order.
+type BlockHashVerifier struct {
+	// approvers is the number of block approvers by epoch and next epoch, the approvers of a
+	// block being the block producers of both
+	approvers map[string]int
+	// maxMissingApprovals is the number of missing approvals tried for epochs whose number of
+	// approvers is not known yet
+	maxMissingApprovals int
+	// source is nil when the number of approvers is learned from the headers only
+	source BlockApproversSource
+}
+
+type BlockHashVerifierOption func(v *BlockHashVerifier)
+
+// WithBlockApproversSource makes the verifier get the number of approvers of an epoch from source
+// the first time the epoch is seen and when it changes, the last blocks of an epoch being also
+// approved by the block producers of the next epoch, instead of learning it from the headers
+func WithBlockApproversSource(source BlockApproversSource) BlockHashVerifierOption {
+	return func(v *BlockHashVerifier) {
+		v.source = source
+	}
+}
+
+// NewBlockHashVerifier returns a verifier trying up to maxMissingApprovals missing approvals
+// until the number of approvers of an epoch is known
+func NewBlockHashVerifier(maxMissingApprovals int, opts ...BlockHashVerifierOption) *BlockHashVerifier {
+	v := &BlockHashVerifier{approvers: make(map[string]int), maxMissingApprovals: maxMissingApprovals}
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	return v
+}
+
+// Verify checks the hash of the header like VerifyHash does, returning a *BlockHashMismatchError
+// when the number of approvers of the header's epoch is known. Without BlockApproversSource, the
+// number is learned from the first header of the epoch that verifies with up to
+// maxMissingApprovals missing approvals, ErrBlockHashUnverifiable being returned for the headers
+// that do not until then.
+func (v *BlockHashVerifier) Verify(ctx context.Context, header *BlockHeader) error {
+	epoch := header.EpochId.AsString() + "/" + header.NextEpochId.AsString()
+
+	approvers, known := v.approvers[epoch]
+	fetched := false
+	if !known && v.source != nil {
+		var err error
+		if approvers, err = v.blockApprovers(ctx, header); err != nil {
+			return err
+		}
+		v.approvers[epoch] = approvers
+		known, fetched = true, true
+	}
+
+	if !known {
+		approvers, err := v.learnApprovers(header)
+		if err != nil {
+			return err
+		}
+		v.approvers[epoch] = approvers
+		return nil
+	}
+
+	err := header.VerifyHash(approvers)
+	var mismatch *BlockHashMismatchError
+	if !errors.As(err, &mismatch) || fetched {
+		return err
+	}
+
+	// The last blocks of an epoch are also approved by the block producers of the next epoch, the
+	// number of approvers grows
+	if v.source != nil {
+		current, sourceErr := v.blockApprovers(ctx, header)
+		if sourceErr != nil {
+			return sourceErr
+		}
+		if current == approvers {
+			return err
+		}
+
+		v.approvers[epoch] = current
+		return header.VerifyHash(current)
+	}
+
+	if current, learnErr := v.learnApprovers(header); learnErr == nil {
+		v.approvers[epoch] = current
+		return nil
+	}
+	return err
+}
+
+func (v *BlockHashVerifier) blockApprovers(ctx context.Context, header *BlockHeader) (int, error) {
+	approvers, err := v.source.BlockApprovers(ctx, header.PrevHash)
+	if err != nil {
+		return 0, fmt.Errorf("block #%d approvers: %w", header.Height, err)
+	}
+	return approvers, nil
+}
+
+// learnApprovers returns the number of approvers the header verifies with, trying up to
+// maxMissingApprovals missing approvals
+func (v *BlockHashVerifier) learnApprovers(header *BlockHeader) (int, error) {
+	for missing := 0; missing <= v.maxMissingApprovals; missing++ {
+		err := header.VerifyHash(len(header.Approvals) + missing)
+		if err == nil {
+			return len(header.Approvals) + missing, nil
+		}
+
+		var mismatch *BlockHashMismatchError
+		if !errors.As(err, &mismatch) {
+			return 0, err
+		}
+	}
+
+	return 0, fmt.Errorf("%w: block #%d hash does not match with up to %d missing approvals and the number of approvers of its epoch is not known yet", ErrBlockHashUnverifiable, header.Height, v.maxMissingApprovals)
+}
+
+// InnerLiteBorsh returns the Borsh serialization of the header's inner lite part, the part
+// light clients receive
+func (x *BlockHeader) InnerLiteBorsh() []byte {
+	w := &borshWriter{}
+	w.u64(x.Height)
+	w.hash(x.EpochId)
+	w.hash(x.NextEpochId)
+	w.hash(x.PrevStateRoot)
+	w.hash(x.OutcomeRoot)
+	w.u64(x.TimestampNanosec)
+	w.hash(x.NextBpHash)
+	w.hash(x.BlockMerkleRoot)
+
+	return w.Bytes()
+}
+
+// InnerRestBorsh returns the Borsh serialization of the header's inner rest part laid out as
+// version, with the approvals carried by the header
+func (x *BlockHeader) InnerRestBorsh(version BlockHeaderVersion) ([]byte, error) {
+	return x.innerRestBorsh(version, x.Approvals)
+}
+
+func (x *BlockHeader) innerRestBorsh(version BlockHeaderVersion, approvals []*Signature) ([]byte, error) {
+	if err := x.checkVersionFields(version); err != nil {
+		return nil, err
+	}
+
+	w := &borshWriter{}
+	w.hash(x.ChunkReceiptsRoot)
+	w.hash(x.ChunkHeadersRoot)
+	w.hash(x.ChunkTxRoot)
+	if version == BlockHeaderV1 {
+		w.u64(x.ChunksIncluded)
+	}
+	w.hash(x.ChallengesRoot)
+	w.hash(x.RandomValue)
+
+	w.u32(uint32(len(x.ValidatorProposals)))
+	for _, proposal := range x.ValidatorProposals {
+		if version >= BlockHeaderV3 {
+			// ValidatorStake::V1, earlier versions use the unversioned ValidatorStakeV1
+			w.u8(0)
+		}
+		w.string(proposal.AccountId)
+		if err := w.publicKey(proposal.PublicKey); err != nil {
+			return nil, fmt.Errorf("validator proposal %s: %w", proposal.AccountId, err)
+		}
+		w.u128(proposal.Stake)
+	}
+
+	w.u32(uint32(len(x.ChunkMask)))
+	for _, included := range x.ChunkMask {
+		w.bool(included)
+	}
+
+	w.u128(x.GasPrice)
+	w.u128(x.TotalSupply)
+
+	w.u32(uint32(len(x.ChallengesResult)))
+	for _, slashed := range x.ChallengesResult {
+		w.string(slashed.AccountId)
+		w.bool(slashed.IsDoubleSign)
+	}
+
+	w.hash(x.LastFinalBlock)
+	w.hash(x.LastDsFinalBlock)
+
+	if version >= BlockHeaderV3 {
+		w.u64(x.BlockOrdinal)
+		w.u64(x.PrevHeight)
+		if len(x.EpochSyncDataHash) > 0 {
+			w.u8(1)
+			w.raw(x.EpochSyncDataHash)
+		} else {
+			w.u8(0)
+		}
+	}
+
+	w.u32(uint32(len(approvals)))
+	for _, approval := range approvals {
+		if approval == nil {
+			w.u8(0)
+			continue
+		}
+
+		w.u8(1)
+		if err := w.signature(approval); err != nil {
+			return nil, fmt.Errorf("approval: %w", err)
+		}
+	}
+
+	w.u32(x.LatestProtocolVersion)
+
+	return w.Bytes(), nil
+}
+
+// borshHash returns the 32 bytes of the hash, a missing hash being the default (zero) hash
+func borshHash(hash *CryptoHash) []byte {
+	if hash == nil || len(hash.Bytes) == 0 {
+		return make([]byte, 32)
+	}
+	return hash.Bytes
+}
+
+// borshWriter serializes values with the Borsh encoding of the corresponding NEAR types
+type borshWriter struct {
+	bytes.Buffer
+}
+
+func (w *borshWriter) raw(data []byte) { w.Write(data) }
+
+func (w *borshWriter) u8(value uint8) { w.WriteByte(value) }
+
+func (w *borshWriter) u32(value uint32) { w.Write(binary.LittleEndian.AppendUint32(nil, value)) }
+
+func (w *borshWriter) u64(value uint64) { w.Write(binary.LittleEndian.AppendUint64(nil, value)) }
+
+// u128 writes the big-endian amount as a little-endian u128
+func (w *borshWriter) u128(value *BigInt) {
+	out := new(big.Int).SetBytes(value.GetBytes()).FillBytes(make([]byte, 16))
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+	w.Write(out)
+}
+
+func (w *borshWriter) bool(value bool) {
+	if value {
+		w.u8(1)
+	} else {
+		w.u8(0)
+	}
+}
+
+func (w *borshWriter) string(value string) {
+	w.u32(uint32(len(value)))
+	w.WriteString(value)
+}
+
+func (w *borshWriter) hash(hash *CryptoHash) { w.Write(borshHash(hash)) }
+
+func (w *borshWriter) publicKey(key *PublicKey) error {
+	return w.curveBytes(key.GetType(), key.GetBytes(), 32, 64)
+}
+
+func (w *borshWriter) signature(signature *Signature) error {
+	return w.curveBytes(signature.GetType(), signature.GetBytes(), 64, 65)
+}
+
+// curveBytes writes the curve kind tag followed by the key or signature bytes, whose size
+// depends on the curve
+func (w *borshWriter) curveBytes(kind CurveKind, data []byte, ed25519Size, secp256k1Size int) error {
+	expected := ed25519Size
+	if kind == CurveKind_SECP256K1 {
+		expected = secp256k1Size
+	}
+
+	if len(data) != expected {
+		return fmt.Errorf("invalid %s length %d, expected %d", curvePrefix(kind), len(data), expected)
+	}
+
+	w.u8(uint8(kind))
+	w.Write(data)
+	return nil
+}
@@ -0,0 +1,276 @@
+package pbnear
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestBlockHeader_VerifyHash(t *testing.T) {
+	hash := func(b byte) *CryptoHash { return &CryptoHash{Bytes: bytes.Repeat([]byte{b}, 32)} }
+	approval := func(b byte) *Signature { return &Signature{Bytes: bytes.Repeat([]byte{b}, 64)} }
+
+	header := &BlockHeader{
+		Height: 10, PrevHeight: 9, Hash: hash(1), PrevHash: hash(2), EpochId: hash(3), NextEpochId: hash(4),
+		ChunkMask: []bool{true, false}, GasPrice: &BigInt{Bytes: []byte{1, 0}}, TotalSupply: &BigInt{Bytes: []byte{2}},
+		ValidatorProposals: []*ValidatorStake{{AccountId: "val.near", PublicKey: &PublicKey{Bytes: make([]byte, 32)}, Stake: &BigInt{Bytes: []byte{3}}}},
+		Approvals:          []*Signature{approval(5), approval(6)},
+		TimestampNanosec:   1700000000000000000, LatestProtocolVersion: 47,
+	}
+
+	// The second of three approvers did not approve the block
+	computed, err := header.computeHash(BlockHeaderV2, []*Signature{approval(5), nil, approval(6)})
+	require.NoError(t, err)
+	header.Hash = computed
+
+	require.NoError(t, header.VerifyHash(3))
+
+	// Light clients get the hash of the inner rest part from the node
+	innerRest, err := header.innerRestBorsh(BlockHeaderV2, []*Signature{approval(5), nil, approval(6)})
+	require.NoError(t, err)
+	innerRestHash := sha256.Sum256(innerRest)
+	assert.Equal(t, computed, header.ComputeLightClientHash(&CryptoHash{Bytes: innerRestHash[:]}))
+
+	var mismatch *BlockHashMismatchError
+	require.ErrorAs(t, header.VerifyHash(0), &mismatch)
+	assert.Equal(t, uint64(10), mismatch.Height)
+
+	verifier := NewBlockHashVerifier(1)
+	require.NoError(t, verifier.Verify(context.Background(), header))
+
+	// The number of approvers of the epoch is now known, a tampered header is a mismatch
+	tampered := proto.Clone(header).(*BlockHeader)
+	tampered.TotalSupply = &BigInt{Bytes: []byte{3}}
+	require.ErrorAs(t, verifier.Verify(context.Background(), tampered), &mismatch)
+
+	// Headers with fields the BlockHeader does not carry cannot be verified
+	withoutOrdinal := proto.Clone(header).(*BlockHeader)
+	withoutOrdinal.LatestProtocolVersion = 50
+	assert.True(t, errors.Is(withoutOrdinal.VerifyHash(0), ErrBlockHashUnverifiable))
+
+	withBodyHash := proto.Clone(header).(*BlockHeader)
+	withBodyHash.LatestProtocolVersion = 63
+	withBodyHash.BlockOrdinal = 10
+	assert.True(t, errors.Is(withBodyHash.VerifyHash(0), ErrBlockBodyHashRequired))
+	assert.True(t, errors.Is(withBodyHash.VerifyHash(0), ErrBlockHashUnverifiable))
+}
+
+// testBlockApproversSource gives the number of approvers of the block following each block,
+// recording the blocks asked for
+type testBlockApproversSource struct {
+	approvers map[string]int
+	asked     []string
+}
+
+func (s *testBlockApproversSource) BlockApprovers(_ context.Context, blockHash *CryptoHash) (int, error) {
+	s.asked = append(s.asked, blockHash.AsString())
+
+	approvers, found := s.approvers[blockHash.AsString()]
+	if !found {
+		return 0, fmt.Errorf("unknown block %s", blockHash.AsString())
+	}
+	return approvers, nil
+}
+
+func TestBlockHashVerifier_ApproversSource(t *testing.T) {
+	hash := func(b byte) *CryptoHash { return &CryptoHash{Bytes: bytes.Repeat([]byte{b}, 32)} }
+	approval := func(b byte) *Signature { return &Signature{Bytes: bytes.Repeat([]byte{b}, 64)} }
+
+	// newHeader returns a header of the epoch whose hash is computed with the approvals
+	newHeader := func(height uint64, prevHash *CryptoHash, approvals []*Signature) *BlockHeader {
+		header := &BlockHeader{
+			Height: height, PrevHeight: height - 1, PrevHash: prevHash, EpochId: hash(3), NextEpochId: hash(4),
+			GasPrice: &BigInt{Bytes: []byte{1}}, TotalSupply: &BigInt{Bytes: []byte{2}}, LatestProtocolVersion: 47,
+		}
+		for _, approval := range approvals {
+			if approval != nil {
+				header.Approvals = append(header.Approvals, approval)
+			}
+		}
+
+		computed, err := header.computeHash(BlockHeaderV2, approvals)
+		require.NoError(t, err)
+		header.Hash = computed
+		return header
+	}
+
+	// Three approvers, then four for the last block of the epoch, each missing more approvals
+	// than learning tries
+	first := newHeader(10, hash(9), []*Signature{approval(5), nil, nil})
+	last := newHeader(11, first.Hash, []*Signature{approval(5), nil, nil, nil})
+
+	source := &testBlockApproversSource{approvers: map[string]int{hash(9).AsString(): 3, first.Hash.AsString(): 4}}
+
+	// The first header of the epoch is tampered, it is a mismatch and not unverifiable
+	tampered := proto.Clone(first).(*BlockHeader)
+	tampered.TotalSupply = &BigInt{Bytes: []byte{3}}
+	var mismatch *BlockHashMismatchError
+	require.ErrorAs(t, NewBlockHashVerifier(1, WithBlockApproversSource(source)).Verify(context.Background(), tampered), &mismatch)
+
+	source.asked = nil
+	verifier := NewBlockHashVerifier(1, WithBlockApproversSource(source))
+	require.NoError(t, verifier.Verify(context.Background(), first))
+	require.NoError(t, verifier.Verify(context.Background(), last))
+	assert.Equal(t, []string{hash(9).AsString(), first.Hash.AsString()}, source.asked)
+
+	// Without source, the number of approvers cannot be learned from these headers
+	assert.True(t, errors.Is(NewBlockHashVerifier(1).Verify(context.Background(), first), ErrBlockHashUnverifiable))
+}
+
+// TestBlockHeader_VerifyHash_KnownAnswer verifies block #123 of a localnet run with protocol
+// version 47, see codec/testdata/old.firelog
+func TestBlockHeader_VerifyHash_KnownAnswer(t *testing.T) {
+	hash := func(in string) *CryptoHash { return &CryptoHash{Bytes: mustBase64(t, in)} }
+	approval := func(in string) *Signature { return &Signature{Bytes: mustBase64(t, in)} }
+
+	header := &BlockHeader{
+		Height:            123,
+		PrevHeight:        122,
+		EpochId:           hash("wPlosPzhC+Fx7APrhwX8hbLbzixlUnULGYhZ7Y+NqxQ="),
+		NextEpochId:       hash("n+Up//XSaOtzPc4zC9SE1iQaUwNtjXX75TvTqYI5GTQ="),
+		Hash:              hash("BDGXbi6CEcwy+3HbaX5DMgnon6jeF5ZEok9WDW2zQMU="),
+		PrevHash:          hash("n+Up//XSaOtzPc4zC9SE1iQaUwNtjXX75TvTqYI5GTQ="),
+		PrevStateRoot:     hash("o/RipX1udDvFCNe97bZ/7xRg/mSEzoNmPA/cBJQpXkU="),
+		ChunkReceiptsRoot: hash("ek/eNqQm3q9eElL1cYoG4Xh/jRLLfaWhgFf6n/tmzg0="),
+		ChunkHeadersRoot:  hash("2J5t4JPeJeTv7HtZQ63a1uc/rpPvydJc4QDUfzdJhU4="),
+		ChunkTxRoot:       hash("Zmh6rfhivXdsj8GLjp+OIAiXFIVu4jOzkCpZHQ1fKSU="),
+		OutcomeRoot:       hash("Zmh6rfhivXdsj8GLjp+OIAiXFIVu4jOzkCpZHQ1fKSU="),
+		ChunksIncluded:    1,
+		ChallengesRoot:    hash("AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA="),
+		Timestamp:         1634924947419862434,
+		TimestampNanosec:  1634924947419862434,
+		RandomValue:       hash("6EaijtFKXmzMC0gzxmN6M/vA5pqvsSPsCxxSoZycQPo="),
+		ChunkMask:         []bool{true},
+		GasPrice:          &BigInt{Bytes: mustBase64(t, "AAAAAAAAAAAAAAAAO5rKAA==")},
+		TotalSupply:       &BigInt{Bytes: mustBase64(t, "AAD70iIkgolLYenk87UPXA==")},
+		LastFinalBlock:    hash("dWzC/jjEyk4CBzeX/M3WlfEF3e74Hhx4tS3h9zsMvwo="),
+		LastDsFinalBlock:  hash("n+Up//XSaOtzPc4zC9SE1iQaUwNtjXX75TvTqYI5GTQ="),
+		NextBpHash:        hash("ut428YEAI7IQh21uBueLTJxRWSxHIFGuFkCsE6UYZ24="),
+		BlockMerkleRoot:   hash("Ezzc2lkkwH3II09okD9dXajCJGZV884+LK8CempWFlE="),
+		Approvals: []*Signature{
+			approval("3l4da7dzqMa6KanOrsNxvHbX9cXGpGKu1lQrNj7wyUYH/Keo1Z8hTFppioe8YigsqzELcHCHY3Mg68u/WVyjDQ=="),
+			approval("2HfEYPwHEikflukgIPnLfHwUvo6TNA9guVkQe0DtGpcH52RUdjMHFj0AQANBGV9SKtLQ0MWfNn++OkHsHjYsDg=="),
+			approval("4uln5y1rxKodmxELnsddo9yAod6qvnOcR5Xxj8fiKBQlY/L1Cb9OReQ6qJJW4T4liiXAhTzPbHNQDQ7Skf9GAw=="),
+			approval("4+Ok5ViyH9jdvIzZRcn0kq/8WdhNDlNy4AKFEyQVcAN55yAuh3c2hYKXAr2ml8E4VYR1yF6d9sKSWi7gQkOVDw=="),
+		},
+		LatestProtocolVersion: 47,
+	}
+
+	assert.Equal(t, []BlockHeaderVersion{BlockHeaderV2, BlockHeaderV1}, header.PossibleVersions())
+	require.NoError(t, header.VerifyHash(0))
+
+	computed, err := header.ComputeHash(BlockHeaderV2)
+	require.NoError(t, err)
+	assert.Equal(t, header.Hash.Bytes, computed.Bytes)
+
+	computed, err = header.ComputeHash(BlockHeaderV1)
+	require.NoError(t, err)
+	assert.NotEqual(t, header.Hash.Bytes, computed.Bytes)
+}
+
+func TestBlockHeader_InnerRestBorsh(t *testing.T) {
+	hash := func(b byte) *CryptoHash { return &CryptoHash{Bytes: bytes.Repeat([]byte{b}, 32)} }
+	u32 := func(value uint32) []byte { return binary.LittleEndian.AppendUint32(nil, value) }
+	u64 := func(value uint64) []byte { return binary.LittleEndian.AppendUint64(nil, value) }
+	u128 := func(value byte) []byte { return append([]byte{value}, make([]byte, 15)...) }
+	concat := func(parts ...[]byte) []byte { return bytes.Join(parts, nil) }
+
+	header := &BlockHeader{
+		ChunkReceiptsRoot:     hash(1),
+		ChunkHeadersRoot:      hash(2),
+		ChunkTxRoot:           hash(3),
+		ChunksIncluded:        1,
+		ChallengesRoot:        hash(4),
+		RandomValue:           hash(5),
+		ValidatorProposals:    []*ValidatorStake{{AccountId: "val", PublicKey: &PublicKey{Bytes: bytes.Repeat([]byte{6}, 32)}, Stake: &BigInt{Bytes: []byte{7}}}},
+		ChunkMask:             []bool{true, false},
+		GasPrice:              &BigInt{Bytes: []byte{8}},
+		TotalSupply:           &BigInt{Bytes: []byte{9}},
+		ChallengesResult:      []*SlashedValidator{{AccountId: "bad", IsDoubleSign: true}},
+		LastFinalBlock:        hash(10),
+		LastDsFinalBlock:      hash(11),
+		BlockOrdinal:          12,
+		PrevHeight:            13,
+		EpochSyncDataHash:     bytes.Repeat([]byte{14}, 32),
+		Approvals:             []*Signature{{Bytes: bytes.Repeat([]byte{15}, 64)}},
+		LatestProtocolVersion: 60,
+	}
+
+	roots := concat(hash(1).Bytes, hash(2).Bytes, hash(3).Bytes)
+	randomness := concat(hash(4).Bytes, hash(5).Bytes)
+	// Account ID, ED25519 public key and stake of ValidatorStakeV1
+	proposal := concat(u32(3), []byte("val"), []byte{0}, bytes.Repeat([]byte{6}, 32), u128(7))
+	rest := concat(
+		u32(2), []byte{1, 0}, u128(8), u128(9),
+		u32(1), u32(3), []byte("bad"), []byte{1},
+		hash(10).Bytes, hash(11).Bytes,
+	)
+	approvals := concat(u32(1), []byte{1, 0}, bytes.Repeat([]byte{15}, 64))
+
+	tests := []struct {
+		version  BlockHeaderVersion
+		expected []byte
+	}{
+		{BlockHeaderV1, concat(roots, u64(1), randomness, u32(1), proposal, rest, approvals, u32(60))},
+		{BlockHeaderV2, concat(roots, randomness, u32(1), proposal, rest, approvals, u32(60))},
+		{BlockHeaderV3, concat(roots, randomness, u32(1), []byte{0}, proposal, rest, u64(12), u64(13), []byte{1}, bytes.Repeat([]byte{14}, 32), approvals, u32(60))},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("v%d", tt.version), func(t *testing.T) {
+			innerRest, err := header.InnerRestBorsh(tt.version)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, innerRest)
+		})
+	}
+
+	_, err := header.InnerRestBorsh(BlockHeaderV4)
+	assert.True(t, errors.Is(err, ErrBlockHashUnverifiable))
+}
+
+func mustBase64(t *testing.T, in string) []byte {
+	t.Helper()
+
+	out, err := base64.StdEncoding.DecodeString(in)
+	require.NoError(t, err)
+	return out
+}
+
+func TestForEachApprovalsPlacement(t *testing.T) {
+	a, b := &Signature{Bytes: []byte{'a'}}, &Signature{Bytes: []byte{'b'}}
+
+	var placements []string
+	err := forEachApprovalsPlacement([]*Signature{a, b}, 4, func(approvals []*Signature) (bool, error) {
+		placement := ""
+		for _, approval := range approvals {
+			if approval == nil {
+				placement += "-"
+			} else {
+				placement += string(approval.Bytes)
+			}
+		}
+		placements = append(placements, placement)
+		return false, nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"ab--", "a-b-", "a--b", "-ab-", "-a-b", "--ab"}, placements)
+	assert.Equal(t, 6, binomial(4, 2))
+}
+
+func TestBorshWriter_U128(t *testing.T) {
+	w := &borshWriter{}
+	w.u128(&BigInt{Bytes: []byte{0x01, 0x02}})
+	w.u128(nil)
+
+	assert.Equal(t, append([]byte{0x02, 0x01}, make([]byte, 30)...), w.Bytes())
+}
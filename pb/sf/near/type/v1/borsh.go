@@ -0,0 +1,578 @@
+package pbnear
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// maxBorshDepth bounds the nesting of decoded values, protecting against recursive schemas
+const maxBorshDepth = 64
+
+// borshSchema is a Borsh schema container as serialized in near-sdk ABIs, both the `borsh` 0.x
+// (Array, Sequence, Tuple, Enum and Struct definitions) and 1.x (which adds Primitive, length
+// widths on sequences and explicit discriminants on enums) flavors are supported.
+type borshSchema struct {
+	Declaration string                      `json:"declaration"`
+	Definitions map[string]*borshDefinition `json:"definitions"`
+}
+
+type borshDefinition struct {
+	Primitive *uint8 `json:"Primitive"`
+	Array     *struct {
+		Length   uint64 `json:"length"`
+		Elements string `json:"elements"`
+	} `json:"Array"`
+	Sequence *struct {
+		LengthWidth *uint8 `json:"length_width"`
+		LengthRange *struct {
+			Start uint64 `json:"start"`
+			End   uint64 `json:"end"`
+		} `json:"length_range"`
+		Elements string `json:"elements"`
+	} `json:"Sequence"`
+	Tuple *struct {
+		Elements []string `json:"elements"`
+	} `json:"Tuple"`
+	Enum *struct {
+		TagWidth *uint8            `json:"tag_width"`
+		Variants []json.RawMessage `json:"variants"`
+	} `json:"Enum"`
+	Struct *struct {
+		Fields json.RawMessage `json:"fields"`
+	} `json:"Struct"`
+}
+
+type borshVariant struct {
+	Discriminant uint64
+	Name         string
+	Declaration  string
+}
+
+type borshField struct {
+	Name        string
+	Declaration string
+}
+
+func parseBorshSchema(in json.RawMessage) (*borshSchema, error) {
+	schema := &borshSchema{}
+	if err := json.Unmarshal(in, schema); err != nil {
+		return nil, fmt.Errorf("invalid borsh schema: %w", err)
+	}
+
+	if schema.Declaration == "" {
+		return nil, fmt.Errorf("invalid borsh schema: declaration is missing")
+	}
+
+	return schema, nil
+}
+
+// variants returns the enum variants, `[name, declaration]` (0.x, the discriminant being the
+// index) or `[discriminant, name, declaration]` (1.x) entries.
+func (d *borshDefinition) variants() ([]borshVariant, error) {
+	out := make([]borshVariant, len(d.Enum.Variants))
+	for i, raw := range d.Enum.Variants {
+		var parts []json.RawMessage
+		if err := json.Unmarshal(raw, &parts); err != nil {
+			return nil, fmt.Errorf("invalid enum variant: %w", err)
+		}
+
+		out[i].Discriminant = uint64(i)
+		if len(parts) == 3 {
+			if err := json.Unmarshal(parts[0], &out[i].Discriminant); err != nil {
+				return nil, fmt.Errorf("invalid enum variant discriminant: %w", err)
+			}
+			parts = parts[1:]
+		}
+
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid enum variant, expected [name, declaration]")
+		}
+
+		if err := json.Unmarshal(parts[0], &out[i].Name); err != nil {
+			return nil, fmt.Errorf("invalid enum variant name: %w", err)
+		}
+		if err := json.Unmarshal(parts[1], &out[i].Declaration); err != nil {
+			return nil, fmt.Errorf("invalid enum variant declaration: %w", err)
+		}
+	}
+
+	return out, nil
+}
+
+// fields returns the struct fields, named is false for tuple structs
+func (d *borshDefinition) fields() (fields []borshField, named bool, err error) {
+	var empty string
+	if json.Unmarshal(d.Struct.Fields, &empty) == nil {
+		return nil, true, nil
+	}
+
+	var kind struct {
+		NamedFields   [][2]string `json:"NamedFields"`
+		UnnamedFields []string    `json:"UnnamedFields"`
+	}
+	if err := json.Unmarshal(d.Struct.Fields, &kind); err != nil {
+		return nil, false, fmt.Errorf("invalid struct fields: %w", err)
+	}
+
+	if kind.NamedFields != nil {
+		for _, field := range kind.NamedFields {
+			fields = append(fields, borshField{Name: field[0], Declaration: field[1]})
+		}
+		return fields, true, nil
+	}
+
+	for _, declaration := range kind.UnnamedFields {
+		fields = append(fields, borshField{Declaration: declaration})
+	}
+	return fields, false, nil
+}
+
+type borshDecoder struct {
+	data        []byte
+	pos         int
+	definitions map[string]*borshDefinition
+}
+
+func (d *borshDecoder) read(n int) ([]byte, error) {
+	if n < 0 || d.pos+n > len(d.data) {
+		return nil, fmt.Errorf("unexpected end of data at offset %d reading %d bytes", d.pos, n)
+	}
+
+	out := d.data[d.pos : d.pos+n]
+	d.pos += n
+	return out, nil
+}
+
+func (d *borshDecoder) readUint(width int) (uint64, error) {
+	content, err := d.read(width)
+	if err != nil {
+		return 0, err
+	}
+
+	var out uint64
+	for i := width - 1; i >= 0; i-- {
+		out = out<<8 | uint64(content[i])
+	}
+	return out, nil
+}
+
+// readLength reads a collection length and ensures it is plausible given the remaining data
+func (d *borshDecoder) readLength(width int) (int, error) {
+	length, err := d.readUint(width)
+	if err != nil {
+		return 0, err
+	}
+
+	if length > uint64(len(d.data)-d.pos) {
+		return 0, fmt.Errorf("length %d at offset %d exceeds remaining data", length, d.pos)
+	}
+	return int(length), nil
+}
+
+func (d *borshDecoder) decode(declaration string, depth int) (any, error) {
+	if depth > maxBorshDepth {
+		return nil, fmt.Errorf("maximum nesting depth reached decoding %q", declaration)
+	}
+
+	if value, found, err := d.decodePrimitive(declaration); found {
+		return value, err
+	}
+
+	if definition, found := d.definitions[declaration]; found {
+		return d.decodeDefinition(declaration, definition, depth)
+	}
+
+	return d.decodeGeneric(declaration, depth)
+}
+
+func (d *borshDecoder) decodePrimitive(declaration string) (value any, found bool, err error) {
+	readInt := func(width int, signed bool) (any, error) {
+		content, err := d.read(width)
+		if err != nil {
+			return nil, err
+		}
+
+		switch width {
+		case 1, 2, 4:
+			unsigned, _ := (&borshDecoder{data: content}).readUint(width)
+			if signed {
+				shift := 64 - 8*width
+				return int64(unsigned<<shift) >> shift, nil
+			}
+			return unsigned, nil
+		}
+
+		// 64 and 128 bits values are rendered as strings, like near-sdk's U64/U128 JSON types,
+		// as JSON numbers can't represent them without losing precision.
+		bigEndian := make([]byte, width)
+		for i := range content {
+			bigEndian[width-1-i] = content[i]
+		}
+		out := new(big.Int).SetBytes(bigEndian)
+		if signed && content[width-1]&0x80 != 0 {
+			out.Sub(out, new(big.Int).Lsh(big.NewInt(1), uint(8*width)))
+		}
+		return out.String(), nil
+	}
+
+	switch declaration {
+	case "u8", "u16", "u32", "u64", "u128":
+		bits, _ := strconv.Atoi(declaration[1:])
+		value, err = readInt(bits/8, false)
+	case "i8", "i16", "i32", "i64", "i128":
+		bits, _ := strconv.Atoi(declaration[1:])
+		value, err = readInt(bits/8, true)
+	case "f32":
+		var bits uint64
+		bits, err = d.readUint(4)
+		value = float64(math.Float32frombits(uint32(bits)))
+	case "f64":
+		var bits uint64
+		bits, err = d.readUint(8)
+		value = math.Float64frombits(bits)
+	case "bool":
+		var content []byte
+		if content, err = d.read(1); err == nil {
+			if content[0] > 1 {
+				err = fmt.Errorf("invalid bool value %d", content[0])
+			}
+			value = content[0] == 1
+		}
+	case "string", "String":
+		var length int
+		if length, err = d.readLength(4); err == nil {
+			var content []byte
+			if content, err = d.read(length); err == nil {
+				value = string(content)
+			}
+		}
+	case "()", "nil":
+		value = nil
+	default:
+		return nil, false, nil
+	}
+
+	return value, true, err
+}
+
+func (d *borshDecoder) decodeDefinition(declaration string, definition *borshDefinition, depth int) (any, error) {
+	switch {
+	case definition.Primitive != nil:
+		if *definition.Primitive > 8 {
+			return nil, fmt.Errorf("unsupported %d bytes primitive %q", *definition.Primitive, declaration)
+		}
+		return d.readUint(int(*definition.Primitive))
+
+	case definition.Array != nil:
+		return d.decodeSequence(definition.Array.Elements, int(definition.Array.Length), depth)
+
+	case definition.Sequence != nil:
+		width := 4
+		if definition.Sequence.LengthWidth != nil {
+			width = int(*definition.Sequence.LengthWidth)
+		}
+
+		if width == 0 {
+			if definition.Sequence.LengthRange == nil {
+				return nil, fmt.Errorf("fixed length sequence %q without length range", declaration)
+			}
+			return d.decodeSequence(definition.Sequence.Elements, int(definition.Sequence.LengthRange.Start), depth)
+		}
+
+		length, err := d.readLength(width)
+		if err != nil {
+			return nil, err
+		}
+
+		if definition.Sequence.Elements == "u8" && (declaration == "String" || declaration == "string") {
+			content, err := d.read(length)
+			return string(content), err
+		}
+		return d.decodeSequence(definition.Sequence.Elements, length, depth)
+
+	case definition.Tuple != nil:
+		return d.decodeTuple(definition.Tuple.Elements, depth)
+
+	case definition.Enum != nil:
+		variants, err := definition.variants()
+		if err != nil {
+			return nil, fmt.Errorf("enum %q: %w", declaration, err)
+		}
+
+		width := 1
+		if definition.Enum.TagWidth != nil {
+			width = int(*definition.Enum.TagWidth)
+		}
+
+		return d.decodeEnum(declaration, variants, width, depth)
+
+	case definition.Struct != nil:
+		fields, named, err := definition.fields()
+		if err != nil {
+			return nil, fmt.Errorf("struct %q: %w", declaration, err)
+		}
+
+		if !named {
+			declarations := make([]string, len(fields))
+			for i, field := range fields {
+				declarations[i] = field.Declaration
+			}
+
+			// Newtypes, like `AccountId(String)`, are rendered as their inner value
+			if len(declarations) == 1 {
+				return d.decode(declarations[0], depth+1)
+			}
+			return d.decodeTuple(declarations, depth)
+		}
+
+		out := make(orderedObject, 0, len(fields))
+		for _, field := range fields {
+			value, err := d.decode(field.Declaration, depth+1)
+			if err != nil {
+				return nil, fmt.Errorf("field %q: %w", field.Name, err)
+			}
+			out = append(out, orderedField{Name: field.Name, Value: value})
+		}
+		return out, nil
+	}
+
+	return nil, fmt.Errorf("unsupported definition for %q", declaration)
+}
+
+// decodeGeneric decodes the standard generic declarations, used when the schema does not
+// define them explicitly.
+func (d *borshDecoder) decodeGeneric(declaration string, depth int) (any, error) {
+	if strings.HasPrefix(declaration, "(") && strings.HasSuffix(declaration, ")") {
+		return d.decodeTuple(splitBorshDeclarations(declaration[1:len(declaration)-1]), depth)
+	}
+
+	if strings.HasPrefix(declaration, "[") && strings.HasSuffix(declaration, "]") {
+		parts := splitBorshDeclarationsOn(declaration[1:len(declaration)-1], ';')
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid array declaration %q", declaration)
+		}
+
+		length, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid array length in %q: %w", declaration, err)
+		}
+		return d.decodeSequence(parts[0], length, depth)
+	}
+
+	name, params, isGeneric := strings.Cut(declaration, "<")
+	if !isGeneric || !strings.HasSuffix(params, ">") {
+		return nil, fmt.Errorf("unknown declaration %q, not defined by the schema", declaration)
+	}
+	args := splitBorshDeclarations(params[:len(params)-1])
+
+	expectArgs := func(count int) error {
+		if len(args) != count {
+			return fmt.Errorf("expected %d type parameters in %q", count, declaration)
+		}
+		return nil
+	}
+
+	switch name {
+	case "Box", "Rc", "Arc":
+		if err := expectArgs(1); err != nil {
+			return nil, err
+		}
+		return d.decode(args[0], depth+1)
+
+	case "Vec", "VecDeque", "LinkedList", "HashSet", "BTreeSet":
+		if err := expectArgs(1); err != nil {
+			return nil, err
+		}
+
+		length, err := d.readLength(4)
+		if err != nil {
+			return nil, err
+		}
+		return d.decodeSequence(args[0], length, depth)
+
+	case "Option":
+		if err := expectArgs(1); err != nil {
+			return nil, err
+		}
+		return d.decodeEnum(declaration, []borshVariant{{0, "None", "()"}, {1, "Some", args[0]}}, 1, depth)
+
+	case "Result":
+		if err := expectArgs(2); err != nil {
+			return nil, err
+		}
+		return d.decodeEnum(declaration, []borshVariant{{0, "Ok", args[0]}, {1, "Err", args[1]}}, 1, depth)
+
+	case "HashMap", "BTreeMap":
+		if err := expectArgs(2); err != nil {
+			return nil, err
+		}
+
+		length, err := d.readLength(4)
+		if err != nil {
+			return nil, err
+		}
+
+		entries := make([]any, 0, length)
+		object := make(orderedObject, 0, length)
+		stringKeys := true
+		for i := 0; i < length; i++ {
+			key, err := d.decode(args[0], depth+1)
+			if err != nil {
+				return nil, fmt.Errorf("map key: %w", err)
+			}
+
+			value, err := d.decode(args[1], depth+1)
+			if err != nil {
+				return nil, fmt.Errorf("map value: %w", err)
+			}
+
+			entries = append(entries, []any{key, value})
+			if keyString, isString := key.(string); isString {
+				object = append(object, orderedField{Name: keyString, Value: value})
+			} else {
+				stringKeys = false
+			}
+		}
+
+		if stringKeys {
+			return object, nil
+		}
+		return entries, nil
+	}
+
+	return nil, fmt.Errorf("unknown declaration %q, not defined by the schema", declaration)
+}
+
+func (d *borshDecoder) decodeSequence(elements string, length int, depth int) (any, error) {
+	// Bytes are rendered as base64, like near-sdk's Base64VecU8 JSON type
+	if elements == "u8" {
+		content, err := d.read(length)
+		if err != nil {
+			return nil, err
+		}
+		return base64.StdEncoding.EncodeToString(content), nil
+	}
+
+	out := make([]any, 0, length)
+	for i := 0; i < length; i++ {
+		value, err := d.decode(elements, depth+1)
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+		out = append(out, value)
+	}
+	return out, nil
+}
+
+func (d *borshDecoder) decodeTuple(elements []string, depth int) (any, error) {
+	out := make([]any, 0, len(elements))
+	for i, element := range elements {
+		value, err := d.decode(element, depth+1)
+		if err != nil {
+			return nil, fmt.Errorf("tuple element %d: %w", i, err)
+		}
+		out = append(out, value)
+	}
+	return out, nil
+}
+
+// decodeEnum renders `Option` as null or its value, unit variants as their name and other
+// variants as a single key object `{"<variant>": <value>}`.
+func (d *borshDecoder) decodeEnum(declaration string, variants []borshVariant, tagWidth int, depth int) (any, error) {
+	tag, err := d.readUint(tagWidth)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, variant := range variants {
+		if variant.Discriminant != tag {
+			continue
+		}
+
+		value, err := d.decode(variant.Declaration, depth+1)
+		if err != nil {
+			return nil, fmt.Errorf("variant %q: %w", variant.Name, err)
+		}
+
+		if strings.HasPrefix(declaration, "Option<") {
+			return value, nil
+		}
+
+		if variant.Declaration == "()" || variant.Declaration == "nil" {
+			return variant.Name, nil
+		}
+
+		return orderedObject{{Name: variant.Name, Value: value}}, nil
+	}
+
+	return nil, fmt.Errorf("unknown discriminant %d for enum %q", tag, declaration)
+}
+
+func splitBorshDeclarations(in string) []string {
+	return splitBorshDeclarationsOn(in, ',')
+}
+
+// splitBorshDeclarationsOn splits the declarations list on separator, ignoring separators
+// nested in generic parameters, tuples and arrays.
+func splitBorshDeclarationsOn(in string, separator rune) (out []string) {
+	depth := 0
+	start := 0
+	for i, char := range in {
+		switch char {
+		case '<', '(', '[':
+			depth++
+		case '>', ')', ']':
+			depth--
+		case separator:
+			if depth == 0 {
+				out = append(out, strings.TrimSpace(in[start:i]))
+				start = i + 1
+			}
+		}
+	}
+
+	if last := strings.TrimSpace(in[start:]); last != "" {
+		out = append(out, last)
+	}
+	return
+}
+
+type orderedField struct {
+	Name  string
+	Value any
+}
+
+// orderedObject is a JSON object keeping the order of its fields, as declared by the schema
+type orderedObject []orderedField
+
+func (o orderedObject) MarshalJSON() ([]byte, error) {
+	buffer := bytes.NewBufferString("{")
+	for i, field := range o {
+		if i > 0 {
+			buffer.WriteByte(',')
+		}
+
+		name, err := json.Marshal(field.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := json.Marshal(field.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		buffer.Write(name)
+		buffer.WriteByte(':')
+		buffer.Write(value)
+	}
+	buffer.WriteByte('}')
+
+	return buffer.Bytes(), nil
+}
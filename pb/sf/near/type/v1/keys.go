@@ -0,0 +1,208 @@
+package pbnear
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/mr-tron/base58"
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	ed25519KeyPrefix   = "ed25519"
+	secp256k1KeyPrefix = "secp256k1"
+
+	// secp256k1PublicKeyLen is the length of NEAR secp256k1 public keys, the uncompressed point
+	// without its leading 0x04 byte.
+	secp256k1PublicKeyLen = 64
+
+	// secp256k1SignatureLen is the length of NEAR secp256k1 signatures, `r`, `s` and the recovery
+	// id `v`.
+	secp256k1SignatureLen = 65
+)
+
+func curvePrefix(curve CurveKind) string {
+	if curve == CurveKind_SECP256K1 {
+		return secp256k1KeyPrefix
+	}
+	return ed25519KeyPrefix
+}
+
+// parseKeyString parses NEAR `<curve>:<base58>` key strings, keys without curve prefix being ed25519
+func parseKeyString(in string) (CurveKind, []byte, error) {
+	curve := CurveKind_ED25519
+	encoded := in
+	if prefix, value, found := strings.Cut(in, ":"); found {
+		switch prefix {
+		case ed25519KeyPrefix:
+		case secp256k1KeyPrefix:
+			curve = CurveKind_SECP256K1
+		default:
+			return 0, nil, fmt.Errorf("unknown key curve %q", prefix)
+		}
+		encoded = value
+	}
+
+	content, err := base58.Decode(encoded)
+	if err != nil {
+		return 0, nil, fmt.Errorf("invalid base58 key: %w", err)
+	}
+
+	return curve, content, nil
+}
+
+// ParsePublicKey parses a NEAR public key string, ex: `ed25519:<base58>`
+func ParsePublicKey(in string) (*PublicKey, error) {
+	curve, content, err := parseKeyString(in)
+	if err != nil {
+		return nil, err
+	}
+
+	expectedLen := ed25519.PublicKeySize
+	if curve == CurveKind_SECP256K1 {
+		expectedLen = secp256k1PublicKeyLen
+	}
+
+	if len(content) != expectedLen {
+		return nil, fmt.Errorf("invalid %s public key length %d, expected %d", curvePrefix(curve), len(content), expectedLen)
+	}
+
+	return &PublicKey{Type: curve, Bytes: content}, nil
+}
+
+// AsKeyString returns the NEAR string representation of the key, ex: `ed25519:<base58>`
+func (x *PublicKey) AsKeyString() string {
+	return curvePrefix(x.Type) + ":" + base58.Encode(x.Bytes)
+}
+
+// ImplicitAccountID returns the account ID controlled by the key: the implicit account (hex of
+// the public key) for ed25519 keys and the ETH-implicit account (`0x` followed by the last 20
+// bytes of the public key's keccak256) for secp256k1 keys.
+func (x *PublicKey) ImplicitAccountID() string {
+	if x.Type == CurveKind_SECP256K1 {
+		hash := sha3.NewLegacyKeccak256()
+		hash.Write(x.Bytes)
+		return "0x" + hex.EncodeToString(hash.Sum(nil)[12:])
+	}
+
+	return hex.EncodeToString(x.Bytes)
+}
+
+// SecretKey is a NEAR secret key, Bytes being the 64 bytes (seed followed by public key) ed25519
+// private key or the 32 bytes secp256k1 scalar.
+type SecretKey struct {
+	Type  CurveKind
+	Bytes []byte
+}
+
+// ParseSecretKey parses a NEAR secret key string, ex: `ed25519:<base58>`
+func ParseSecretKey(in string) (*SecretKey, error) {
+	curve, content, err := parseKeyString(in)
+	if err != nil {
+		return nil, err
+	}
+
+	expectedLen := ed25519.PrivateKeySize
+	if curve == CurveKind_SECP256K1 {
+		expectedLen = secp256k1.PrivKeyBytesLen
+	}
+
+	if len(content) != expectedLen {
+		return nil, fmt.Errorf("invalid %s secret key length %d, expected %d", curvePrefix(curve), len(content), expectedLen)
+	}
+
+	key := &SecretKey{Type: curve, Bytes: content}
+	if curve == CurveKind_ED25519 && !bytes.Equal(key.PublicKey().Bytes, content[ed25519.SeedSize:]) {
+		return nil, fmt.Errorf("invalid ed25519 secret key, its public key part does not match its seed part")
+	}
+
+	return key, nil
+}
+
+// GenerateSecretKey generates a new random secret key on the curve, using rand as entropy source
+func GenerateSecretKey(curve CurveKind, rand io.Reader) (*SecretKey, error) {
+	if curve == CurveKind_SECP256K1 {
+		privateKey, err := secp256k1.GeneratePrivateKeyFromRand(rand)
+		if err != nil {
+			return nil, fmt.Errorf("generate secp256k1 key: %w", err)
+		}
+		return &SecretKey{Type: curve, Bytes: privateKey.Serialize()}, nil
+	}
+
+	_, privateKey, err := ed25519.GenerateKey(rand)
+	if err != nil {
+		return nil, fmt.Errorf("generate ed25519 key: %w", err)
+	}
+	return &SecretKey{Type: curve, Bytes: privateKey}, nil
+}
+
+// SecretKeyFromSeed deterministically derives an ed25519 secret key from seed the same way
+// near-crypto's `SecretKey::from_seed` does (and so `neard init --test-seed`): the first 32
+// bytes of the seed, padded with spaces, are the ed25519 seed.
+func SecretKeyFromSeed(seed string) *SecretKey {
+	keySeed := []byte(strings.Repeat(" ", ed25519.SeedSize))
+	copy(keySeed, seed)
+
+	return &SecretKey{Type: CurveKind_ED25519, Bytes: ed25519.NewKeyFromSeed(keySeed)}
+}
+
+// PublicKey computes the public key of the secret key
+func (k *SecretKey) PublicKey() *PublicKey {
+	if k.Type == CurveKind_SECP256K1 {
+		uncompressed := secp256k1.PrivKeyFromBytes(k.Bytes).PubKey().SerializeUncompressed()
+		return &PublicKey{Type: k.Type, Bytes: uncompressed[1:]}
+	}
+
+	derived := ed25519.NewKeyFromSeed(k.Bytes[:ed25519.SeedSize])
+	return &PublicKey{Type: k.Type, Bytes: []byte(derived.Public().(ed25519.PublicKey))}
+}
+
+// AsKeyString returns the NEAR string representation of the key, ex: `ed25519:<base58>`
+func (k *SecretKey) AsKeyString() string {
+	return curvePrefix(k.Type) + ":" + base58.Encode(k.Bytes)
+}
+
+// Sign signs data with the secret key. As in near-crypto, secp256k1 keys sign a 32 bytes digest,
+// data being the digest.
+func (k *SecretKey) Sign(data []byte) (*Signature, error) {
+	if k.Type == CurveKind_SECP256K1 {
+		if len(data) != 32 {
+			return nil, fmt.Errorf("secp256k1 keys sign 32 bytes digests, got %d bytes", len(data))
+		}
+
+		// The compact signature is `27 + v` (+ 4 for compressed keys) followed by `r` and `s`
+		compact := ecdsa.SignCompact(secp256k1.PrivKeyFromBytes(k.Bytes), data, false)
+		return &Signature{Type: k.Type, Bytes: append(compact[1:], compact[0]-27)}, nil
+	}
+
+	return &Signature{Type: k.Type, Bytes: ed25519.Sign(ed25519.PrivateKey(k.Bytes), data)}, nil
+}
+
+// Verify checks that signature is the signature of data by the key. As in near-crypto,
+// secp256k1 signatures are the signature of a 32 bytes digest, data being the digest.
+func (x *PublicKey) Verify(data []byte, signature *Signature) bool {
+	if signature.GetType() != x.GetType() {
+		return false
+	}
+
+	if x.GetType() == CurveKind_SECP256K1 {
+		if len(data) != 32 || len(signature.Bytes) != secp256k1SignatureLen || len(x.Bytes) != secp256k1PublicKeyLen || signature.Bytes[64] > 3 {
+			return false
+		}
+
+		compact := append([]byte{27 + signature.Bytes[64]}, signature.Bytes[:64]...)
+		recovered, _, err := ecdsa.RecoverCompact(compact, data)
+		if err != nil {
+			return false
+		}
+		return bytes.Equal(recovered.SerializeUncompressed()[1:], x.Bytes)
+	}
+
+	return len(x.GetBytes()) == ed25519.PublicKeySize && ed25519.Verify(x.Bytes, data, signature.GetBytes())
+}
@@ -0,0 +1,103 @@
+package pbnear
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretKey_RoundTrip(t *testing.T) {
+	for _, curve := range []CurveKind{CurveKind_ED25519, CurveKind_SECP256K1} {
+		t.Run(curve.String(), func(t *testing.T) {
+			secretKey, err := GenerateSecretKey(curve, rand.Reader)
+			require.NoError(t, err)
+
+			parsedSecretKey, err := ParseSecretKey(secretKey.AsKeyString())
+			require.NoError(t, err)
+			assert.Equal(t, secretKey, parsedSecretKey)
+
+			publicKey := secretKey.PublicKey()
+			parsedPublicKey, err := ParsePublicKey(publicKey.AsKeyString())
+			require.NoError(t, err)
+			assert.Equal(t, publicKey.Bytes, parsedPublicKey.Bytes)
+			assert.Equal(t, curve, parsedPublicKey.Type)
+		})
+	}
+}
+
+func TestSecretKey_Sign(t *testing.T) {
+	digest := sha256.Sum256([]byte("approval"))
+
+	for _, curve := range []CurveKind{CurveKind_ED25519, CurveKind_SECP256K1} {
+		t.Run(curve.String(), func(t *testing.T) {
+			secretKey, err := GenerateSecretKey(curve, rand.Reader)
+			require.NoError(t, err)
+
+			signature, err := secretKey.Sign(digest[:])
+			require.NoError(t, err)
+			assert.Equal(t, curve, signature.Type)
+
+			publicKey := secretKey.PublicKey()
+			assert.True(t, publicKey.Verify(digest[:], signature))
+
+			other := sha256.Sum256([]byte("other"))
+			assert.False(t, publicKey.Verify(other[:], signature))
+
+			otherKey, err := GenerateSecretKey(curve, rand.Reader)
+			require.NoError(t, err)
+			assert.False(t, otherKey.PublicKey().Verify(digest[:], signature))
+		})
+	}
+
+	_, err := SecretKeyFromSeed("alice.near").Sign([]byte("not a digest"))
+	require.NoError(t, err)
+
+	secp256k1Key, err := GenerateSecretKey(CurveKind_SECP256K1, rand.Reader)
+	require.NoError(t, err)
+	_, err = secp256k1Key.Sign([]byte("not a digest"))
+	assert.Error(t, err)
+}
+
+func TestSecretKeyFromSeed(t *testing.T) {
+	assert.Equal(t, SecretKeyFromSeed("alice.near"), SecretKeyFromSeed("alice.near"))
+	assert.NotEqual(t, SecretKeyFromSeed("alice.near"), SecretKeyFromSeed("bob.near"))
+
+	// Seeds are truncated to 32 bytes
+	assert.Equal(t, SecretKeyFromSeed(strings.Repeat("a", 32)), SecretKeyFromSeed(strings.Repeat("a", 40)))
+}
+
+func TestParseSecretKey_Invalid(t *testing.T) {
+	secretKey := SecretKeyFromSeed("alice.near")
+
+	corrupted := append([]byte{}, secretKey.Bytes...)
+	corrupted[40] ^= 0xff
+
+	_, err := ParseSecretKey("ed25519:" + base58.Encode(corrupted))
+	assert.Error(t, err)
+
+	_, err = ParseSecretKey("rsa:" + base58.Encode(secretKey.Bytes))
+	assert.Error(t, err)
+
+	_, err = ParseSecretKey("secp256k1:" + base58.Encode(secretKey.Bytes))
+	assert.Error(t, err)
+}
+
+func TestPublicKey_ImplicitAccountID(t *testing.T) {
+	secretKey := SecretKeyFromSeed("alice.near")
+	publicKey := secretKey.PublicKey()
+	assert.Equal(t, hex.EncodeToString(publicKey.Bytes), publicKey.ImplicitAccountID())
+	assert.True(t, IsImplicitAccountID(publicKey.ImplicitAccountID()))
+
+	// The secp256k1 private key 1 controls the well known Ethereum address 0x7e5f4552091a69125d5dfcb7b8c2659029395bdf
+	one := make([]byte, 32)
+	one[31] = 1
+	ethKey := (&SecretKey{Type: CurveKind_SECP256K1, Bytes: one}).PublicKey()
+	assert.Equal(t, "0x7e5f4552091a69125d5dfcb7b8c2659029395bdf", ethKey.ImplicitAccountID())
+	assert.True(t, IsEthImplicitAccountID(ethKey.ImplicitAccountID()))
+}
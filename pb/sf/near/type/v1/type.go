@@ -2,6 +2,7 @@ package pbnear
 
 import (
 	"encoding/hex"
+	"math/big"
 	"time"
 
 	"github.com/mr-tron/base58"
@@ -27,12 +28,39 @@ func (x *Block) Time() time.Time {
 	return time.Unix(0, int64(x.Header.TimestampNanosec)).UTC()
 }
 
+// AsString returns the hex encoding of the hash, a nil hash being the empty string.
 func (x *CryptoHash) AsString() string {
-	return hex.EncodeToString(x.Bytes)
+	return hex.EncodeToString(x.GetBytes())
 }
 
+// AsBase58String returns the base58 encoding of the hash, a nil hash being the empty string.
 func (x *CryptoHash) AsBase58String() string {
-	return base58.Encode(x.Bytes)
+	return base58.Encode(x.GetBytes())
+}
+
+// AsBigInt returns the value as a [big.Int], the bytes being the big-endian representation of
+// the (u128) amount as produced by the indexer. A nil BigInt is zero.
+func (x *BigInt) AsBigInt() *big.Int {
+	return new(big.Int).SetBytes(x.GetBytes())
+}
+
+// CausingHash returns the hash of the transaction or receipt that caused the state change, nil
+// if the cause is not attributable to a transaction or a receipt.
+func (x *StateChangeCause) CausingHash() *CryptoHash {
+	switch c := x.GetCause().(type) {
+	case *StateChangeCause_TransactionProcessing_:
+		return c.TransactionProcessing.GetTxHash()
+	case *StateChangeCause_ActionReceiptProcessingStarted_:
+		return c.ActionReceiptProcessingStarted.GetReceiptHash()
+	case *StateChangeCause_ActionReceiptGasReward_:
+		return c.ActionReceiptGasReward.GetTxHash()
+	case *StateChangeCause_ReceiptProcessing_:
+		return c.ReceiptProcessing.GetTxHash()
+	case *StateChangeCause_PostponedReceipt_:
+		return c.PostponedReceipt.GetTxHash()
+	}
+
+	return nil
 }
 
 // firecore.Block implementation (mostly forwarding to existing methods)
@@ -183,6 +183,7 @@ const (
 	ReceiptValidationError_ReturnedValueLengthExceeded         ReceiptValidationError = 4
 	ReceiptValidationError_NumberInputDataDependenciesExceeded ReceiptValidationError = 5
 	ReceiptValidationError_ActionsValidationError              ReceiptValidationError = 6
+	ReceiptValidationError_ReceiptSizeExceeded                 ReceiptValidationError = 7
 )
 
 // Enum value maps for ReceiptValidationError.
@@ -195,6 +196,7 @@ var (
 		4: "ReturnedValueLengthExceeded",
 		5: "NumberInputDataDependenciesExceeded",
 		6: "ActionsValidationError",
+		7: "ReceiptSizeExceeded",
 	}
 	ReceiptValidationError_value = map[string]int32{
 		"InvalidPredecessorId":                0,
@@ -204,6 +206,7 @@ var (
 		"ReturnedValueLengthExceeded":         4,
 		"NumberInputDataDependenciesExceeded": 5,
 		"ActionsValidationError":              6,
+		"ReceiptSizeExceeded":                 7,
 	}
 )
 
@@ -237,20 +240,24 @@ func (ReceiptValidationError) EnumDescriptor() ([]byte, []int) {
 type InvalidTxError int32
 
 const (
-	InvalidTxError_InvalidAccessKeyError   InvalidTxError = 0
-	InvalidTxError_InvalidSignerId         InvalidTxError = 1
-	InvalidTxError_SignerDoesNotExist      InvalidTxError = 2
-	InvalidTxError_InvalidNonce            InvalidTxError = 3
-	InvalidTxError_NonceTooLarge           InvalidTxError = 4
-	InvalidTxError_InvalidReceiverId       InvalidTxError = 5
-	InvalidTxError_InvalidSignature        InvalidTxError = 6
-	InvalidTxError_NotEnoughBalance        InvalidTxError = 7
-	InvalidTxError_LackBalanceForState     InvalidTxError = 8
-	InvalidTxError_CostOverflow            InvalidTxError = 9
-	InvalidTxError_InvalidChain            InvalidTxError = 10
-	InvalidTxError_Expired                 InvalidTxError = 11
-	InvalidTxError_ActionsValidation       InvalidTxError = 12
-	InvalidTxError_TransactionSizeExceeded InvalidTxError = 13
+	InvalidTxError_InvalidAccessKeyError     InvalidTxError = 0
+	InvalidTxError_InvalidSignerId           InvalidTxError = 1
+	InvalidTxError_SignerDoesNotExist        InvalidTxError = 2
+	InvalidTxError_InvalidNonce              InvalidTxError = 3
+	InvalidTxError_NonceTooLarge             InvalidTxError = 4
+	InvalidTxError_InvalidReceiverId         InvalidTxError = 5
+	InvalidTxError_InvalidSignature          InvalidTxError = 6
+	InvalidTxError_NotEnoughBalance          InvalidTxError = 7
+	InvalidTxError_LackBalanceForState       InvalidTxError = 8
+	InvalidTxError_CostOverflow              InvalidTxError = 9
+	InvalidTxError_InvalidChain              InvalidTxError = 10
+	InvalidTxError_Expired                   InvalidTxError = 11
+	InvalidTxError_ActionsValidation         InvalidTxError = 12
+	InvalidTxError_TransactionSizeExceeded   InvalidTxError = 13
+	InvalidTxError_InvalidTransactionVersion InvalidTxError = 14
+	InvalidTxError_StorageError              InvalidTxError = 15
+	InvalidTxError_ShardCongested            InvalidTxError = 16
+	InvalidTxError_ShardStuck                InvalidTxError = 17
 )
 
 // Enum value maps for InvalidTxError.
@@ -270,22 +277,30 @@ var (
 		11: "Expired",
 		12: "ActionsValidation",
 		13: "TransactionSizeExceeded",
+		14: "InvalidTransactionVersion",
+		15: "StorageError",
+		16: "ShardCongested",
+		17: "ShardStuck",
 	}
 	InvalidTxError_value = map[string]int32{
-		"InvalidAccessKeyError":   0,
-		"InvalidSignerId":         1,
-		"SignerDoesNotExist":      2,
-		"InvalidNonce":            3,
-		"NonceTooLarge":           4,
-		"InvalidReceiverId":       5,
-		"InvalidSignature":        6,
-		"NotEnoughBalance":        7,
-		"LackBalanceForState":     8,
-		"CostOverflow":            9,
-		"InvalidChain":            10,
-		"Expired":                 11,
-		"ActionsValidation":       12,
-		"TransactionSizeExceeded": 13,
+		"InvalidAccessKeyError":     0,
+		"InvalidSignerId":           1,
+		"SignerDoesNotExist":        2,
+		"InvalidNonce":              3,
+		"NonceTooLarge":             4,
+		"InvalidReceiverId":         5,
+		"InvalidSignature":          6,
+		"NotEnoughBalance":          7,
+		"LackBalanceForState":       8,
+		"CostOverflow":              9,
+		"InvalidChain":              10,
+		"Expired":                   11,
+		"ActionsValidation":         12,
+		"TransactionSizeExceeded":   13,
+		"InvalidTransactionVersion": 14,
+		"StorageError":              15,
+		"ShardCongested":            16,
+		"ShardStuck":                17,
 	}
 )
 
@@ -2472,6 +2487,10 @@ type ExecutionOutcomeWithId struct {
 	BlockHash *CryptoHash       `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
 	Id        *CryptoHash       `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
 	Outcome   *ExecutionOutcome `protobuf:"bytes,4,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	// Only set when `proof` has been stripped by the `sf.near.transform.v1.StripPayloads` transform, the
+	// sha256 of the stripped `proof` (deterministic Protobuf encoding) and its size in bytes.
+	ProofHash *CryptoHash `protobuf:"bytes,5,opt,name=proof_hash,json=proofHash,proto3" json:"proof_hash,omitempty"`
+	ProofSize uint64      `protobuf:"varint,6,opt,name=proof_size,json=proofSize,proto3" json:"proof_size,omitempty"`
 }
 
 func (x *ExecutionOutcomeWithId) Reset() {
@@ -2534,6 +2553,20 @@ func (x *ExecutionOutcomeWithId) GetOutcome() *ExecutionOutcome {
 	return nil
 }
 
+func (x *ExecutionOutcomeWithId) GetProofHash() *CryptoHash {
+	if x != nil {
+		return x.ProofHash
+	}
+	return nil
+}
+
+func (x *ExecutionOutcomeWithId) GetProofSize() uint64 {
+	if x != nil {
+		return x.ProofSize
+	}
+	return 0
+}
+
 type ExecutionOutcome struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2925,6 +2958,13 @@ type ActionError struct {
 	//	*ActionError_NewReceiptValidation
 	//	*ActionError_OnlyImplicitAccountCreationAllowed
 	//	*ActionError_DeleteAccountWithLargeState
+	//	*ActionError_DelegateActionInvalidSignature
+	//	*ActionError_DelegateActionSenderDoesNotMatchTxReceiver
+	//	*ActionError_DelegateActionExpired
+	//	*ActionError_DelegateActionAccessKeyError
+	//	*ActionError_DelegateActionInvalidNonce
+	//	*ActionError_DelegateActionNonceTooLarge
+	//	*ActionError_NonRefundableTransferToExistingAccount
 	Kind isActionError_Kind `protobuf_oneof:"kind"`
 }
 
@@ -3086,6 +3126,55 @@ func (x *ActionError) GetDeleteAccountWithLargeState() *DeleteAccountWithLargeSt
 	return nil
 }
 
+func (x *ActionError) GetDelegateActionInvalidSignature() *DelegateActionInvalidSignatureKind {
+	if x, ok := x.GetKind().(*ActionError_DelegateActionInvalidSignature); ok {
+		return x.DelegateActionInvalidSignature
+	}
+	return nil
+}
+
+func (x *ActionError) GetDelegateActionSenderDoesNotMatchTxReceiver() *DelegateActionSenderDoesNotMatchTxReceiverKind {
+	if x, ok := x.GetKind().(*ActionError_DelegateActionSenderDoesNotMatchTxReceiver); ok {
+		return x.DelegateActionSenderDoesNotMatchTxReceiver
+	}
+	return nil
+}
+
+func (x *ActionError) GetDelegateActionExpired() *DelegateActionExpiredKind {
+	if x, ok := x.GetKind().(*ActionError_DelegateActionExpired); ok {
+		return x.DelegateActionExpired
+	}
+	return nil
+}
+
+func (x *ActionError) GetDelegateActionAccessKeyError() *DelegateActionAccessKeyErrorKind {
+	if x, ok := x.GetKind().(*ActionError_DelegateActionAccessKeyError); ok {
+		return x.DelegateActionAccessKeyError
+	}
+	return nil
+}
+
+func (x *ActionError) GetDelegateActionInvalidNonce() *DelegateActionInvalidNonceKind {
+	if x, ok := x.GetKind().(*ActionError_DelegateActionInvalidNonce); ok {
+		return x.DelegateActionInvalidNonce
+	}
+	return nil
+}
+
+func (x *ActionError) GetDelegateActionNonceTooLarge() *DelegateActionNonceTooLargeKind {
+	if x, ok := x.GetKind().(*ActionError_DelegateActionNonceTooLarge); ok {
+		return x.DelegateActionNonceTooLarge
+	}
+	return nil
+}
+
+func (x *ActionError) GetNonRefundableTransferToExistingAccount() *NonRefundableTransferToExistingAccountKind {
+	if x, ok := x.GetKind().(*ActionError_NonRefundableTransferToExistingAccount); ok {
+		return x.NonRefundableTransferToExistingAccount
+	}
+	return nil
+}
+
 type isActionError_Kind interface {
 	isActionError_Kind()
 }
@@ -3154,6 +3243,34 @@ type ActionError_DeleteAccountWithLargeState struct {
 	DeleteAccountWithLargeState *DeleteAccountWithLargeStateErrorKind `protobuf:"bytes,36,opt,name=delete_account_with_large_state,json=deleteAccountWithLargeState,proto3,oneof"`
 }
 
+type ActionError_DelegateActionInvalidSignature struct {
+	DelegateActionInvalidSignature *DelegateActionInvalidSignatureKind `protobuf:"bytes,37,opt,name=delegate_action_invalid_signature,json=delegateActionInvalidSignature,proto3,oneof"`
+}
+
+type ActionError_DelegateActionSenderDoesNotMatchTxReceiver struct {
+	DelegateActionSenderDoesNotMatchTxReceiver *DelegateActionSenderDoesNotMatchTxReceiverKind `protobuf:"bytes,38,opt,name=delegate_action_sender_does_not_match_tx_receiver,json=delegateActionSenderDoesNotMatchTxReceiver,proto3,oneof"`
+}
+
+type ActionError_DelegateActionExpired struct {
+	DelegateActionExpired *DelegateActionExpiredKind `protobuf:"bytes,39,opt,name=delegate_action_expired,json=delegateActionExpired,proto3,oneof"`
+}
+
+type ActionError_DelegateActionAccessKeyError struct {
+	DelegateActionAccessKeyError *DelegateActionAccessKeyErrorKind `protobuf:"bytes,40,opt,name=delegate_action_access_key_error,json=delegateActionAccessKeyError,proto3,oneof"`
+}
+
+type ActionError_DelegateActionInvalidNonce struct {
+	DelegateActionInvalidNonce *DelegateActionInvalidNonceKind `protobuf:"bytes,41,opt,name=delegate_action_invalid_nonce,json=delegateActionInvalidNonce,proto3,oneof"`
+}
+
+type ActionError_DelegateActionNonceTooLarge struct {
+	DelegateActionNonceTooLarge *DelegateActionNonceTooLargeKind `protobuf:"bytes,42,opt,name=delegate_action_nonce_too_large,json=delegateActionNonceTooLarge,proto3,oneof"`
+}
+
+type ActionError_NonRefundableTransferToExistingAccount struct {
+	NonRefundableTransferToExistingAccount *NonRefundableTransferToExistingAccountKind `protobuf:"bytes,43,opt,name=non_refundable_transfer_to_existing_account,json=nonRefundableTransferToExistingAccount,proto3,oneof"`
+}
+
 func (*ActionError_AccountAlreadyExist) isActionError_Kind() {}
 
 func (*ActionError_AccountDoesNotExist) isActionError_Kind() {}
@@ -3186,6 +3303,20 @@ func (*ActionError_OnlyImplicitAccountCreationAllowed) isActionError_Kind() {}
 
 func (*ActionError_DeleteAccountWithLargeState) isActionError_Kind() {}
 
+func (*ActionError_DelegateActionInvalidSignature) isActionError_Kind() {}
+
+func (*ActionError_DelegateActionSenderDoesNotMatchTxReceiver) isActionError_Kind() {}
+
+func (*ActionError_DelegateActionExpired) isActionError_Kind() {}
+
+func (*ActionError_DelegateActionAccessKeyError) isActionError_Kind() {}
+
+func (*ActionError_DelegateActionInvalidNonce) isActionError_Kind() {}
+
+func (*ActionError_DelegateActionNonceTooLarge) isActionError_Kind() {}
+
+func (*ActionError_NonRefundableTransferToExistingAccount) isActionError_Kind() {}
+
 type AccountAlreadyExistsErrorKind struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3280,7 +3411,7 @@ func (x *AccountDoesNotExistErrorKind) GetAccountId() string {
 	return ""
 }
 
-/// A top-level account ID can only be created by registrar.
+// / A top-level account ID can only be created by registrar.
 type CreateAccountOnlyByRegistrarErrorKind struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -4035,16 +4166,14 @@ func (x *DeleteAccountWithLargeStateErrorKind) GetAccountId() string {
 	return ""
 }
 
-type MerklePath struct {
+type DelegateActionInvalidSignatureKind struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Path []*MerklePathItem `protobuf:"bytes,1,rep,name=path,proto3" json:"path,omitempty"`
 }
 
-func (x *MerklePath) Reset() {
-	*x = MerklePath{}
+func (x *DelegateActionInvalidSignatureKind) Reset() {
+	*x = DelegateActionInvalidSignatureKind{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sf_near_type_v1_type_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4052,13 +4181,13 @@ func (x *MerklePath) Reset() {
 	}
 }
 
-func (x *MerklePath) String() string {
+func (x *DelegateActionInvalidSignatureKind) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MerklePath) ProtoMessage() {}
+func (*DelegateActionInvalidSignatureKind) ProtoMessage() {}
 
-func (x *MerklePath) ProtoReflect() protoreflect.Message {
+func (x *DelegateActionInvalidSignatureKind) ProtoReflect() protoreflect.Message {
 	mi := &file_sf_near_type_v1_type_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4070,29 +4199,22 @@ func (x *MerklePath) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MerklePath.ProtoReflect.Descriptor instead.
-func (*MerklePath) Descriptor() ([]byte, []int) {
+// Deprecated: Use DelegateActionInvalidSignatureKind.ProtoReflect.Descriptor instead.
+func (*DelegateActionInvalidSignatureKind) Descriptor() ([]byte, []int) {
 	return file_sf_near_type_v1_type_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *MerklePath) GetPath() []*MerklePathItem {
-	if x != nil {
-		return x.Path
-	}
-	return nil
-}
-
-type MerklePathItem struct {
+type DelegateActionSenderDoesNotMatchTxReceiverKind struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hash      *CryptoHash `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	Direction Direction   `protobuf:"varint,2,opt,name=direction,proto3,enum=sf.near.type.v1.Direction" json:"direction,omitempty"`
+	SenderId   string `protobuf:"bytes,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	ReceiverId string `protobuf:"bytes,2,opt,name=receiver_id,json=receiverId,proto3" json:"receiver_id,omitempty"`
 }
 
-func (x *MerklePathItem) Reset() {
-	*x = MerklePathItem{}
+func (x *DelegateActionSenderDoesNotMatchTxReceiverKind) Reset() {
+	*x = DelegateActionSenderDoesNotMatchTxReceiverKind{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sf_near_type_v1_type_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4100,13 +4222,13 @@ func (x *MerklePathItem) Reset() {
 	}
 }
 
-func (x *MerklePathItem) String() string {
+func (x *DelegateActionSenderDoesNotMatchTxReceiverKind) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MerklePathItem) ProtoMessage() {}
+func (*DelegateActionSenderDoesNotMatchTxReceiverKind) ProtoMessage() {}
 
-func (x *MerklePathItem) ProtoReflect() protoreflect.Message {
+func (x *DelegateActionSenderDoesNotMatchTxReceiverKind) ProtoReflect() protoreflect.Message {
 	mi := &file_sf_near_type_v1_type_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4118,44 +4240,33 @@ func (x *MerklePathItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MerklePathItem.ProtoReflect.Descriptor instead.
-func (*MerklePathItem) Descriptor() ([]byte, []int) {
+// Deprecated: Use DelegateActionSenderDoesNotMatchTxReceiverKind.ProtoReflect.Descriptor instead.
+func (*DelegateActionSenderDoesNotMatchTxReceiverKind) Descriptor() ([]byte, []int) {
 	return file_sf_near_type_v1_type_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *MerklePathItem) GetHash() *CryptoHash {
+func (x *DelegateActionSenderDoesNotMatchTxReceiverKind) GetSenderId() string {
 	if x != nil {
-		return x.Hash
+		return x.SenderId
 	}
-	return nil
+	return ""
 }
 
-func (x *MerklePathItem) GetDirection() Direction {
+func (x *DelegateActionSenderDoesNotMatchTxReceiverKind) GetReceiverId() string {
 	if x != nil {
-		return x.Direction
+		return x.ReceiverId
 	}
-	return Direction_left
+	return ""
 }
 
-type Action struct {
+type DelegateActionExpiredKind struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Types that are assignable to Action:
-	//	*Action_CreateAccount
-	//	*Action_DeployContract
-	//	*Action_FunctionCall
-	//	*Action_Transfer
-	//	*Action_Stake
-	//	*Action_AddKey
-	//	*Action_DeleteKey
-	//	*Action_DeleteAccount
-	Action isAction_Action `protobuf_oneof:"action"`
 }
 
-func (x *Action) Reset() {
-	*x = Action{}
+func (x *DelegateActionExpiredKind) Reset() {
+	*x = DelegateActionExpiredKind{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sf_near_type_v1_type_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4163,13 +4274,13 @@ func (x *Action) Reset() {
 	}
 }
 
-func (x *Action) String() string {
+func (x *DelegateActionExpiredKind) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Action) ProtoMessage() {}
+func (*DelegateActionExpiredKind) ProtoMessage() {}
 
-func (x *Action) ProtoReflect() protoreflect.Message {
+func (x *DelegateActionExpiredKind) ProtoReflect() protoreflect.Message {
 	mi := &file_sf_near_type_v1_type_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4181,134 +4292,21 @@ func (x *Action) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Action.ProtoReflect.Descriptor instead.
-func (*Action) Descriptor() ([]byte, []int) {
+// Deprecated: Use DelegateActionExpiredKind.ProtoReflect.Descriptor instead.
+func (*DelegateActionExpiredKind) Descriptor() ([]byte, []int) {
 	return file_sf_near_type_v1_type_proto_rawDescGZIP(), []int{49}
 }
 
-func (m *Action) GetAction() isAction_Action {
-	if m != nil {
-		return m.Action
-	}
-	return nil
-}
-
-func (x *Action) GetCreateAccount() *CreateAccountAction {
-	if x, ok := x.GetAction().(*Action_CreateAccount); ok {
-		return x.CreateAccount
-	}
-	return nil
-}
-
-func (x *Action) GetDeployContract() *DeployContractAction {
-	if x, ok := x.GetAction().(*Action_DeployContract); ok {
-		return x.DeployContract
-	}
-	return nil
-}
-
-func (x *Action) GetFunctionCall() *FunctionCallAction {
-	if x, ok := x.GetAction().(*Action_FunctionCall); ok {
-		return x.FunctionCall
-	}
-	return nil
-}
-
-func (x *Action) GetTransfer() *TransferAction {
-	if x, ok := x.GetAction().(*Action_Transfer); ok {
-		return x.Transfer
-	}
-	return nil
-}
-
-func (x *Action) GetStake() *StakeAction {
-	if x, ok := x.GetAction().(*Action_Stake); ok {
-		return x.Stake
-	}
-	return nil
-}
-
-func (x *Action) GetAddKey() *AddKeyAction {
-	if x, ok := x.GetAction().(*Action_AddKey); ok {
-		return x.AddKey
-	}
-	return nil
-}
-
-func (x *Action) GetDeleteKey() *DeleteKeyAction {
-	if x, ok := x.GetAction().(*Action_DeleteKey); ok {
-		return x.DeleteKey
-	}
-	return nil
-}
-
-func (x *Action) GetDeleteAccount() *DeleteAccountAction {
-	if x, ok := x.GetAction().(*Action_DeleteAccount); ok {
-		return x.DeleteAccount
-	}
-	return nil
-}
-
-type isAction_Action interface {
-	isAction_Action()
-}
-
-type Action_CreateAccount struct {
-	CreateAccount *CreateAccountAction `protobuf:"bytes,1,opt,name=create_account,json=createAccount,proto3,oneof"`
-}
-
-type Action_DeployContract struct {
-	DeployContract *DeployContractAction `protobuf:"bytes,2,opt,name=deploy_contract,json=deployContract,proto3,oneof"`
-}
-
-type Action_FunctionCall struct {
-	FunctionCall *FunctionCallAction `protobuf:"bytes,3,opt,name=function_call,json=functionCall,proto3,oneof"`
-}
-
-type Action_Transfer struct {
-	Transfer *TransferAction `protobuf:"bytes,4,opt,name=transfer,proto3,oneof"`
-}
-
-type Action_Stake struct {
-	Stake *StakeAction `protobuf:"bytes,5,opt,name=stake,proto3,oneof"`
-}
-
-type Action_AddKey struct {
-	AddKey *AddKeyAction `protobuf:"bytes,6,opt,name=add_key,json=addKey,proto3,oneof"`
-}
-
-type Action_DeleteKey struct {
-	DeleteKey *DeleteKeyAction `protobuf:"bytes,7,opt,name=delete_key,json=deleteKey,proto3,oneof"`
-}
-
-type Action_DeleteAccount struct {
-	DeleteAccount *DeleteAccountAction `protobuf:"bytes,8,opt,name=delete_account,json=deleteAccount,proto3,oneof"`
-}
-
-func (*Action_CreateAccount) isAction_Action() {}
-
-func (*Action_DeployContract) isAction_Action() {}
-
-func (*Action_FunctionCall) isAction_Action() {}
-
-func (*Action_Transfer) isAction_Action() {}
-
-func (*Action_Stake) isAction_Action() {}
-
-func (*Action_AddKey) isAction_Action() {}
-
-func (*Action_DeleteKey) isAction_Action() {}
-
-func (*Action_DeleteAccount) isAction_Action() {}
-
-type CreateAccountAction struct {
+type DelegateActionAccessKeyErrorKind struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Error InvalidTxError `protobuf:"varint,1,opt,name=error,proto3,enum=sf.near.type.v1.InvalidTxError" json:"error,omitempty"` // InvalidAccessKeyError
 }
 
-func (x *CreateAccountAction) Reset() {
-	*x = CreateAccountAction{}
+func (x *DelegateActionAccessKeyErrorKind) Reset() {
+	*x = DelegateActionAccessKeyErrorKind{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sf_near_type_v1_type_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4316,13 +4314,13 @@ func (x *CreateAccountAction) Reset() {
 	}
 }
 
-func (x *CreateAccountAction) String() string {
+func (x *DelegateActionAccessKeyErrorKind) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAccountAction) ProtoMessage() {}
+func (*DelegateActionAccessKeyErrorKind) ProtoMessage() {}
 
-func (x *CreateAccountAction) ProtoReflect() protoreflect.Message {
+func (x *DelegateActionAccessKeyErrorKind) ProtoReflect() protoreflect.Message {
 	mi := &file_sf_near_type_v1_type_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4334,21 +4332,29 @@ func (x *CreateAccountAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAccountAction.ProtoReflect.Descriptor instead.
-func (*CreateAccountAction) Descriptor() ([]byte, []int) {
+// Deprecated: Use DelegateActionAccessKeyErrorKind.ProtoReflect.Descriptor instead.
+func (*DelegateActionAccessKeyErrorKind) Descriptor() ([]byte, []int) {
 	return file_sf_near_type_v1_type_proto_rawDescGZIP(), []int{50}
 }
 
-type DeployContractAction struct {
+func (x *DelegateActionAccessKeyErrorKind) GetError() InvalidTxError {
+	if x != nil {
+		return x.Error
+	}
+	return InvalidTxError_InvalidAccessKeyError
+}
+
+type DelegateActionInvalidNonceKind struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code []byte `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	DelegateNonce uint64 `protobuf:"varint,1,opt,name=delegate_nonce,json=delegateNonce,proto3" json:"delegate_nonce,omitempty"`
+	AkNonce       uint64 `protobuf:"varint,2,opt,name=ak_nonce,json=akNonce,proto3" json:"ak_nonce,omitempty"`
 }
 
-func (x *DeployContractAction) Reset() {
-	*x = DeployContractAction{}
+func (x *DelegateActionInvalidNonceKind) Reset() {
+	*x = DelegateActionInvalidNonceKind{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sf_near_type_v1_type_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4356,13 +4362,13 @@ func (x *DeployContractAction) Reset() {
 	}
 }
 
-func (x *DeployContractAction) String() string {
+func (x *DelegateActionInvalidNonceKind) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeployContractAction) ProtoMessage() {}
+func (*DelegateActionInvalidNonceKind) ProtoMessage() {}
 
-func (x *DeployContractAction) ProtoReflect() protoreflect.Message {
+func (x *DelegateActionInvalidNonceKind) ProtoReflect() protoreflect.Message {
 	mi := &file_sf_near_type_v1_type_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4374,16 +4380,508 @@ func (x *DeployContractAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeployContractAction.ProtoReflect.Descriptor instead.
-func (*DeployContractAction) Descriptor() ([]byte, []int) {
+// Deprecated: Use DelegateActionInvalidNonceKind.ProtoReflect.Descriptor instead.
+func (*DelegateActionInvalidNonceKind) Descriptor() ([]byte, []int) {
 	return file_sf_near_type_v1_type_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *DeployContractAction) GetCode() []byte {
+func (x *DelegateActionInvalidNonceKind) GetDelegateNonce() uint64 {
 	if x != nil {
-		return x.Code
+		return x.DelegateNonce
 	}
-	return nil
+	return 0
+}
+
+func (x *DelegateActionInvalidNonceKind) GetAkNonce() uint64 {
+	if x != nil {
+		return x.AkNonce
+	}
+	return 0
+}
+
+type DelegateActionNonceTooLargeKind struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DelegateNonce uint64 `protobuf:"varint,1,opt,name=delegate_nonce,json=delegateNonce,proto3" json:"delegate_nonce,omitempty"`
+	UpperBound    uint64 `protobuf:"varint,2,opt,name=upper_bound,json=upperBound,proto3" json:"upper_bound,omitempty"`
+}
+
+func (x *DelegateActionNonceTooLargeKind) Reset() {
+	*x = DelegateActionNonceTooLargeKind{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_near_type_v1_type_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelegateActionNonceTooLargeKind) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelegateActionNonceTooLargeKind) ProtoMessage() {}
+
+func (x *DelegateActionNonceTooLargeKind) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_near_type_v1_type_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelegateActionNonceTooLargeKind.ProtoReflect.Descriptor instead.
+func (*DelegateActionNonceTooLargeKind) Descriptor() ([]byte, []int) {
+	return file_sf_near_type_v1_type_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DelegateActionNonceTooLargeKind) GetDelegateNonce() uint64 {
+	if x != nil {
+		return x.DelegateNonce
+	}
+	return 0
+}
+
+func (x *DelegateActionNonceTooLargeKind) GetUpperBound() uint64 {
+	if x != nil {
+		return x.UpperBound
+	}
+	return 0
+}
+
+type NonRefundableTransferToExistingAccountKind struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+}
+
+func (x *NonRefundableTransferToExistingAccountKind) Reset() {
+	*x = NonRefundableTransferToExistingAccountKind{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_near_type_v1_type_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NonRefundableTransferToExistingAccountKind) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NonRefundableTransferToExistingAccountKind) ProtoMessage() {}
+
+func (x *NonRefundableTransferToExistingAccountKind) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_near_type_v1_type_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NonRefundableTransferToExistingAccountKind.ProtoReflect.Descriptor instead.
+func (*NonRefundableTransferToExistingAccountKind) Descriptor() ([]byte, []int) {
+	return file_sf_near_type_v1_type_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *NonRefundableTransferToExistingAccountKind) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+type MerklePath struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path []*MerklePathItem `protobuf:"bytes,1,rep,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *MerklePath) Reset() {
+	*x = MerklePath{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_near_type_v1_type_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MerklePath) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MerklePath) ProtoMessage() {}
+
+func (x *MerklePath) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_near_type_v1_type_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MerklePath.ProtoReflect.Descriptor instead.
+func (*MerklePath) Descriptor() ([]byte, []int) {
+	return file_sf_near_type_v1_type_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *MerklePath) GetPath() []*MerklePathItem {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+type MerklePathItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash      *CryptoHash `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Direction Direction   `protobuf:"varint,2,opt,name=direction,proto3,enum=sf.near.type.v1.Direction" json:"direction,omitempty"`
+}
+
+func (x *MerklePathItem) Reset() {
+	*x = MerklePathItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_near_type_v1_type_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MerklePathItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MerklePathItem) ProtoMessage() {}
+
+func (x *MerklePathItem) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_near_type_v1_type_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MerklePathItem.ProtoReflect.Descriptor instead.
+func (*MerklePathItem) Descriptor() ([]byte, []int) {
+	return file_sf_near_type_v1_type_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *MerklePathItem) GetHash() *CryptoHash {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *MerklePathItem) GetDirection() Direction {
+	if x != nil {
+		return x.Direction
+	}
+	return Direction_left
+}
+
+type Action struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Action:
+	//	*Action_CreateAccount
+	//	*Action_DeployContract
+	//	*Action_FunctionCall
+	//	*Action_Transfer
+	//	*Action_Stake
+	//	*Action_AddKey
+	//	*Action_DeleteKey
+	//	*Action_DeleteAccount
+	//	*Action_Delegate
+	Action isAction_Action `protobuf_oneof:"action"`
+}
+
+func (x *Action) Reset() {
+	*x = Action{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_near_type_v1_type_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Action) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Action) ProtoMessage() {}
+
+func (x *Action) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_near_type_v1_type_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Action.ProtoReflect.Descriptor instead.
+func (*Action) Descriptor() ([]byte, []int) {
+	return file_sf_near_type_v1_type_proto_rawDescGZIP(), []int{56}
+}
+
+func (m *Action) GetAction() isAction_Action {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+func (x *Action) GetCreateAccount() *CreateAccountAction {
+	if x, ok := x.GetAction().(*Action_CreateAccount); ok {
+		return x.CreateAccount
+	}
+	return nil
+}
+
+func (x *Action) GetDeployContract() *DeployContractAction {
+	if x, ok := x.GetAction().(*Action_DeployContract); ok {
+		return x.DeployContract
+	}
+	return nil
+}
+
+func (x *Action) GetFunctionCall() *FunctionCallAction {
+	if x, ok := x.GetAction().(*Action_FunctionCall); ok {
+		return x.FunctionCall
+	}
+	return nil
+}
+
+func (x *Action) GetTransfer() *TransferAction {
+	if x, ok := x.GetAction().(*Action_Transfer); ok {
+		return x.Transfer
+	}
+	return nil
+}
+
+func (x *Action) GetStake() *StakeAction {
+	if x, ok := x.GetAction().(*Action_Stake); ok {
+		return x.Stake
+	}
+	return nil
+}
+
+func (x *Action) GetAddKey() *AddKeyAction {
+	if x, ok := x.GetAction().(*Action_AddKey); ok {
+		return x.AddKey
+	}
+	return nil
+}
+
+func (x *Action) GetDeleteKey() *DeleteKeyAction {
+	if x, ok := x.GetAction().(*Action_DeleteKey); ok {
+		return x.DeleteKey
+	}
+	return nil
+}
+
+func (x *Action) GetDeleteAccount() *DeleteAccountAction {
+	if x, ok := x.GetAction().(*Action_DeleteAccount); ok {
+		return x.DeleteAccount
+	}
+	return nil
+}
+
+func (x *Action) GetDelegate() *SignedDelegateAction {
+	if x, ok := x.GetAction().(*Action_Delegate); ok {
+		return x.Delegate
+	}
+	return nil
+}
+
+type isAction_Action interface {
+	isAction_Action()
+}
+
+type Action_CreateAccount struct {
+	CreateAccount *CreateAccountAction `protobuf:"bytes,1,opt,name=create_account,json=createAccount,proto3,oneof"`
+}
+
+type Action_DeployContract struct {
+	DeployContract *DeployContractAction `protobuf:"bytes,2,opt,name=deploy_contract,json=deployContract,proto3,oneof"`
+}
+
+type Action_FunctionCall struct {
+	FunctionCall *FunctionCallAction `protobuf:"bytes,3,opt,name=function_call,json=functionCall,proto3,oneof"`
+}
+
+type Action_Transfer struct {
+	Transfer *TransferAction `protobuf:"bytes,4,opt,name=transfer,proto3,oneof"`
+}
+
+type Action_Stake struct {
+	Stake *StakeAction `protobuf:"bytes,5,opt,name=stake,proto3,oneof"`
+}
+
+type Action_AddKey struct {
+	AddKey *AddKeyAction `protobuf:"bytes,6,opt,name=add_key,json=addKey,proto3,oneof"`
+}
+
+type Action_DeleteKey struct {
+	DeleteKey *DeleteKeyAction `protobuf:"bytes,7,opt,name=delete_key,json=deleteKey,proto3,oneof"`
+}
+
+type Action_DeleteAccount struct {
+	DeleteAccount *DeleteAccountAction `protobuf:"bytes,8,opt,name=delete_account,json=deleteAccount,proto3,oneof"`
+}
+
+type Action_Delegate struct {
+	Delegate *SignedDelegateAction `protobuf:"bytes,9,opt,name=delegate,proto3,oneof"`
+}
+
+func (*Action_CreateAccount) isAction_Action() {}
+
+func (*Action_DeployContract) isAction_Action() {}
+
+func (*Action_FunctionCall) isAction_Action() {}
+
+func (*Action_Transfer) isAction_Action() {}
+
+func (*Action_Stake) isAction_Action() {}
+
+func (*Action_AddKey) isAction_Action() {}
+
+func (*Action_DeleteKey) isAction_Action() {}
+
+func (*Action_DeleteAccount) isAction_Action() {}
+
+func (*Action_Delegate) isAction_Action() {}
+
+type CreateAccountAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CreateAccountAction) Reset() {
+	*x = CreateAccountAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_near_type_v1_type_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAccountAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountAction) ProtoMessage() {}
+
+func (x *CreateAccountAction) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_near_type_v1_type_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountAction.ProtoReflect.Descriptor instead.
+func (*CreateAccountAction) Descriptor() ([]byte, []int) {
+	return file_sf_near_type_v1_type_proto_rawDescGZIP(), []int{57}
+}
+
+type DeployContractAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code []byte `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// Only set when `code` has been stripped by the `sf.near.transform.v1.StripPayloads` transform, the
+	// sha256 of the stripped `code` and its size in bytes.
+	CodeHash *CryptoHash `protobuf:"bytes,2,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+	CodeSize uint64      `protobuf:"varint,3,opt,name=code_size,json=codeSize,proto3" json:"code_size,omitempty"`
+}
+
+func (x *DeployContractAction) Reset() {
+	*x = DeployContractAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_near_type_v1_type_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeployContractAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeployContractAction) ProtoMessage() {}
+
+func (x *DeployContractAction) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_near_type_v1_type_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeployContractAction.ProtoReflect.Descriptor instead.
+func (*DeployContractAction) Descriptor() ([]byte, []int) {
+	return file_sf_near_type_v1_type_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *DeployContractAction) GetCode() []byte {
+	if x != nil {
+		return x.Code
+	}
+	return nil
+}
+
+func (x *DeployContractAction) GetCodeHash() *CryptoHash {
+	if x != nil {
+		return x.CodeHash
+	}
+	return nil
+}
+
+func (x *DeployContractAction) GetCodeSize() uint64 {
+	if x != nil {
+		return x.CodeSize
+	}
+	return 0
 }
 
 type FunctionCallAction struct {
@@ -4395,12 +4893,16 @@ type FunctionCallAction struct {
 	Args       []byte  `protobuf:"bytes,2,opt,name=args,proto3" json:"args,omitempty"`
 	Gas        uint64  `protobuf:"varint,3,opt,name=gas,proto3" json:"gas,omitempty"`
 	Deposit    *BigInt `protobuf:"bytes,4,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	// Only set when `args` has been stripped by the `sf.near.transform.v1.StripPayloads` transform, the
+	// sha256 of the stripped `args` and its size in bytes.
+	ArgsHash *CryptoHash `protobuf:"bytes,5,opt,name=args_hash,json=argsHash,proto3" json:"args_hash,omitempty"`
+	ArgsSize uint64      `protobuf:"varint,6,opt,name=args_size,json=argsSize,proto3" json:"args_size,omitempty"`
 }
 
 func (x *FunctionCallAction) Reset() {
 	*x = FunctionCallAction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sf_near_type_v1_type_proto_msgTypes[52]
+		mi := &file_sf_near_type_v1_type_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4413,7 +4915,7 @@ func (x *FunctionCallAction) String() string {
 func (*FunctionCallAction) ProtoMessage() {}
 
 func (x *FunctionCallAction) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_near_type_v1_type_proto_msgTypes[52]
+	mi := &file_sf_near_type_v1_type_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4426,7 +4928,7 @@ func (x *FunctionCallAction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FunctionCallAction.ProtoReflect.Descriptor instead.
 func (*FunctionCallAction) Descriptor() ([]byte, []int) {
-	return file_sf_near_type_v1_type_proto_rawDescGZIP(), []int{52}
+	return file_sf_near_type_v1_type_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *FunctionCallAction) GetMethodName() string {
@@ -4457,6 +4959,20 @@ func (x *FunctionCallAction) GetDeposit() *BigInt {
 	return nil
 }
 
+func (x *FunctionCallAction) GetArgsHash() *CryptoHash {
+	if x != nil {
+		return x.ArgsHash
+	}
+	return nil
+}
+
+func (x *FunctionCallAction) GetArgsSize() uint64 {
+	if x != nil {
+		return x.ArgsSize
+	}
+	return 0
+}
+
 type TransferAction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -4468,7 +4984,7 @@ type TransferAction struct {
 func (x *TransferAction) Reset() {
 	*x = TransferAction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sf_near_type_v1_type_proto_msgTypes[53]
+		mi := &file_sf_near_type_v1_type_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4481,7 +4997,7 @@ func (x *TransferAction) String() string {
 func (*TransferAction) ProtoMessage() {}
 
 func (x *TransferAction) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_near_type_v1_type_proto_msgTypes[53]
+	mi := &file_sf_near_type_v1_type_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4494,7 +5010,7 @@ func (x *TransferAction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransferAction.ProtoReflect.Descriptor instead.
 func (*TransferAction) Descriptor() ([]byte, []int) {
-	return file_sf_near_type_v1_type_proto_rawDescGZIP(), []int{53}
+	return file_sf_near_type_v1_type_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *TransferAction) GetDeposit() *BigInt {
@@ -4516,7 +5032,7 @@ type StakeAction struct {
 func (x *StakeAction) Reset() {
 	*x = StakeAction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sf_near_type_v1_type_proto_msgTypes[54]
+		mi := &file_sf_near_type_v1_type_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4529,7 +5045,7 @@ func (x *StakeAction) String() string {
 func (*StakeAction) ProtoMessage() {}
 
 func (x *StakeAction) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_near_type_v1_type_proto_msgTypes[54]
+	mi := &file_sf_near_type_v1_type_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4542,7 +5058,7 @@ func (x *StakeAction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StakeAction.ProtoReflect.Descriptor instead.
 func (*StakeAction) Descriptor() ([]byte, []int) {
-	return file_sf_near_type_v1_type_proto_rawDescGZIP(), []int{54}
+	return file_sf_near_type_v1_type_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *StakeAction) GetStake() *BigInt {
@@ -4571,20 +5087,121 @@ type AddKeyAction struct {
 func (x *AddKeyAction) Reset() {
 	*x = AddKeyAction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sf_near_type_v1_type_proto_msgTypes[55]
+		mi := &file_sf_near_type_v1_type_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddKeyAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddKeyAction) ProtoMessage() {}
+
+func (x *AddKeyAction) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_near_type_v1_type_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddKeyAction.ProtoReflect.Descriptor instead.
+func (*AddKeyAction) Descriptor() ([]byte, []int) {
+	return file_sf_near_type_v1_type_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *AddKeyAction) GetPublicKey() *PublicKey {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *AddKeyAction) GetAccessKey() *AccessKey {
+	if x != nil {
+		return x.AccessKey
+	}
+	return nil
+}
+
+type DeleteKeyAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKey *PublicKey `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+}
+
+func (x *DeleteKeyAction) Reset() {
+	*x = DeleteKeyAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_near_type_v1_type_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteKeyAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteKeyAction) ProtoMessage() {}
+
+func (x *DeleteKeyAction) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_near_type_v1_type_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteKeyAction.ProtoReflect.Descriptor instead.
+func (*DeleteKeyAction) Descriptor() ([]byte, []int) {
+	return file_sf_near_type_v1_type_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DeleteKeyAction) GetPublicKey() *PublicKey {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+type DeleteAccountAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BeneficiaryId string `protobuf:"bytes,1,opt,name=beneficiary_id,json=beneficiaryId,proto3" json:"beneficiary_id,omitempty"`
+}
+
+func (x *DeleteAccountAction) Reset() {
+	*x = DeleteAccountAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_near_type_v1_type_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddKeyAction) String() string {
+func (x *DeleteAccountAction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddKeyAction) ProtoMessage() {}
+func (*DeleteAccountAction) ProtoMessage() {}
 
-func (x *AddKeyAction) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_near_type_v1_type_proto_msgTypes[55]
+func (x *DeleteAccountAction) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_near_type_v1_type_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4595,50 +5212,44 @@ func (x *AddKeyAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddKeyAction.ProtoReflect.Descriptor instead.
-func (*AddKeyAction) Descriptor() ([]byte, []int) {
-	return file_sf_near_type_v1_type_proto_rawDescGZIP(), []int{55}
-}
-
-func (x *AddKeyAction) GetPublicKey() *PublicKey {
-	if x != nil {
-		return x.PublicKey
-	}
-	return nil
+// Deprecated: Use DeleteAccountAction.ProtoReflect.Descriptor instead.
+func (*DeleteAccountAction) Descriptor() ([]byte, []int) {
+	return file_sf_near_type_v1_type_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *AddKeyAction) GetAccessKey() *AccessKey {
+func (x *DeleteAccountAction) GetBeneficiaryId() string {
 	if x != nil {
-		return x.AccessKey
+		return x.BeneficiaryId
 	}
-	return nil
+	return ""
 }
 
-type DeleteKeyAction struct {
+type SignedDelegateAction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PublicKey *PublicKey `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Signature      *Signature      `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	DelegateAction *DelegateAction `protobuf:"bytes,2,opt,name=delegate_action,json=delegateAction,proto3" json:"delegate_action,omitempty"`
 }
 
-func (x *DeleteKeyAction) Reset() {
-	*x = DeleteKeyAction{}
+func (x *SignedDelegateAction) Reset() {
+	*x = SignedDelegateAction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sf_near_type_v1_type_proto_msgTypes[56]
+		mi := &file_sf_near_type_v1_type_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteKeyAction) String() string {
+func (x *SignedDelegateAction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteKeyAction) ProtoMessage() {}
+func (*SignedDelegateAction) ProtoMessage() {}
 
-func (x *DeleteKeyAction) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_near_type_v1_type_proto_msgTypes[56]
+func (x *SignedDelegateAction) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_near_type_v1_type_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4649,43 +5260,55 @@ func (x *DeleteKeyAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteKeyAction.ProtoReflect.Descriptor instead.
-func (*DeleteKeyAction) Descriptor() ([]byte, []int) {
-	return file_sf_near_type_v1_type_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use SignedDelegateAction.ProtoReflect.Descriptor instead.
+func (*SignedDelegateAction) Descriptor() ([]byte, []int) {
+	return file_sf_near_type_v1_type_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *DeleteKeyAction) GetPublicKey() *PublicKey {
+func (x *SignedDelegateAction) GetSignature() *Signature {
 	if x != nil {
-		return x.PublicKey
+		return x.Signature
 	}
 	return nil
 }
 
-type DeleteAccountAction struct {
+func (x *SignedDelegateAction) GetDelegateAction() *DelegateAction {
+	if x != nil {
+		return x.DelegateAction
+	}
+	return nil
+}
+
+type DelegateAction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BeneficiaryId string `protobuf:"bytes,1,opt,name=beneficiary_id,json=beneficiaryId,proto3" json:"beneficiary_id,omitempty"`
+	SenderId       string     `protobuf:"bytes,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	ReceiverId     string     `protobuf:"bytes,2,opt,name=receiver_id,json=receiverId,proto3" json:"receiver_id,omitempty"`
+	Actions        []*Action  `protobuf:"bytes,3,rep,name=actions,proto3" json:"actions,omitempty"`
+	Nonce          uint64     `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	MaxBlockHeight uint64     `protobuf:"varint,5,opt,name=max_block_height,json=maxBlockHeight,proto3" json:"max_block_height,omitempty"`
+	PublicKey      *PublicKey `protobuf:"bytes,6,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
 }
 
-func (x *DeleteAccountAction) Reset() {
-	*x = DeleteAccountAction{}
+func (x *DelegateAction) Reset() {
+	*x = DelegateAction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sf_near_type_v1_type_proto_msgTypes[57]
+		mi := &file_sf_near_type_v1_type_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteAccountAction) String() string {
+func (x *DelegateAction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAccountAction) ProtoMessage() {}
+func (*DelegateAction) ProtoMessage() {}
 
-func (x *DeleteAccountAction) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_near_type_v1_type_proto_msgTypes[57]
+func (x *DelegateAction) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_near_type_v1_type_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4696,18 +5319,53 @@ func (x *DeleteAccountAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAccountAction.ProtoReflect.Descriptor instead.
-func (*DeleteAccountAction) Descriptor() ([]byte, []int) {
-	return file_sf_near_type_v1_type_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use DelegateAction.ProtoReflect.Descriptor instead.
+func (*DelegateAction) Descriptor() ([]byte, []int) {
+	return file_sf_near_type_v1_type_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *DeleteAccountAction) GetBeneficiaryId() string {
+func (x *DelegateAction) GetSenderId() string {
 	if x != nil {
-		return x.BeneficiaryId
+		return x.SenderId
+	}
+	return ""
+}
+
+func (x *DelegateAction) GetReceiverId() string {
+	if x != nil {
+		return x.ReceiverId
 	}
 	return ""
 }
 
+func (x *DelegateAction) GetActions() []*Action {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *DelegateAction) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *DelegateAction) GetMaxBlockHeight() uint64 {
+	if x != nil {
+		return x.MaxBlockHeight
+	}
+	return 0
+}
+
+func (x *DelegateAction) GetPublicKey() *PublicKey {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
 type AccessKey struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -4720,7 +5378,7 @@ type AccessKey struct {
 func (x *AccessKey) Reset() {
 	*x = AccessKey{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sf_near_type_v1_type_proto_msgTypes[58]
+		mi := &file_sf_near_type_v1_type_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4733,7 +5391,7 @@ func (x *AccessKey) String() string {
 func (*AccessKey) ProtoMessage() {}
 
 func (x *AccessKey) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_near_type_v1_type_proto_msgTypes[58]
+	mi := &file_sf_near_type_v1_type_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4746,7 +5404,7 @@ func (x *AccessKey) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AccessKey.ProtoReflect.Descriptor instead.
 func (*AccessKey) Descriptor() ([]byte, []int) {
-	return file_sf_near_type_v1_type_proto_rawDescGZIP(), []int{58}
+	return file_sf_near_type_v1_type_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *AccessKey) GetNonce() uint64 {
@@ -4777,7 +5435,7 @@ type AccessKeyPermission struct {
 func (x *AccessKeyPermission) Reset() {
 	*x = AccessKeyPermission{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sf_near_type_v1_type_proto_msgTypes[59]
+		mi := &file_sf_near_type_v1_type_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4790,7 +5448,7 @@ func (x *AccessKeyPermission) String() string {
 func (*AccessKeyPermission) ProtoMessage() {}
 
 func (x *AccessKeyPermission) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_near_type_v1_type_proto_msgTypes[59]
+	mi := &file_sf_near_type_v1_type_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4803,7 +5461,7 @@ func (x *AccessKeyPermission) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AccessKeyPermission.ProtoReflect.Descriptor instead.
 func (*AccessKeyPermission) Descriptor() ([]byte, []int) {
-	return file_sf_near_type_v1_type_proto_rawDescGZIP(), []int{59}
+	return file_sf_near_type_v1_type_proto_rawDescGZIP(), []int{68}
 }
 
 func (m *AccessKeyPermission) GetPermission() isAccessKeyPermission_Permission {
@@ -4856,7 +5514,7 @@ type FunctionCallPermission struct {
 func (x *FunctionCallPermission) Reset() {
 	*x = FunctionCallPermission{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sf_near_type_v1_type_proto_msgTypes[60]
+		mi := &file_sf_near_type_v1_type_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4869,7 +5527,7 @@ func (x *FunctionCallPermission) String() string {
 func (*FunctionCallPermission) ProtoMessage() {}
 
 func (x *FunctionCallPermission) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_near_type_v1_type_proto_msgTypes[60]
+	mi := &file_sf_near_type_v1_type_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4882,7 +5540,7 @@ func (x *FunctionCallPermission) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FunctionCallPermission.ProtoReflect.Descriptor instead.
 func (*FunctionCallPermission) Descriptor() ([]byte, []int) {
-	return file_sf_near_type_v1_type_proto_rawDescGZIP(), []int{60}
+	return file_sf_near_type_v1_type_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *FunctionCallPermission) GetAllowance() *BigInt {
@@ -4915,7 +5573,7 @@ type FullAccessPermission struct {
 func (x *FullAccessPermission) Reset() {
 	*x = FullAccessPermission{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sf_near_type_v1_type_proto_msgTypes[61]
+		mi := &file_sf_near_type_v1_type_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4928,7 +5586,7 @@ func (x *FullAccessPermission) String() string {
 func (*FullAccessPermission) ProtoMessage() {}
 
 func (x *FullAccessPermission) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_near_type_v1_type_proto_msgTypes[61]
+	mi := &file_sf_near_type_v1_type_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4941,7 +5599,7 @@ func (x *FullAccessPermission) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FullAccessPermission.ProtoReflect.Descriptor instead.
 func (*FullAccessPermission) Descriptor() ([]byte, []int) {
-	return file_sf_near_type_v1_type_proto_rawDescGZIP(), []int{61}
+	return file_sf_near_type_v1_type_proto_rawDescGZIP(), []int{70}
 }
 
 type StateChangeCause_NotWritableToDisk struct {
@@ -4953,7 +5611,7 @@ type StateChangeCause_NotWritableToDisk struct {
 func (x *StateChangeCause_NotWritableToDisk) Reset() {
 	*x = StateChangeCause_NotWritableToDisk{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sf_near_type_v1_type_proto_msgTypes[62]
+		mi := &file_sf_near_type_v1_type_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4966,7 +5624,7 @@ func (x *StateChangeCause_NotWritableToDisk) String() string {
 func (*StateChangeCause_NotWritableToDisk) ProtoMessage() {}
 
 func (x *StateChangeCause_NotWritableToDisk) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_near_type_v1_type_proto_msgTypes[62]
+	mi := &file_sf_near_type_v1_type_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4991,7 +5649,7 @@ type StateChangeCause_InitialState struct {
 func (x *StateChangeCause_InitialState) Reset() {
 	*x = StateChangeCause_InitialState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sf_near_type_v1_type_proto_msgTypes[63]
+		mi := &file_sf_near_type_v1_type_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5004,7 +5662,7 @@ func (x *StateChangeCause_InitialState) String() string {
 func (*StateChangeCause_InitialState) ProtoMessage() {}
 
 func (x *StateChangeCause_InitialState) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_near_type_v1_type_proto_msgTypes[63]
+	mi := &file_sf_near_type_v1_type_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5031,7 +5689,7 @@ type StateChangeCause_TransactionProcessing struct {
 func (x *StateChangeCause_TransactionProcessing) Reset() {
 	*x = StateChangeCause_TransactionProcessing{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sf_near_type_v1_type_proto_msgTypes[64]
+		mi := &file_sf_near_type_v1_type_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5044,7 +5702,7 @@ func (x *StateChangeCause_TransactionProcessing) String() string {
 func (*StateChangeCause_TransactionProcessing) ProtoMessage() {}
 
 func (x *StateChangeCause_TransactionProcessing) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_near_type_v1_type_proto_msgTypes[64]
+	mi := &file_sf_near_type_v1_type_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5078,7 +5736,7 @@ type StateChangeCause_ActionReceiptProcessingStarted struct {
 func (x *StateChangeCause_ActionReceiptProcessingStarted) Reset() {
 	*x = StateChangeCause_ActionReceiptProcessingStarted{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sf_near_type_v1_type_proto_msgTypes[65]
+		mi := &file_sf_near_type_v1_type_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5091,7 +5749,7 @@ func (x *StateChangeCause_ActionReceiptProcessingStarted) String() string {
 func (*StateChangeCause_ActionReceiptProcessingStarted) ProtoMessage() {}
 
 func (x *StateChangeCause_ActionReceiptProcessingStarted) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_near_type_v1_type_proto_msgTypes[65]
+	mi := &file_sf_near_type_v1_type_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5125,7 +5783,7 @@ type StateChangeCause_ActionReceiptGasReward struct {
 func (x *StateChangeCause_ActionReceiptGasReward) Reset() {
 	*x = StateChangeCause_ActionReceiptGasReward{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sf_near_type_v1_type_proto_msgTypes[66]
+		mi := &file_sf_near_type_v1_type_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5138,7 +5796,7 @@ func (x *StateChangeCause_ActionReceiptGasReward) String() string {
 func (*StateChangeCause_ActionReceiptGasReward) ProtoMessage() {}
 
 func (x *StateChangeCause_ActionReceiptGasReward) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_near_type_v1_type_proto_msgTypes[66]
+	mi := &file_sf_near_type_v1_type_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5172,7 +5830,7 @@ type StateChangeCause_ReceiptProcessing struct {
 func (x *StateChangeCause_ReceiptProcessing) Reset() {
 	*x = StateChangeCause_ReceiptProcessing{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sf_near_type_v1_type_proto_msgTypes[67]
+		mi := &file_sf_near_type_v1_type_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5185,7 +5843,7 @@ func (x *StateChangeCause_ReceiptProcessing) String() string {
 func (*StateChangeCause_ReceiptProcessing) ProtoMessage() {}
 
 func (x *StateChangeCause_ReceiptProcessing) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_near_type_v1_type_proto_msgTypes[67]
+	mi := &file_sf_near_type_v1_type_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5219,7 +5877,7 @@ type StateChangeCause_PostponedReceipt struct {
 func (x *StateChangeCause_PostponedReceipt) Reset() {
 	*x = StateChangeCause_PostponedReceipt{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sf_near_type_v1_type_proto_msgTypes[68]
+		mi := &file_sf_near_type_v1_type_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5232,7 +5890,7 @@ func (x *StateChangeCause_PostponedReceipt) String() string {
 func (*StateChangeCause_PostponedReceipt) ProtoMessage() {}
 
 func (x *StateChangeCause_PostponedReceipt) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_near_type_v1_type_proto_msgTypes[68]
+	mi := &file_sf_near_type_v1_type_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5264,7 +5922,7 @@ type StateChangeCause_UpdatedDelayedReceipts struct {
 func (x *StateChangeCause_UpdatedDelayedReceipts) Reset() {
 	*x = StateChangeCause_UpdatedDelayedReceipts{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sf_near_type_v1_type_proto_msgTypes[69]
+		mi := &file_sf_near_type_v1_type_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5277,7 +5935,7 @@ func (x *StateChangeCause_UpdatedDelayedReceipts) String() string {
 func (*StateChangeCause_UpdatedDelayedReceipts) ProtoMessage() {}
 
 func (x *StateChangeCause_UpdatedDelayedReceipts) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_near_type_v1_type_proto_msgTypes[69]
+	mi := &file_sf_near_type_v1_type_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5302,7 +5960,7 @@ type StateChangeCause_ValidatorAccountsUpdate struct {
 func (x *StateChangeCause_ValidatorAccountsUpdate) Reset() {
 	*x = StateChangeCause_ValidatorAccountsUpdate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sf_near_type_v1_type_proto_msgTypes[70]
+		mi := &file_sf_near_type_v1_type_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5315,7 +5973,7 @@ func (x *StateChangeCause_ValidatorAccountsUpdate) String() string {
 func (*StateChangeCause_ValidatorAccountsUpdate) ProtoMessage() {}
 
 func (x *StateChangeCause_ValidatorAccountsUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_near_type_v1_type_proto_msgTypes[70]
+	mi := &file_sf_near_type_v1_type_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5340,7 +5998,7 @@ type StateChangeCause_Migration struct {
 func (x *StateChangeCause_Migration) Reset() {
 	*x = StateChangeCause_Migration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sf_near_type_v1_type_proto_msgTypes[71]
+		mi := &file_sf_near_type_v1_type_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5353,7 +6011,7 @@ func (x *StateChangeCause_Migration) String() string {
 func (*StateChangeCause_Migration) ProtoMessage() {}
 
 func (x *StateChangeCause_Migration) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_near_type_v1_type_proto_msgTypes[71]
+	mi := &file_sf_near_type_v1_type_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5381,7 +6039,7 @@ type StateChangeValue_AccountUpdate struct {
 func (x *StateChangeValue_AccountUpdate) Reset() {
 	*x = StateChangeValue_AccountUpdate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sf_near_type_v1_type_proto_msgTypes[72]
+		mi := &file_sf_near_type_v1_type_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5394,7 +6052,7 @@ func (x *StateChangeValue_AccountUpdate) String() string {
 func (*StateChangeValue_AccountUpdate) ProtoMessage() {}
 
 func (x *StateChangeValue_AccountUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_near_type_v1_type_proto_msgTypes[72]
+	mi := &file_sf_near_type_v1_type_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5435,7 +6093,7 @@ type StateChangeValue_AccountDeletion struct {
 func (x *StateChangeValue_AccountDeletion) Reset() {
 	*x = StateChangeValue_AccountDeletion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sf_near_type_v1_type_proto_msgTypes[73]
+		mi := &file_sf_near_type_v1_type_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5448,7 +6106,7 @@ func (x *StateChangeValue_AccountDeletion) String() string {
 func (*StateChangeValue_AccountDeletion) ProtoMessage() {}
 
 func (x *StateChangeValue_AccountDeletion) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_near_type_v1_type_proto_msgTypes[73]
+	mi := &file_sf_near_type_v1_type_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5484,7 +6142,7 @@ type StateChangeValue_AccessKeyUpdate struct {
 func (x *StateChangeValue_AccessKeyUpdate) Reset() {
 	*x = StateChangeValue_AccessKeyUpdate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sf_near_type_v1_type_proto_msgTypes[74]
+		mi := &file_sf_near_type_v1_type_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5497,7 +6155,7 @@ func (x *StateChangeValue_AccessKeyUpdate) String() string {
 func (*StateChangeValue_AccessKeyUpdate) ProtoMessage() {}
 
 func (x *StateChangeValue_AccessKeyUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_near_type_v1_type_proto_msgTypes[74]
+	mi := &file_sf_near_type_v1_type_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5546,7 +6204,7 @@ type StateChangeValue_AccessKeyDeletion struct {
 func (x *StateChangeValue_AccessKeyDeletion) Reset() {
 	*x = StateChangeValue_AccessKeyDeletion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sf_near_type_v1_type_proto_msgTypes[75]
+		mi := &file_sf_near_type_v1_type_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5559,7 +6217,7 @@ func (x *StateChangeValue_AccessKeyDeletion) String() string {
 func (*StateChangeValue_AccessKeyDeletion) ProtoMessage() {}
 
 func (x *StateChangeValue_AccessKeyDeletion) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_near_type_v1_type_proto_msgTypes[75]
+	mi := &file_sf_near_type_v1_type_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5602,7 +6260,7 @@ type StateChangeValue_DataUpdate struct {
 func (x *StateChangeValue_DataUpdate) Reset() {
 	*x = StateChangeValue_DataUpdate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sf_near_type_v1_type_proto_msgTypes[76]
+		mi := &file_sf_near_type_v1_type_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5615,7 +6273,7 @@ func (x *StateChangeValue_DataUpdate) String() string {
 func (*StateChangeValue_DataUpdate) ProtoMessage() {}
 
 func (x *StateChangeValue_DataUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_near_type_v1_type_proto_msgTypes[76]
+	mi := &file_sf_near_type_v1_type_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5664,7 +6322,7 @@ type StateChangeValue_DataDeletion struct {
 func (x *StateChangeValue_DataDeletion) Reset() {
 	*x = StateChangeValue_DataDeletion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sf_near_type_v1_type_proto_msgTypes[77]
+		mi := &file_sf_near_type_v1_type_proto_msgTypes[86]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5677,7 +6335,7 @@ func (x *StateChangeValue_DataDeletion) String() string {
 func (*StateChangeValue_DataDeletion) ProtoMessage() {}
 
 func (x *StateChangeValue_DataDeletion) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_near_type_v1_type_proto_msgTypes[77]
+	mi := &file_sf_near_type_v1_type_proto_msgTypes[86]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5714,12 +6372,16 @@ type StateChangeValue_ContractCodeUpdate struct {
 
 	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
 	Code      []byte `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// Only set when `code` has been stripped by the `sf.near.transform.v1.StripPayloads` transform, the
+	// sha256 of the stripped `code` and its size in bytes.
+	CodeHash *CryptoHash `protobuf:"bytes,3,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+	CodeSize uint64      `protobuf:"varint,4,opt,name=code_size,json=codeSize,proto3" json:"code_size,omitempty"`
 }
 
 func (x *StateChangeValue_ContractCodeUpdate) Reset() {
 	*x = StateChangeValue_ContractCodeUpdate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sf_near_type_v1_type_proto_msgTypes[78]
+		mi := &file_sf_near_type_v1_type_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5732,7 +6394,7 @@ func (x *StateChangeValue_ContractCodeUpdate) String() string {
 func (*StateChangeValue_ContractCodeUpdate) ProtoMessage() {}
 
 func (x *StateChangeValue_ContractCodeUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_near_type_v1_type_proto_msgTypes[78]
+	mi := &file_sf_near_type_v1_type_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5762,6 +6424,20 @@ func (x *StateChangeValue_ContractCodeUpdate) GetCode() []byte {
 	return nil
 }
 
+func (x *StateChangeValue_ContractCodeUpdate) GetCodeHash() *CryptoHash {
+	if x != nil {
+		return x.CodeHash
+	}
+	return nil
+}
+
+func (x *StateChangeValue_ContractCodeUpdate) GetCodeSize() uint64 {
+	if x != nil {
+		return x.CodeSize
+	}
+	return 0
+}
+
 type StateChangeValue_ContractCodeDeletion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -5773,7 +6449,7 @@ type StateChangeValue_ContractCodeDeletion struct {
 func (x *StateChangeValue_ContractCodeDeletion) Reset() {
 	*x = StateChangeValue_ContractCodeDeletion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sf_near_type_v1_type_proto_msgTypes[79]
+		mi := &file_sf_near_type_v1_type_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5786,7 +6462,7 @@ func (x *StateChangeValue_ContractCodeDeletion) String() string {
 func (*StateChangeValue_ContractCodeDeletion) ProtoMessage() {}
 
 func (x *StateChangeValue_ContractCodeDeletion) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_near_type_v1_type_proto_msgTypes[79]
+	mi := &file_sf_near_type_v1_type_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5947,7 +6623,7 @@ var file_sf_near_type_v1_type_proto_rawDesc = []byte{
 	0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x1a, 0x19, 0x0a, 0x17, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
 	0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74,
 	0x65, 0x1a, 0x0b, 0x0a, 0x09, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x07,
-	0x0a, 0x05, 0x63, 0x61, 0x75, 0x73, 0x65, 0x22, 0xda, 0x0b, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x74,
+	0x0a, 0x05, 0x63, 0x61, 0x75, 0x73, 0x65, 0x22, 0xb2, 0x0c, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x74,
 	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x58, 0x0a, 0x0e,
 	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74,
@@ -6032,823 +6708,974 @@ var file_sf_near_type_v1_type_proto_rawDesc = []byte{
 	0x74, 0x61, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63,
 	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
 	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x1a, 0x47, 0x0a, 0x12, 0x43,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
-	0x63, 0x6f, 0x64, 0x65, 0x1a, 0x35, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x43, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x22, 0xca, 0x01, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x2f, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x42, 0x69, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x2f, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x6b,
-	0x65, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61,
-	0x73, 0x68, 0x52, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x23, 0x0a, 0x0d,
-	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x55, 0x73, 0x61, 0x67,
-	0x65, 0x22, 0xc5, 0x0e, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x65,
-	0x76, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
-	0x70, 0x72, 0x65, 0x76, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x65, 0x70,
-	0x6f, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73,
-	0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x52, 0x07, 0x65, 0x70, 0x6f, 0x63, 0x68,
-	0x49, 0x64, 0x12, 0x3f, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68,
-	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66, 0x2e, 0x6e,
-	0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x79, 0x70,
-	0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x52, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x45, 0x70, 0x6f, 0x63,
-	0x68, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x1a, 0x9e, 0x01, 0x0a, 0x12,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65,
+	0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74,
+	0x6f, 0x48, 0x61, 0x73, 0x68, 0x52, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x1a, 0x35, 0x0a, 0x14,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x49, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xca, 0x01, 0x0a,
+	0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65,
+	0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x67, 0x49, 0x6e,
+	0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x66, 0x2e, 0x6e,
+	0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x67, 0x49,
+	0x6e, 0x74, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x6f,
+	0x64, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x52, 0x08, 0x63, 0x6f, 0x64, 0x65,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f,
+	0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x22, 0xc5, 0x0e, 0x0a, 0x0b, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x73,
+	0x68, 0x52, 0x07, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x0d, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x52, 0x04,
-	0x68, 0x61, 0x73, 0x68, 0x12, 0x38, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x68, 0x61, 0x73,
-	0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61,
-	0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f,
-	0x48, 0x61, 0x73, 0x68, 0x52, 0x08, 0x70, 0x72, 0x65, 0x76, 0x48, 0x61, 0x73, 0x68, 0x12, 0x43,
-	0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f,
-	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x52, 0x0b,
+	0x6e, 0x65, 0x78, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x04, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66, 0x2e, 0x6e,
+	0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x79, 0x70,
+	0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x38, 0x0a, 0x09,
+	0x70, 0x72, 0x65, 0x76, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x52, 0x08, 0x70, 0x72,
+	0x65, 0x76, 0x48, 0x61, 0x73, 0x68, 0x12, 0x43, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x52, 0x0d, 0x70, 0x72,
+	0x65, 0x76, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x4b, 0x0a, 0x13, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x5f, 0x72, 0x6f,
+	0x6f, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65,
+	0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74,
+	0x6f, 0x48, 0x61, 0x73, 0x68, 0x52, 0x11, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x49, 0x0a, 0x12, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x73,
+	0x68, 0x52, 0x10, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52,
+	0x6f, 0x6f, 0x74, 0x12, 0x3f, 0x0a, 0x0d, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x74, 0x78, 0x5f,
+	0x72, 0x6f, 0x6f, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66, 0x2e,
+	0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x79,
+	0x70, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x52, 0x0b, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x54, 0x78,
+	0x52, 0x6f, 0x6f, 0x74, 0x12, 0x3e, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x5f,
+	0x72, 0x6f, 0x6f, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66, 0x2e,
+	0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x79,
+	0x70, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65,
+	0x52, 0x6f, 0x6f, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x5f, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x73, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x12, 0x44, 0x0a,
+	0x0f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x5f, 0x72, 0x6f, 0x6f, 0x74,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x48,
+	0x61, 0x73, 0x68, 0x52, 0x0e, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x52,
+	0x6f, 0x6f, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6e,
+	0x61, 0x6e, 0x6f, 0x73, 0x65, 0x63, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4e, 0x61, 0x6e, 0x6f, 0x73, 0x65, 0x63, 0x12, 0x3e,
+	0x0a, 0x0c, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x73,
+	0x68, 0x52, 0x0b, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x50,
+	0x0a, 0x13, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x66,
+	0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x12, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x12,
+	0x20, 0x03, 0x28, 0x08, 0x52, 0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x4d, 0x61, 0x73, 0x6b, 0x12,
+	0x34, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x08, 0x67, 0x61, 0x73,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6f,
+	0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x4f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x12, 0x3a, 0x0a, 0x0c, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x69, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x4e, 0x0a, 0x11, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x16, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x35, 0x0a, 0x17, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x66,
+	0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x46, 0x69, 0x6e,
+	0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x45, 0x0a,
+	0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61,
 	0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f,
-	0x48, 0x61, 0x73, 0x68, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
-	0x6f, 0x6f, 0x74, 0x12, 0x4b, 0x0a, 0x13, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x72, 0x65, 0x63,
-	0x65, 0x69, 0x70, 0x74, 0x73, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x52, 0x11, 0x63,
-	0x68, 0x75, 0x6e, 0x6b, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x6f, 0x6f, 0x74,
-	0x12, 0x49, 0x0a, 0x12, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x73, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73,
-	0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x52, 0x10, 0x63, 0x68, 0x75, 0x6e, 0x6b,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x3f, 0x0a, 0x0d, 0x63,
-	0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x74, 0x78, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x52,
-	0x0b, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x54, 0x78, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x3e, 0x0a, 0x0c,
-	0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x48, 0x61, 0x73, 0x68, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x3a, 0x0a, 0x1a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x64, 0x73, 0x5f,
+	0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x73,
+	0x46, 0x69, 0x6e, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x4a, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x64, 0x73, 0x5f, 0x66, 0x69, 0x6e, 0x61,
+	0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74,
+	0x44, 0x73, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x3d, 0x0a, 0x0c,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x62, 0x70, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x1b, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70,
 	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x52,
-	0x0b, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x27, 0x0a, 0x0f,
-	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x49, 0x6e, 0x63,
-	0x6c, 0x75, 0x64, 0x65, 0x64, 0x12, 0x44, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
-	0x67, 0x65, 0x73, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x52, 0x0e, 0x63, 0x68, 0x61,
-	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x73, 0x65, 0x63, 0x18, 0x0f,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4e,
-	0x61, 0x6e, 0x6f, 0x73, 0x65, 0x63, 0x12, 0x3e, 0x0a, 0x0c, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
-	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73,
-	0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x52, 0x0b, 0x72, 0x61, 0x6e, 0x64, 0x6f,
-	0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x50, 0x0a, 0x13, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x18, 0x11, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53,
-	0x74, 0x61, 0x6b, 0x65, 0x52, 0x12, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50,
-	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x75, 0x6e,
-	0x6b, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x12, 0x20, 0x03, 0x28, 0x08, 0x52, 0x09, 0x63, 0x68,
-	0x75, 0x6e, 0x6b, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x34, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x70,
-	0x72, 0x69, 0x63, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x66, 0x2e,
-	0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x67,
-	0x49, 0x6e, 0x74, 0x52, 0x08, 0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x23, 0x0a,
-	0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x14,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4f, 0x72, 0x64, 0x69, 0x6e,
-	0x61, 0x6c, 0x12, 0x3a, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x70, 0x70,
-	0x6c, 0x79, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65,
-	0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x67, 0x49, 0x6e,
-	0x74, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x4e,
-	0x0a, 0x11, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x66, 0x2e, 0x6e,
-	0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6c, 0x61, 0x73,
-	0x68, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x10, 0x63, 0x68,
-	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x35,
-	0x0a, 0x17, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x14, 0x6c, 0x61, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x45, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x66, 0x69,
-	0x6e, 0x61, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x52, 0x0e, 0x6c, 0x61,
-	0x73, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x3a, 0x0a, 0x1a,
-	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x64, 0x73, 0x5f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x16, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x73, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x4a, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74,
-	0x5f, 0x64, 0x73, 0x5f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
-	0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61,
-	0x73, 0x68, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x73, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x3d, 0x0a, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x62, 0x70, 0x5f,
-	0x68, 0x61, 0x73, 0x68, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66, 0x2e,
-	0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x79,
-	0x70, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x42, 0x70, 0x48,
-	0x61, 0x73, 0x68, 0x12, 0x47, 0x0a, 0x11, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6d, 0x65, 0x72,
-	0x6b, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x52, 0x0f, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x2f, 0x0a, 0x14,
-	0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f,
-	0x68, 0x61, 0x73, 0x68, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x65, 0x70, 0x6f, 0x63,
-	0x68, 0x53, 0x79, 0x6e, 0x63, 0x44, 0x61, 0x74, 0x61, 0x48, 0x61, 0x73, 0x68, 0x12, 0x38, 0x0a,
-	0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x1e, 0x20, 0x03, 0x28, 0x0b,
+	0x0a, 0x6e, 0x65, 0x78, 0x74, 0x42, 0x70, 0x48, 0x61, 0x73, 0x68, 0x12, 0x47, 0x0a, 0x11, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74,
+	0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x48,
+	0x61, 0x73, 0x68, 0x52, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65,
+	0x52, 0x6f, 0x6f, 0x74, 0x12, 0x2f, 0x0a, 0x14, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x73, 0x79,
+	0x6e, 0x63, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x1d, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x11, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x53, 0x79, 0x6e, 0x63, 0x44, 0x61, 0x74,
+	0x61, 0x48, 0x61, 0x73, 0x68, 0x12, 0x38, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x73, 0x18, 0x1e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65,
+	0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x12,
+	0x38, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x1f, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x6c, 0x61, 0x74,
+	0x65, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x6c, 0x61, 0x74, 0x65,
+	0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0x1e, 0x0a, 0x06, 0x42, 0x69, 0x67, 0x49, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x22, 0x22, 0x0a, 0x0a, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x22, 0x51, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
 	0x32, 0x1a, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x61, 0x70,
-	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x66, 0x2e,
-	0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x12, 0x36, 0x0a, 0x17, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x20, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x15, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x1e, 0x0a, 0x06, 0x42, 0x69, 0x67,
-	0x49, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x22, 0x22, 0x0a, 0x0a, 0x43, 0x72, 0x79,
-	0x70, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x22, 0x51, 0x0a,
-	0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65,
-	0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x72, 0x76, 0x65,
-	0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79,
-	0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73,
-	0x22, 0x51, 0x0a, 0x09, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x2e, 0x0a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x73, 0x66,
-	0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75,
-	0x72, 0x76, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x79,
-	0x74, 0x65, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x0e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f,
-	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x66, 0x2e, 0x6e,
-	0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
-	0x12, 0x2d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x42, 0x69, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x22,
-	0x57, 0x0a, 0x10, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f,
-	0x73, 0x69, 0x67, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x44, 0x6f,
-	0x75, 0x62, 0x6c, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x22, 0xf6, 0x05, 0x0a, 0x0b, 0x43, 0x68, 0x75,
-	0x6e, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x75, 0x6e,
-	0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x68,
-	0x75, 0x6e, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x5f,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12,
-	0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x6f,
-	0x6f, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x70, 0x72, 0x65,
-	0x76, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x65, 0x6e,
-	0x63, 0x6f, 0x64, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x6f,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64,
-	0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6e,
-	0x63, 0x6f, 0x64, 0x65, 0x64, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0d, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x4c, 0x65, 0x6e, 0x67, 0x74,
-	0x68, 0x12, 0x25, 0x0a, 0x0e, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0e, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
-	0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08,
-	0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
-	0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x67, 0x61, 0x73, 0x4c,
-	0x69, 0x6d, 0x69, 0x74, 0x12, 0x42, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x76, 0x31, 0x2e, 0x43, 0x75, 0x72, 0x76, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x22, 0x51, 0x0a, 0x09, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x72, 0x76, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x0e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a,
+	0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x09, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61,
+	0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x67, 0x49, 0x6e, 0x74,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x22, 0x57, 0x0a, 0x10, 0x53, 0x6c, 0x61, 0x73, 0x68,
+	0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x73,
+	0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x53, 0x69, 0x67, 0x6e,
+	0x22, 0xf6, 0x05, 0x0a, 0x0b, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x26, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x6f,
+	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x72,
+	0x65, 0x76, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f,
+	0x6f, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x5f, 0x6d, 0x65,
+	0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x11, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f,
+	0x6f, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x5f, 0x6c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x65, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x64, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0d, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x27, 0x0a, 0x0f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x68, 0x61,
+	0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x68, 0x61,
+	0x72, 0x64, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x42, 0x0a, 0x10,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x67, 0x49, 0x6e, 0x74, 0x52,
+	0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x12, 0x3c, 0x0a, 0x0d, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x62, 0x75, 0x72, 0x6e,
+	0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61,
+	0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x67, 0x49, 0x6e, 0x74,
+	0x52, 0x0c, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x75, 0x72, 0x6e, 0x74, 0x12, 0x34,
+	0x0a, 0x16, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x73, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x14,
+	0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73,
+	0x52, 0x6f, 0x6f, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x78, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x50, 0x0a,
+	0x13, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x66, 0x2e,
+	0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x12, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12,
+	0x38, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xd1, 0x01, 0x0a, 0x0c, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x53, 0x68, 0x61, 0x72, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x68,
+	0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x68,
+	0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x43, 0x68,
+	0x75, 0x6e, 0x6b, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x71, 0x0a, 0x1a, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33,
 	0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x42, 0x69, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x3c, 0x0a, 0x0d, 0x62, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x5f, 0x62, 0x75, 0x72, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x42, 0x69, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x0c, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x42, 0x75, 0x72, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69,
-	0x6e, 0x67, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x5f, 0x72, 0x6f, 0x6f, 0x74,
-	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67,
-	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x17, 0x0a, 0x07,
-	0x74, 0x78, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x74,
-	0x78, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x50, 0x0a, 0x13, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x18, 0x10, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74,
-	0x61, 0x6b, 0x65, 0x52, 0x12, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x66, 0x2e,
-	0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x22, 0xd1, 0x01, 0x0a, 0x0c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x53, 0x68, 0x61,
-	0x72, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x33, 0x0a,
-	0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73,
-	0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x52, 0x05, 0x63, 0x68, 0x75,
-	0x6e, 0x6b, 0x12, 0x71, 0x0a, 0x1a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x65, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
-	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65,
-	0x57, 0x69, 0x74, 0x68, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x18, 0x72, 0x65, 0x63,
-	0x65, 0x69, 0x70, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74,
-	0x63, 0x6f, 0x6d, 0x65, 0x73, 0x22, 0xae, 0x01, 0x0a, 0x22, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65,
-	0x72, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
-	0x65, 0x57, 0x69, 0x74, 0x68, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x54, 0x0a, 0x11,
-	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61,
-	0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x57, 0x69, 0x74, 0x68, 0x49, 0x64,
-	0x52, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f,
-	0x6d, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x07, 0x72,
-	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x22, 0xe6, 0x01, 0x0a, 0x0c, 0x49, 0x6e, 0x64, 0x65, 0x78,
-	0x65, 0x72, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12,
-	0x34, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x52, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x66,
-	0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
-	0x64, 0x65, 0x78, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x57, 0x69, 0x74, 0x68, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x0c, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x65, 0x63,
-	0x65, 0x69, 0x70, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x66,
+	0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x57, 0x69, 0x74, 0x68, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x52, 0x18, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x73, 0x22, 0xae, 0x01,
+	0x0a, 0x22, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x57, 0x69, 0x74, 0x68, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x12, 0x54, 0x0a, 0x11, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x57, 0x69, 0x74, 0x68, 0x49, 0x64, 0x52, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x66,
 	0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x22,
-	0xbc, 0x01, 0x0a, 0x1d, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
-	0x65, 0x12, 0x44, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x55, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65,
-	0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78,
-	0x65, 0x72, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f,
-	0x6d, 0x65, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65,
-	0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0xc0,
-	0x02, 0x0a, 0x11, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
-	0x79, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e,
-	0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x66, 0x2e, 0x6e,
-	0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x12, 0x2f, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x22, 0xe6,
+	0x01, 0x0a, 0x0c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x34, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61,
+	0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x52, 0x0a,
+	0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x75, 0x74, 0x63,
+	0x6f, 0x6d, 0x65, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x08, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x22, 0xbc, 0x01, 0x0a, 0x1d, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69,
+	0x74, 0x68, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
 	0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x52, 0x04, 0x68, 0x61, 0x73,
-	0x68, 0x22, 0xb6, 0x01, 0x0a, 0x2a, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x45, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x57, 0x69, 0x74,
-	0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
-	0x12, 0x54, 0x0a, 0x11, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x75,
-	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x66,
-	0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x57, 0x69,
-	0x74, 0x68, 0x49, 0x64, 0x52, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4f,
-	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61,
-	0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
-	0x74, 0x52, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x22, 0x86, 0x02, 0x0a, 0x07, 0x52,
-	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x64, 0x65, 0x63,
-	0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x70, 0x72, 0x65, 0x64, 0x65, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a,
-	0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3a,
-	0x0a, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x52,
-	0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x06, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x66, 0x2e,
-	0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63,
-	0x65, 0x69, 0x70, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x06, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x44, 0x61, 0x74, 0x61,
-	0x48, 0x00, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x42, 0x09, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x65,
-	0x69, 0x70, 0x74, 0x22, 0x57, 0x0a, 0x0b, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x44, 0x61,
-	0x74, 0x61, 0x12, 0x34, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68,
-	0x52, 0x06, 0x64, 0x61, 0x74, 0x61, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xf3, 0x02, 0x0a,
-	0x0d, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b,
-	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x11, 0x73,
-	0x69, 0x67, 0x6e, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
-	0x65, 0x79, 0x52, 0x0f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x4b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x67, 0x49, 0x6e, 0x74, 0x52,
-	0x08, 0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x51, 0x0a, 0x15, 0x6f, 0x75, 0x74,
-	0x70, 0x75, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
-	0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65,
-	0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x52, 0x13, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x44,
-	0x61, 0x74, 0x61, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x73, 0x12, 0x41, 0x0a, 0x0e,
-	0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x73,
-	0x68, 0x52, 0x0c, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x44, 0x61, 0x74, 0x61, 0x49, 0x64, 0x73, 0x12,
-	0x31, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x22, 0x65, 0x0a, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76,
-	0x65, 0x72, 0x12, 0x34, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68,
-	0x52, 0x06, 0x64, 0x61, 0x74, 0x61, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65,
-	0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72,
-	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0xf1, 0x01, 0x0a, 0x16, 0x45, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x57, 0x69,
-	0x74, 0x68, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68,
-	0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x3a, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66,
+	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x55, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x3b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x07, 0x6f,
+	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0xc0, 0x02, 0x0a, 0x11, 0x53, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x07, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73,
+	0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x38,
+	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x48,
+	0x61, 0x73, 0x68, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0xb6, 0x01, 0x0a, 0x2a, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x54, 0x0a, 0x11, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4f,
+	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x57, 0x69, 0x74, 0x68, 0x49, 0x64, 0x52, 0x10, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x32,
+	0x0a, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x22, 0x86, 0x02, 0x0a, 0x07, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x70, 0x72, 0x65, 0x64, 0x65, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x64, 0x65, 0x63, 0x65, 0x73,
+	0x73, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66, 0x2e,
+	0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x79,
+	0x70, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x52, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x49, 0x64, 0x12, 0x38, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x48, 0x00, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x66, 0x2e,
+	0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x42, 0x09, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x22, 0x57, 0x0a, 0x0b, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x34, 0x0a, 0x07, 0x64, 0x61,
+	0x74, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66,
 	0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
-	0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
-	0x61, 0x73, 0x68, 0x12, 0x2b, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x3b, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x21, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74,
-	0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0xe9, 0x04,
-	0x0a, 0x10, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f,
-	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0x3c, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70,
-	0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66,
+	0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x52, 0x06, 0x64, 0x61, 0x74, 0x61, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0xf3, 0x02, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x11, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x5f, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x0f, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x09, 0x67,
+	0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x69, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x08, 0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x51, 0x0a, 0x15, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x52,
+	0x13, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x72, 0x73, 0x12, 0x41, 0x0a, 0x0e, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73,
+	0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x52, 0x0c, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x49, 0x64, 0x73, 0x12, 0x31, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65,
+	0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x65, 0x0a, 0x0c, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x07, 0x64, 0x61,
+	0x74, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66,
 	0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
-	0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70,
-	0x74, 0x49, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x62, 0x75, 0x72, 0x6e,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x67, 0x61, 0x73, 0x42, 0x75, 0x72, 0x6e,
-	0x74, 0x12, 0x3a, 0x0a, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x62, 0x75, 0x72, 0x6e,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61,
-	0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x67, 0x49, 0x6e, 0x74,
-	0x52, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x42, 0x75, 0x72, 0x6e, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x43,
-	0x0a, 0x07, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x27, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x07, 0x75, 0x6e, 0x6b, 0x6e,
-	0x6f, 0x77, 0x6e, 0x12, 0x43, 0x0a, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x15,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x45, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52,
-	0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x53, 0x0a, 0x0d, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2c, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x45, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52,
-	0x0c, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x60, 0x0a,
-	0x12, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73, 0x66, 0x2e, 0x6e,
-	0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x64, 0x45, 0x78, 0x65, 0x63,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x10, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12,
-	0x3e, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x22, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42,
-	0x08, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x33, 0x0a, 0x1b, 0x53, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x4e,
-	0x0a, 0x1f, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
-	0x49, 0x64, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x2b, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x52, 0x06, 0x64, 0x61, 0x74, 0x61, 0x49, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0xcc, 0x02, 0x0a, 0x16, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4f,
+	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x57, 0x69, 0x74, 0x68, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x05,
+	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66,
+	0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65,
+	0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12,
+	0x3a, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68,
+	0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2b, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61,
+	0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f,
+	0x48, 0x61, 0x73, 0x68, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63,
+	0x6f, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x66, 0x2e, 0x6e,
+	0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66, 0x2e, 0x6e,
+	0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x79, 0x70,
+	0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x53, 0x69, 0x7a, 0x65,
+	0x22, 0xe9, 0x04, 0x0a, 0x10, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0x3c, 0x0a, 0x0b, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x52, 0x0a, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x49, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x62,
+	0x75, 0x72, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x67, 0x61, 0x73, 0x42,
+	0x75, 0x72, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x62,
+	0x75, 0x72, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x66, 0x2e,
+	0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x67,
+	0x49, 0x6e, 0x74, 0x52, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x42, 0x75, 0x72, 0x6e, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x49,
+	0x64, 0x12, 0x43, 0x0a, 0x07, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x07, 0x75,
+	0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x12, 0x43, 0x0a, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61,
+	0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x48, 0x00, 0x52, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x53, 0x0a, 0x0d, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x16, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x48, 0x00, 0x52, 0x0c, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x60, 0x0a, 0x12, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73,
+	0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x64, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00,
+	0x52, 0x10, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x49, 0x64, 0x12, 0x3e, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x33, 0x0a, 0x1b,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0x4e, 0x0a, 0x1f, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x49, 0x64, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x18, 0x0a, 0x16, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xb3, 0x01, 0x0a, 0x16,
+	0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x41, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73,
+	0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0b, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x4b, 0x0a, 0x10, 0x69, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x54, 0x78, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0e, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x54,
+	0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x09, 0x0a, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x22, 0xd9, 0x14, 0x0a, 0x0b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x64, 0x0a, 0x15, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74,
+	0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x41, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x13, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x41, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x45, 0x78, 0x69, 0x73, 0x74, 0x12, 0x64, 0x0a,
+	0x16, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x64, 0x6f, 0x65, 0x73, 0x5f, 0x6e, 0x6f,
+	0x74, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
 	0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x52, 0x02, 0x69, 0x64, 0x22, 0x18,
-	0x0a, 0x16, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xb3, 0x01, 0x0a, 0x16, 0x46, 0x61, 0x69,
-	0x6c, 0x75, 0x72, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x41, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x66, 0x2e, 0x6e,
-	0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x4b, 0x0a, 0x10, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x5f, 0x74, 0x78, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x1f, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x54, 0x78, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x48, 0x00, 0x52, 0x0e, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x54, 0x78, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x42, 0x09, 0x0a, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x22, 0xbb,
-	0x0d, 0x0a, 0x0b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14,
-	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x12, 0x64, 0x0a, 0x15, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
-	0x61, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x18, 0x15, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6c, 0x72,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x6f, 0x65, 0x73, 0x4e, 0x6f, 0x74, 0x45, 0x78,
+	0x69, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x13,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x6f, 0x65, 0x73, 0x4e, 0x6f, 0x74, 0x45, 0x78,
+	0x69, 0x73, 0x74, 0x12, 0x80, 0x01, 0x0a, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x62, 0x79, 0x5f, 0x72,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x72, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36,
+	0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x6e,
+	0x6c, 0x79, 0x42, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x72, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x1c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x6e, 0x6c, 0x79, 0x42, 0x79, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x72, 0x12, 0x70, 0x0a, 0x1a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x73, 0x66, 0x2e,
+	0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x41, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x48, 0x00, 0x52,
+	0x17, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x6f,
+	0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x5d, 0x0a, 0x13, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x6e, 0x6f, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x4e, 0x6f, 0x50,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69,
+	0x6e, 0x64, 0x48, 0x00, 0x52, 0x11, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x4e, 0x6f, 0x50, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x6b, 0x0a, 0x19, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x64, 0x6f, 0x65, 0x73, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x65,
+	0x78, 0x69, 0x73, 0x74, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73, 0x66, 0x2e,
+	0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x44, 0x6f, 0x65, 0x73, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69,
+	0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x15, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x44, 0x6f, 0x65, 0x73, 0x4e, 0x6f, 0x74, 0x45,
+	0x78, 0x69, 0x73, 0x74, 0x12, 0x64, 0x0a, 0x16, 0x61, 0x64, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x5f,
+	0x61, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x1b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x4b, 0x65, 0x79, 0x41, 0x6c, 0x72,
 	0x65, 0x61, 0x64, 0x79, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b,
-	0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x13, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6c,
-	0x72, 0x65, 0x61, 0x64, 0x79, 0x45, 0x78, 0x69, 0x73, 0x74, 0x12, 0x64, 0x0a, 0x16, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x64, 0x6f, 0x65, 0x73, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x65,
-	0x78, 0x69, 0x73, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x66, 0x2e,
-	0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x44, 0x6f, 0x65, 0x73, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x13, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x44, 0x6f, 0x65, 0x73, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74,
-	0x12, 0x80, 0x01, 0x0a, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x62, 0x79, 0x5f, 0x72, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x72, 0x61, 0x72, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x73, 0x66,
-	0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x6e, 0x6c, 0x79, 0x42,
-	0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b,
-	0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x1c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x6e, 0x6c, 0x79, 0x42, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x72, 0x61, 0x72, 0x12, 0x70, 0x0a, 0x1a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
-	0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61,
-	0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
-	0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x17, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x41, 0x6c,
-	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x5d, 0x0a, 0x13, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x6e,
-	0x6f, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x19, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x4e, 0x6f, 0x50, 0x65, 0x72, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x48,
-	0x00, 0x52, 0x11, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x4e, 0x6f, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x6b, 0x0a, 0x19, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x6b,
-	0x65, 0x79, 0x5f, 0x64, 0x6f, 0x65, 0x73, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x65, 0x78, 0x69, 0x73,
-	0x74, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61,
-	0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x4b, 0x65, 0x79, 0x44, 0x6f, 0x65, 0x73, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x15, 0x64, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x4b, 0x65, 0x79, 0x44, 0x6f, 0x65, 0x73, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73,
-	0x74, 0x12, 0x64, 0x0a, 0x16, 0x61, 0x64, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x61, 0x6c, 0x72,
-	0x65, 0x61, 0x64, 0x79, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x1b, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x4b, 0x65, 0x79, 0x41, 0x6c, 0x72, 0x65, 0x61, 0x64,
-	0x79, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64,
-	0x48, 0x00, 0x52, 0x13, 0x61, 0x64, 0x64, 0x4b, 0x65, 0x79, 0x41, 0x6c, 0x72, 0x65, 0x61, 0x64,
-	0x79, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x66, 0x0a, 0x16, 0x64, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e,
-	0x67, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61,
-	0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x14, 0x64, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x12,
-	0x64, 0x0a, 0x16, 0x6c, 0x61, 0x63, 0x6b, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f,
-	0x66, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2d, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x61, 0x63, 0x6b, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x6f, 0x72,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x48, 0x00,
-	0x52, 0x13, 0x6c, 0x61, 0x63, 0x6b, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x6f, 0x72,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x54, 0x0a, 0x10, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x74,
-	0x6f, 0x5f, 0x75, 0x6e, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x28, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x54, 0x72, 0x69, 0x65, 0x73, 0x54, 0x6f, 0x55, 0x6e, 0x73, 0x74, 0x61, 0x6b, 0x65,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x72, 0x69,
-	0x65, 0x73, 0x54, 0x6f, 0x55, 0x6e, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x4e, 0x0a, 0x0e, 0x74,
-	0x72, 0x69, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x1f, 0x20,
+	0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x13, 0x61, 0x64, 0x64, 0x4b, 0x65, 0x79, 0x41, 0x6c, 0x72,
+	0x65, 0x61, 0x64, 0x79, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x66, 0x0a, 0x16, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61,
+	0x6b, 0x69, 0x6e, 0x67, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x66, 0x2e,
+	0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e,
+	0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x14, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x6b, 0x69,
+	0x6e, 0x67, 0x12, 0x64, 0x0a, 0x16, 0x6c, 0x61, 0x63, 0x6b, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x1d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x63, 0x6b, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x46, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e,
+	0x64, 0x48, 0x00, 0x52, 0x13, 0x6c, 0x61, 0x63, 0x6b, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x46, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x54, 0x0a, 0x10, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x75, 0x6e, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x1e, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x65, 0x73, 0x54, 0x6f, 0x55, 0x6e, 0x73, 0x74,
+	0x61, 0x6b, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x0e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x54, 0x6f, 0x55, 0x6e, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x4e,
+	0x0a, 0x0e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65,
+	0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x65, 0x73, 0x54, 0x6f,
+	0x53, 0x74, 0x61, 0x6b, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x48, 0x00,
+	0x52, 0x0c, 0x74, 0x72, 0x69, 0x65, 0x73, 0x54, 0x6f, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x5c,
+	0x0a, 0x12, 0x69, 0x6e, 0x73, 0x75, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73,
+	0x74, 0x61, 0x6b, 0x65, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x66, 0x2e,
+	0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73,
+	0x75, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x11, 0x69, 0x6e, 0x73, 0x75, 0x66,
+	0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x4d, 0x0a, 0x0d,
+	0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x21, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x65, 0x73, 0x54, 0x6f, 0x53, 0x74, 0x61,
-	0x6b, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x0c, 0x74,
-	0x72, 0x69, 0x65, 0x73, 0x54, 0x6f, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x5c, 0x0a, 0x12, 0x69,
-	0x6e, 0x73, 0x75, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x6b,
-	0x65, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61,
-	0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x75, 0x66, 0x66,
-	0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x4b, 0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x11, 0x69, 0x6e, 0x73, 0x75, 0x66, 0x66, 0x69, 0x63,
-	0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x4d, 0x0a, 0x0d, 0x66, 0x75, 0x6e,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x26, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x0c, 0x66, 0x75, 0x6e, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x66, 0x0a, 0x16, 0x6e, 0x65, 0x77, 0x5f,
-	0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65,
-	0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x77, 0x52, 0x65,
-	0x63, 0x65, 0x69, 0x70, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x14, 0x6e, 0x65, 0x77, 0x52,
-	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x92, 0x01, 0x0a, 0x26, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x63,
-	0x69, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x23, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x3c, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x6e, 0x6c, 0x79, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
-	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x48,
-	0x00, 0x52, 0x22, 0x6f, 0x6e, 0x6c, 0x79, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c,
-	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x7d, 0x0a, 0x1f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x6c, 0x61, 0x72,
-	0x67, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35,
+	0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61,
+	0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x0c, 0x66,
+	0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x66, 0x0a, 0x16, 0x6e,
+	0x65, 0x77, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x66,
+	0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65,
+	0x77, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x14, 0x6e,
+	0x65, 0x77, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x92, 0x01, 0x0a, 0x26, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x69, 0x6d, 0x70,
+	0x6c, 0x69, 0x63, 0x69, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x23,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x6e, 0x6c, 0x79, 0x49, 0x6d, 0x70, 0x6c, 0x69,
+	0x63, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69,
+	0x6e, 0x64, 0x48, 0x00, 0x52, 0x22, 0x6f, 0x6e, 0x6c, 0x79, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63,
+	0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x7d, 0x0a, 0x1f, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f,
+	0x6c, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x24, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x35, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x57, 0x69, 0x74, 0x68, 0x4c, 0x61, 0x72, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x1b, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x4c, 0x61, 0x72,
+	0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x21, 0x64, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x25, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x1e, 0x64, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0xa8, 0x01, 0x0a, 0x31, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x64, 0x6f, 0x65, 0x73, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x74, 0x78, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72,
+	0x18, 0x26, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x44, 0x6f, 0x65,
+	0x73, 0x4e, 0x6f, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x2a, 0x64, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x44,
+	0x6f, 0x65, 0x73, 0x4e, 0x6f, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x78, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x72, 0x12, 0x64, 0x0a, 0x17, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64,
+	0x18, 0x27, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x4b, 0x69,
+	0x6e, 0x64, 0x48, 0x00, 0x52, 0x15, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x7b, 0x0a, 0x20, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x28, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x1c, 0x64, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x4b, 0x65, 0x79, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x74, 0x0a, 0x1d, 0x64, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x29, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2f, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x4b, 0x69, 0x6e, 0x64,
+	0x48, 0x00, 0x52, 0x1a, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x78,
+	0x0a, 0x1f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x6f, 0x6f, 0x5f, 0x6c, 0x61, 0x72, 0x67,
+	0x65, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61,
+	0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x54, 0x6f, 0x6f,
+	0x4c, 0x61, 0x72, 0x67, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x1b, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65,
+	0x54, 0x6f, 0x6f, 0x4c, 0x61, 0x72, 0x67, 0x65, 0x12, 0x9a, 0x01, 0x0a, 0x2b, 0x6e, 0x6f, 0x6e,
+	0x5f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b,
 	0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x57, 0x69,
-	0x74, 0x68, 0x4c, 0x61, 0x72, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x4b, 0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x1b, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x4c, 0x61, 0x72, 0x67, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x3e, 0x0a, 0x1d,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x45, 0x78,
-	0x69, 0x73, 0x74, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a,
+	0x2e, 0x4e, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x6f, 0x45, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x26, 0x6e,
+	0x6f, 0x6e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x54, 0x6f, 0x45, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x3e, 0x0a,
+	0x1d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x45,
+	0x78, 0x69, 0x73, 0x74, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x3d, 0x0a,
+	0x1c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x6f, 0x65, 0x73, 0x4e, 0x6f, 0x74, 0x45,
+	0x78, 0x69, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a,
 	0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x3d, 0x0a, 0x1c,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x6f, 0x65, 0x73, 0x4e, 0x6f, 0x74, 0x45, 0x78,
-	0x69, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x9f, 0x01, 0x0a, 0x25,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x6e, 0x6c,
-	0x79, 0x42, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x72, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
-	0x72, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x72, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x64, 0x65, 0x63,
-	0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x70, 0x72, 0x65, 0x64, 0x65, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x68, 0x0a,
-	0x20, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x6f,
-	0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e,
-	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64,
-	0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x64, 0x65, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x64, 0x65, 0x63,
-	0x65, 0x73, 0x73, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x56, 0x0a, 0x1a, 0x41, 0x63, 0x74, 0x6f, 0x72,
-	0x4e, 0x6f, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22,
-	0x7a, 0x0a, 0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x44, 0x6f, 0x65, 0x73,
-	0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e,
-	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64,
-	0x12, 0x39, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
-	0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0x78, 0x0a, 0x1c, 0x41,
-	0x64, 0x64, 0x4b, 0x65, 0x79, 0x41, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x45, 0x78, 0x69, 0x73,
-	0x74, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0x3e, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x45, 0x72, 0x72,
+	0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x9f, 0x01, 0x0a,
+	0x25, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x6e,
+	0x6c, 0x79, 0x42, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x72, 0x45, 0x72, 0x72,
 	0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
 	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x70, 0x0a, 0x1c, 0x4c, 0x61, 0x63, 0x6b, 0x42, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x46, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x07,
-	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x38, 0x0a, 0x17, 0x54, 0x72, 0x69, 0x65, 0x73,
-	0x54, 0x6f, 0x55, 0x6e, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69,
+	0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x72, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x64, 0x65,
+	0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x70, 0x72, 0x65, 0x64, 0x65, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x68,
+	0x0a, 0x20, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e,
+	0x6f, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69,
 	0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
-	0x64, 0x22, 0xc9, 0x01, 0x0a, 0x15, 0x54, 0x72, 0x69, 0x65, 0x73, 0x54, 0x6f, 0x53, 0x74, 0x61,
-	0x6b, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x05, 0x73, 0x74,
-	0x61, 0x6b, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x66, 0x2e, 0x6e,
-	0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x67, 0x49,
-	0x6e, 0x74, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x6c, 0x6f, 0x63,
-	0x6b, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x66, 0x2e, 0x6e,
-	0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x67, 0x49,
-	0x6e, 0x74, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x07, 0x62, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x66,
-	0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69,
-	0x67, 0x49, 0x6e, 0x74, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xa8, 0x01,
-	0x0a, 0x1a, 0x49, 0x6e, 0x73, 0x75, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x64, 0x65, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x64, 0x65,
+	0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x56, 0x0a, 0x1a, 0x41, 0x63, 0x74, 0x6f,
+	0x72, 0x4e, 0x6f, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64,
+	0x22, 0x7a, 0x0a, 0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x44, 0x6f, 0x65,
+	0x73, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69,
+	0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
+	0x79, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0x78, 0x0a, 0x1c,
+	0x41, 0x64, 0x64, 0x4b, 0x65, 0x79, 0x41, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x45, 0x78, 0x69,
+	0x73, 0x74, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x09, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0x3e, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x70, 0x0a, 0x1c, 0x4c, 0x61, 0x63, 0x6b, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x67, 0x49, 0x6e, 0x74, 0x52,
+	0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x38, 0x0a, 0x17, 0x54, 0x72, 0x69, 0x65,
+	0x73, 0x54, 0x6f, 0x55, 0x6e, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b,
+	0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x49, 0x64, 0x22, 0xc9, 0x01, 0x0a, 0x15, 0x54, 0x72, 0x69, 0x65, 0x73, 0x54, 0x6f, 0x53, 0x74,
 	0x61, 0x6b, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
 	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x05, 0x73,
 	0x74, 0x61, 0x6b, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x66, 0x2e,
 	0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x67,
-	0x49, 0x6e, 0x74, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x3c, 0x0a, 0x0d, 0x6d, 0x69,
-	0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x69,
-	0x6d, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x22, 0x54, 0x0a, 0x15, 0x46, 0x75, 0x6e, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e,
-	0x64, 0x12, 0x3b, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x25, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x5e,
-	0x0a, 0x1d, 0x4e, 0x65, 0x77, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x12,
-	0x3d, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27,
-	0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x4c,
-	0x0a, 0x2b, 0x4f, 0x6e, 0x6c, 0x79, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c,
-	0x6f, 0x77, 0x65, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a,
+	0x49, 0x6e, 0x74, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x66, 0x2e,
+	0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x67,
+	0x49, 0x6e, 0x74, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x07, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73,
+	0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x69, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xa8,
+	0x01, 0x0a, 0x1a, 0x49, 0x6e, 0x73, 0x75, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x6b, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a,
 	0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x45, 0x0a, 0x24,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x57, 0x69, 0x74,
-	0x68, 0x4c, 0x61, 0x72, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x49, 0x64, 0x22, 0x41, 0x0a, 0x0a, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x74,
-	0x68, 0x12, 0x33, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1f, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x49, 0x74, 0x65, 0x6d,
-	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x7b, 0x0a, 0x0e, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65,
-	0x50, 0x61, 0x74, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x2f, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x48,
-	0x61, 0x73, 0x68, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x38, 0x0a, 0x09, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x73,
-	0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0xc0, 0x04, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4d,
-	0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0d,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x50, 0x0a,
-	0x0f, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x43,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52,
-	0x0e, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12,
-	0x4a, 0x0a, 0x0d, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x61, 0x6c, 0x6c,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x66,
-	0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x3d, 0x0a, 0x08, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
-	0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00,
-	0x52, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x05, 0x73, 0x74,
-	0x61, 0x6b, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x66, 0x2e, 0x6e,
-	0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x6b,
-	0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65,
-	0x12, 0x38, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x4b, 0x65, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x48, 0x00, 0x52, 0x06, 0x61, 0x64, 0x64, 0x4b, 0x65, 0x79, 0x12, 0x41, 0x0a, 0x0a, 0x64, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
-	0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x48, 0x00, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x4d, 0x0a,
-	0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0d, 0x64,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x08, 0x0a, 0x06,
+	0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x66,
+	0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69,
+	0x67, 0x49, 0x6e, 0x74, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x3c, 0x0a, 0x0d, 0x6d,
+	0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x0c, 0x6d, 0x69, 0x6e,
+	0x69, 0x6d, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x22, 0x54, 0x0a, 0x15, 0x46, 0x75, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69,
+	0x6e, 0x64, 0x12, 0x3b, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x25, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x5e, 0x0a, 0x1d, 0x4e, 0x65, 0x77, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64,
+	0x12, 0x3d, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x27, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x4c, 0x0a, 0x2b, 0x4f, 0x6e, 0x6c, 0x79, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x45, 0x0a,
+	0x24, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x57, 0x69,
+	0x74, 0x68, 0x4c, 0x61, 0x72, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x64, 0x22, 0x24, 0x0a, 0x22, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x22, 0x6e, 0x0a, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x44, 0x6f, 0x65, 0x73, 0x4e, 0x6f, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x78,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x44, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x64, 0x4b, 0x69, 0x6e, 0x64, 0x22, 0x59, 0x0a, 0x20, 0x44, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b,
+	0x65, 0x79, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x35, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x66, 0x2e,
+	0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x54, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x62, 0x0a, 0x1e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4e, 0x6f, 0x6e, 0x63, 0x65,
+	0x4b, 0x69, 0x6e, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61,
+	0x6b, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61,
+	0x6b, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x69, 0x0a, 0x1f, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x54, 0x6f, 0x6f,
+	0x4c, 0x61, 0x72, 0x67, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x6e, 0x63, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x70, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x75, 0x70, 0x70, 0x65, 0x72, 0x42, 0x6f, 0x75, 0x6e,
+	0x64, 0x22, 0x4b, 0x0a, 0x2a, 0x4e, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x61, 0x62,
+	0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x6f, 0x45, 0x78, 0x69, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x41,
+	0x0a, 0x0a, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x33, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x66, 0x2e,
+	0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x72,
+	0x6b, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x22, 0x7b, 0x0a, 0x0e, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x49,
+	0x74, 0x65, 0x6d, 0x12, 0x2f, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x52, 0x04,
+	0x68, 0x61, 0x73, 0x68, 0x12, 0x38, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61,
+	0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x85,
+	0x05, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x0e, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x50, 0x0a, 0x0f, 0x64, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0e, 0x64, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x4a, 0x0a, 0x0d, 0x66, 0x75,
+	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x3d, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65,
+	0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x08, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73,
+	0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x64, 0x64, 0x4b, 0x65, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x06, 0x61,
+	0x64, 0x64, 0x4b, 0x65, 0x79, 0x12, 0x41, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x66, 0x2e, 0x6e,
+	0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4b, 0x65, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x09, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x4d, 0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x66, 0x2e, 0x6e,
+	0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x00, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x42, 0x08, 0x0a, 0x06,
 	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x15, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2a, 0x0a,
-	0x14, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x12, 0x46, 0x75,
-	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x03, 0x67, 0x61, 0x73, 0x12, 0x31, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65,
-	0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x67, 0x49, 0x6e,
-	0x74, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x22, 0x43, 0x0a, 0x0e, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x07,
-	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x81, 0x01,
+	0x0a, 0x14, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x6f,
+	0x64, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
 	0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x42, 0x69, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x22,
-	0x77, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d,
-	0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x52, 0x08, 0x63, 0x6f, 0x64, 0x65,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x22, 0xe5, 0x01, 0x0a, 0x12, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61,
+	0x6c, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x10, 0x0a,
+	0x03, 0x67, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x67, 0x61, 0x73, 0x12,
+	0x31, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x69, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x61, 0x72, 0x67, 0x73, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61,
+	0x73, 0x68, 0x52, 0x08, 0x61, 0x72, 0x67, 0x73, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1b, 0x0a, 0x09,
+	0x61, 0x72, 0x67, 0x73, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x61, 0x72, 0x67, 0x73, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x43, 0x0a, 0x0e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x07, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73,
+	0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x69, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x22, 0x77,
+	0x0a, 0x0b, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73,
+	0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x69, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x39, 0x0a, 0x0a,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x09, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0x84, 0x01, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x4b,
+	0x65, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73,
+	0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4b, 0x65, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61,
+	0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x4b, 0x65, 0x79, 0x52, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x22, 0x4c,
+	0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
+	0x79, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0x3c, 0x0a, 0x13,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61,
+	0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x65, 0x6e,
+	0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x49, 0x64, 0x22, 0x9a, 0x01, 0x0a, 0x14, 0x53,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x48, 0x0a,
+	0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xfc, 0x01, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x66, 0x2e, 0x6e,
+	0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6e,
+	0x6f, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63,
+	0x65, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x61, 0x78,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x09, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0x67, 0x0a, 0x09, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x0a, 0x70, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
 	0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x42, 0x69, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x39, 0x0a,
-	0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x09, 0x70,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0x84, 0x01, 0x0a, 0x0c, 0x41, 0x64, 0x64,
-	0x4b, 0x65, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0xbd, 0x01, 0x0a, 0x13, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x50, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4e, 0x0a, 0x0d, 0x66, 0x75, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x50, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x66, 0x75, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x48, 0x0a, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x5f,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73,
+	0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x75, 0x6c, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x93, 0x01, 0x0a, 0x16, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c,
+	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x09, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
 	0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69,
-	0x63, 0x4b, 0x65, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6b,
-	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65,
-	0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x4b, 0x65, 0x79, 0x52, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x22,
-	0x4c, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x41, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
-	0x65, 0x79, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0x3c, 0x0a,
-	0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69,
-	0x61, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x65,
-	0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x49, 0x64, 0x22, 0x67, 0x0a, 0x09, 0x41,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x44,
-	0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x50, 0x65,
-	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x22, 0xbd, 0x01, 0x0a, 0x13, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b,
-	0x65, 0x79, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4e, 0x0a, 0x0d,
-	0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61,
-	0x6c, 0x6c, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0c,
-	0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x48, 0x0a, 0x0b,
-	0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x25, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x75, 0x6c, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x65,
-	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x75, 0x6c, 0x6c,
-	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x93, 0x01, 0x0a, 0x16, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x35, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x66, 0x2e, 0x6e, 0x65, 0x61, 0x72, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x09, 0x61, 0x6c, 0x6c,
-	0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63,
-	0x65, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6d,
-	0x65, 0x74, 0x68, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x46, 0x75,
-	0x6c, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x2a, 0x27, 0x0a, 0x09, 0x43, 0x75, 0x72, 0x76, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12,
-	0x0b, 0x0a, 0x07, 0x45, 0x44, 0x32, 0x35, 0x35, 0x31, 0x39, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09,
-	0x53, 0x45, 0x43, 0x50, 0x32, 0x35, 0x36, 0x4b, 0x31, 0x10, 0x01, 0x2a, 0x2c, 0x0a, 0x11, 0x45,
+	0x42, 0x69, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x46, 0x75, 0x6c, 0x6c, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2a, 0x27, 0x0a,
+	0x09, 0x43, 0x75, 0x72, 0x76, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x44,
+	0x32, 0x35, 0x35, 0x31, 0x39, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43, 0x50, 0x32,
+	0x35, 0x36, 0x4b, 0x31, 0x10, 0x01, 0x2a, 0x2c, 0x0a, 0x11, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x17, 0x0a, 0x13, 0x45,
 	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x12, 0x17, 0x0a, 0x13, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x56, 0x31, 0x10, 0x00, 0x2a, 0xa9, 0x01, 0x0a, 0x14, 0x46, 0x75,
-	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x53,
-	0x65, 0x72, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x69, 0x6e, 0x6b,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x4d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x02, 0x12,
-	0x0c, 0x0a, 0x08, 0x57, 0x61, 0x73, 0x6d, 0x54, 0x72, 0x61, 0x70, 0x10, 0x03, 0x12, 0x14, 0x0a,
-	0x10, 0x57, 0x61, 0x73, 0x6d, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x48, 0x6f, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x5f, 0x45, 0x56, 0x4d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10,
-	0x06, 0x12, 0x12, 0x0a, 0x0e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x10, 0x07, 0x2a, 0xed, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
-	0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x12, 0x18, 0x0a, 0x14, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x64, 0x65,
-	0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x49, 0x64, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x49, 0x6e,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x6e, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x49, 0x64, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x44,
-	0x61, 0x74, 0x61, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x10, 0x03, 0x12,
-	0x1f, 0x0a, 0x1b, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x10, 0x04,
-	0x12, 0x27, 0x0a, 0x23, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x44,
-	0x61, 0x74, 0x61, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x45,
-	0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x10, 0x05, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x10, 0x06, 0x2a, 0xbe, 0x02, 0x0a, 0x0e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x54, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x15, 0x49, 0x6e, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x53, 0x69,
-	0x67, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x69, 0x67, 0x6e,
-	0x65, 0x72, 0x44, 0x6f, 0x65, 0x73, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x10, 0x02,
-	0x12, 0x10, 0x0a, 0x0c, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4e, 0x6f, 0x6e, 0x63, 0x65,
-	0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x54, 0x6f, 0x6f, 0x4c, 0x61,
-	0x72, 0x67, 0x65, 0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x10, 0x05, 0x12, 0x14, 0x0a, 0x10,
-	0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x10, 0x06, 0x12, 0x14, 0x0a, 0x10, 0x4e, 0x6f, 0x74, 0x45, 0x6e, 0x6f, 0x75, 0x67, 0x68, 0x42,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x10, 0x07, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x61, 0x63, 0x6b,
-	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x10,
-	0x08, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x6f, 0x73, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f,
-	0x77, 0x10, 0x09, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x43, 0x68,
-	0x61, 0x69, 0x6e, 0x10, 0x0a, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64,
-	0x10, 0x0b, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x0c, 0x12, 0x1b, 0x0a, 0x17, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x45, 0x78, 0x63, 0x65,
-	0x65, 0x64, 0x65, 0x64, 0x10, 0x0d, 0x2a, 0x20, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04, 0x6c, 0x65, 0x66, 0x74, 0x10, 0x00, 0x12, 0x09, 0x0a,
-	0x05, 0x72, 0x69, 0x67, 0x68, 0x74, 0x10, 0x01, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
-	0x66, 0x61, 0x73, 0x74, 0x2f, 0x66, 0x69, 0x72, 0x65, 0x68, 0x6f, 0x73, 0x65, 0x2d, 0x6e, 0x65,
-	0x61, 0x72, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x62, 0x2f, 0x73, 0x66, 0x2f, 0x6e,
-	0x65, 0x61, 0x72, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x62, 0x6e, 0x65,
-	0x61, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x56, 0x31, 0x10, 0x00, 0x2a, 0xa9, 0x01, 0x0a, 0x14, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x10, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x69, 0x6e, 0x6b, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x57, 0x61,
+	0x73, 0x6d, 0x54, 0x72, 0x61, 0x70, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x57, 0x61, 0x73, 0x6d,
+	0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x04, 0x12, 0x0d,
+	0x0a, 0x09, 0x48, 0x6f, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x05, 0x12, 0x0d, 0x0a,
+	0x09, 0x5f, 0x45, 0x56, 0x4d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x06, 0x12, 0x12, 0x0a, 0x0e,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x07,
+	0x2a, 0x86, 0x02, 0x0a, 0x16, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x14, 0x49,
+	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x64, 0x65, 0x63, 0x65, 0x73, 0x73, 0x6f,
+	0x72, 0x49, 0x64, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
+	0x64, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x53, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x10, 0x02, 0x12,
+	0x19, 0x0a, 0x15, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x52, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x10, 0x04, 0x12, 0x27, 0x0a, 0x23, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x44, 0x61, 0x74, 0x61, 0x44, 0x65,
+	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x45, 0x78, 0x63, 0x65, 0x65, 0x64,
+	0x65, 0x64, 0x10, 0x05, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x06,
+	0x12, 0x17, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x45,
+	0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x10, 0x07, 0x2a, 0x93, 0x03, 0x0a, 0x0e, 0x49, 0x6e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x54, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x15,
+	0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x49, 0x6e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12,
+	0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x44, 0x6f, 0x65, 0x73, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69,
+	0x73, 0x74, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4e,
+	0x6f, 0x6e, 0x63, 0x65, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x54,
+	0x6f, 0x6f, 0x4c, 0x61, 0x72, 0x67, 0x65, 0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x10, 0x05,
+	0x12, 0x14, 0x0a, 0x10, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x10, 0x06, 0x12, 0x14, 0x0a, 0x10, 0x4e, 0x6f, 0x74, 0x45, 0x6e, 0x6f,
+	0x75, 0x67, 0x68, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x10, 0x07, 0x12, 0x17, 0x0a, 0x13,
+	0x4c, 0x61, 0x63, 0x6b, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x6f, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x10, 0x08, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x6f, 0x73, 0x74, 0x4f, 0x76, 0x65,
+	0x72, 0x66, 0x6c, 0x6f, 0x77, 0x10, 0x09, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x6e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x10, 0x0a, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x64, 0x10, 0x0b, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x0c, 0x12, 0x1b, 0x0a,
+	0x17, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65,
+	0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x10, 0x0d, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x6e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x10, 0x0e, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x0f, 0x12, 0x12, 0x0a, 0x0e, 0x53,
+	0x68, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x10, 0x10, 0x12,
+	0x0e, 0x0a, 0x0a, 0x53, 0x68, 0x61, 0x72, 0x64, 0x53, 0x74, 0x75, 0x63, 0x6b, 0x10, 0x11, 0x2a,
+	0x20, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04,
+	0x6c, 0x65, 0x66, 0x74, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x72, 0x69, 0x67, 0x68, 0x74, 0x10,
+	0x01, 0x42, 0x42, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x66, 0x61, 0x73, 0x74, 0x2f, 0x66, 0x69,
+	0x72, 0x65, 0x68, 0x6f, 0x73, 0x65, 0x2d, 0x6e, 0x65, 0x61, 0x72, 0x2f, 0x70, 0x62, 0x2f, 0x73,
+	0x66, 0x2f, 0x6e, 0x65, 0x61, 0x72, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x70,
+	0x62, 0x6e, 0x65, 0x61, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -6864,7 +7691,7 @@ func file_sf_near_type_v1_type_proto_rawDescGZIP() []byte {
 }
 
 var file_sf_near_type_v1_type_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_sf_near_type_v1_type_proto_msgTypes = make([]protoimpl.MessageInfo, 80)
+var file_sf_near_type_v1_type_proto_msgTypes = make([]protoimpl.MessageInfo, 89)
 var file_sf_near_type_v1_type_proto_goTypes = []interface{}{
 	(CurveKind)(0),                                          // 0: sf.near.type.v1.CurveKind
 	(ExecutionMetadata)(0),                                  // 1: sf.near.type.v1.ExecutionMetadata
@@ -6919,39 +7746,48 @@ var file_sf_near_type_v1_type_proto_goTypes = []interface{}{
 	(*NewReceiptValidationErrorKind)(nil),                   // 50: sf.near.type.v1.NewReceiptValidationErrorKind
 	(*OnlyImplicitAccountCreationAllowedErrorKind)(nil),     // 51: sf.near.type.v1.OnlyImplicitAccountCreationAllowedErrorKind
 	(*DeleteAccountWithLargeStateErrorKind)(nil),            // 52: sf.near.type.v1.DeleteAccountWithLargeStateErrorKind
-	(*MerklePath)(nil),                                      // 53: sf.near.type.v1.MerklePath
-	(*MerklePathItem)(nil),                                  // 54: sf.near.type.v1.MerklePathItem
-	(*Action)(nil),                                          // 55: sf.near.type.v1.Action
-	(*CreateAccountAction)(nil),                             // 56: sf.near.type.v1.CreateAccountAction
-	(*DeployContractAction)(nil),                            // 57: sf.near.type.v1.DeployContractAction
-	(*FunctionCallAction)(nil),                              // 58: sf.near.type.v1.FunctionCallAction
-	(*TransferAction)(nil),                                  // 59: sf.near.type.v1.TransferAction
-	(*StakeAction)(nil),                                     // 60: sf.near.type.v1.StakeAction
-	(*AddKeyAction)(nil),                                    // 61: sf.near.type.v1.AddKeyAction
-	(*DeleteKeyAction)(nil),                                 // 62: sf.near.type.v1.DeleteKeyAction
-	(*DeleteAccountAction)(nil),                             // 63: sf.near.type.v1.DeleteAccountAction
-	(*AccessKey)(nil),                                       // 64: sf.near.type.v1.AccessKey
-	(*AccessKeyPermission)(nil),                             // 65: sf.near.type.v1.AccessKeyPermission
-	(*FunctionCallPermission)(nil),                          // 66: sf.near.type.v1.FunctionCallPermission
-	(*FullAccessPermission)(nil),                            // 67: sf.near.type.v1.FullAccessPermission
-	(*StateChangeCause_NotWritableToDisk)(nil),              // 68: sf.near.type.v1.StateChangeCause.NotWritableToDisk
-	(*StateChangeCause_InitialState)(nil),                   // 69: sf.near.type.v1.StateChangeCause.InitialState
-	(*StateChangeCause_TransactionProcessing)(nil),          // 70: sf.near.type.v1.StateChangeCause.TransactionProcessing
-	(*StateChangeCause_ActionReceiptProcessingStarted)(nil), // 71: sf.near.type.v1.StateChangeCause.ActionReceiptProcessingStarted
-	(*StateChangeCause_ActionReceiptGasReward)(nil),         // 72: sf.near.type.v1.StateChangeCause.ActionReceiptGasReward
-	(*StateChangeCause_ReceiptProcessing)(nil),              // 73: sf.near.type.v1.StateChangeCause.ReceiptProcessing
-	(*StateChangeCause_PostponedReceipt)(nil),               // 74: sf.near.type.v1.StateChangeCause.PostponedReceipt
-	(*StateChangeCause_UpdatedDelayedReceipts)(nil),         // 75: sf.near.type.v1.StateChangeCause.UpdatedDelayedReceipts
-	(*StateChangeCause_ValidatorAccountsUpdate)(nil),        // 76: sf.near.type.v1.StateChangeCause.ValidatorAccountsUpdate
-	(*StateChangeCause_Migration)(nil),                      // 77: sf.near.type.v1.StateChangeCause.Migration
-	(*StateChangeValue_AccountUpdate)(nil),                  // 78: sf.near.type.v1.StateChangeValue.AccountUpdate
-	(*StateChangeValue_AccountDeletion)(nil),                // 79: sf.near.type.v1.StateChangeValue.AccountDeletion
-	(*StateChangeValue_AccessKeyUpdate)(nil),                // 80: sf.near.type.v1.StateChangeValue.AccessKeyUpdate
-	(*StateChangeValue_AccessKeyDeletion)(nil),              // 81: sf.near.type.v1.StateChangeValue.AccessKeyDeletion
-	(*StateChangeValue_DataUpdate)(nil),                     // 82: sf.near.type.v1.StateChangeValue.DataUpdate
-	(*StateChangeValue_DataDeletion)(nil),                   // 83: sf.near.type.v1.StateChangeValue.DataDeletion
-	(*StateChangeValue_ContractCodeUpdate)(nil),             // 84: sf.near.type.v1.StateChangeValue.ContractCodeUpdate
-	(*StateChangeValue_ContractCodeDeletion)(nil),           // 85: sf.near.type.v1.StateChangeValue.ContractCodeDeletion
+	(*DelegateActionInvalidSignatureKind)(nil),              // 53: sf.near.type.v1.DelegateActionInvalidSignatureKind
+	(*DelegateActionSenderDoesNotMatchTxReceiverKind)(nil),  // 54: sf.near.type.v1.DelegateActionSenderDoesNotMatchTxReceiverKind
+	(*DelegateActionExpiredKind)(nil),                       // 55: sf.near.type.v1.DelegateActionExpiredKind
+	(*DelegateActionAccessKeyErrorKind)(nil),                // 56: sf.near.type.v1.DelegateActionAccessKeyErrorKind
+	(*DelegateActionInvalidNonceKind)(nil),                  // 57: sf.near.type.v1.DelegateActionInvalidNonceKind
+	(*DelegateActionNonceTooLargeKind)(nil),                 // 58: sf.near.type.v1.DelegateActionNonceTooLargeKind
+	(*NonRefundableTransferToExistingAccountKind)(nil),      // 59: sf.near.type.v1.NonRefundableTransferToExistingAccountKind
+	(*MerklePath)(nil),                                      // 60: sf.near.type.v1.MerklePath
+	(*MerklePathItem)(nil),                                  // 61: sf.near.type.v1.MerklePathItem
+	(*Action)(nil),                                          // 62: sf.near.type.v1.Action
+	(*CreateAccountAction)(nil),                             // 63: sf.near.type.v1.CreateAccountAction
+	(*DeployContractAction)(nil),                            // 64: sf.near.type.v1.DeployContractAction
+	(*FunctionCallAction)(nil),                              // 65: sf.near.type.v1.FunctionCallAction
+	(*TransferAction)(nil),                                  // 66: sf.near.type.v1.TransferAction
+	(*StakeAction)(nil),                                     // 67: sf.near.type.v1.StakeAction
+	(*AddKeyAction)(nil),                                    // 68: sf.near.type.v1.AddKeyAction
+	(*DeleteKeyAction)(nil),                                 // 69: sf.near.type.v1.DeleteKeyAction
+	(*DeleteAccountAction)(nil),                             // 70: sf.near.type.v1.DeleteAccountAction
+	(*SignedDelegateAction)(nil),                            // 71: sf.near.type.v1.SignedDelegateAction
+	(*DelegateAction)(nil),                                  // 72: sf.near.type.v1.DelegateAction
+	(*AccessKey)(nil),                                       // 73: sf.near.type.v1.AccessKey
+	(*AccessKeyPermission)(nil),                             // 74: sf.near.type.v1.AccessKeyPermission
+	(*FunctionCallPermission)(nil),                          // 75: sf.near.type.v1.FunctionCallPermission
+	(*FullAccessPermission)(nil),                            // 76: sf.near.type.v1.FullAccessPermission
+	(*StateChangeCause_NotWritableToDisk)(nil),              // 77: sf.near.type.v1.StateChangeCause.NotWritableToDisk
+	(*StateChangeCause_InitialState)(nil),                   // 78: sf.near.type.v1.StateChangeCause.InitialState
+	(*StateChangeCause_TransactionProcessing)(nil),          // 79: sf.near.type.v1.StateChangeCause.TransactionProcessing
+	(*StateChangeCause_ActionReceiptProcessingStarted)(nil), // 80: sf.near.type.v1.StateChangeCause.ActionReceiptProcessingStarted
+	(*StateChangeCause_ActionReceiptGasReward)(nil),         // 81: sf.near.type.v1.StateChangeCause.ActionReceiptGasReward
+	(*StateChangeCause_ReceiptProcessing)(nil),              // 82: sf.near.type.v1.StateChangeCause.ReceiptProcessing
+	(*StateChangeCause_PostponedReceipt)(nil),               // 83: sf.near.type.v1.StateChangeCause.PostponedReceipt
+	(*StateChangeCause_UpdatedDelayedReceipts)(nil),         // 84: sf.near.type.v1.StateChangeCause.UpdatedDelayedReceipts
+	(*StateChangeCause_ValidatorAccountsUpdate)(nil),        // 85: sf.near.type.v1.StateChangeCause.ValidatorAccountsUpdate
+	(*StateChangeCause_Migration)(nil),                      // 86: sf.near.type.v1.StateChangeCause.Migration
+	(*StateChangeValue_AccountUpdate)(nil),                  // 87: sf.near.type.v1.StateChangeValue.AccountUpdate
+	(*StateChangeValue_AccountDeletion)(nil),                // 88: sf.near.type.v1.StateChangeValue.AccountDeletion
+	(*StateChangeValue_AccessKeyUpdate)(nil),                // 89: sf.near.type.v1.StateChangeValue.AccessKeyUpdate
+	(*StateChangeValue_AccessKeyDeletion)(nil),              // 90: sf.near.type.v1.StateChangeValue.AccessKeyDeletion
+	(*StateChangeValue_DataUpdate)(nil),                     // 91: sf.near.type.v1.StateChangeValue.DataUpdate
+	(*StateChangeValue_DataDeletion)(nil),                   // 92: sf.near.type.v1.StateChangeValue.DataDeletion
+	(*StateChangeValue_ContractCodeUpdate)(nil),             // 93: sf.near.type.v1.StateChangeValue.ContractCodeUpdate
+	(*StateChangeValue_ContractCodeDeletion)(nil),           // 94: sf.near.type.v1.StateChangeValue.ContractCodeDeletion
 }
 var file_sf_near_type_v1_type_proto_depIdxs = []int32{
 	12,  // 0: sf.near.type.v1.Block.header:type_name -> sf.near.type.v1.BlockHeader
@@ -6961,24 +7797,24 @@ var file_sf_near_type_v1_type_proto_depIdxs = []int32{
 	12,  // 4: sf.near.type.v1.HeaderOnlyBlock.header:type_name -> sf.near.type.v1.BlockHeader
 	10,  // 5: sf.near.type.v1.StateChangeWithCause.value:type_name -> sf.near.type.v1.StateChangeValue
 	9,   // 6: sf.near.type.v1.StateChangeWithCause.cause:type_name -> sf.near.type.v1.StateChangeCause
-	68,  // 7: sf.near.type.v1.StateChangeCause.not_writable_to_disk:type_name -> sf.near.type.v1.StateChangeCause.NotWritableToDisk
-	69,  // 8: sf.near.type.v1.StateChangeCause.initial_state:type_name -> sf.near.type.v1.StateChangeCause.InitialState
-	70,  // 9: sf.near.type.v1.StateChangeCause.transaction_processing:type_name -> sf.near.type.v1.StateChangeCause.TransactionProcessing
-	71,  // 10: sf.near.type.v1.StateChangeCause.action_receipt_processing_started:type_name -> sf.near.type.v1.StateChangeCause.ActionReceiptProcessingStarted
-	72,  // 11: sf.near.type.v1.StateChangeCause.action_receipt_gas_reward:type_name -> sf.near.type.v1.StateChangeCause.ActionReceiptGasReward
-	73,  // 12: sf.near.type.v1.StateChangeCause.receipt_processing:type_name -> sf.near.type.v1.StateChangeCause.ReceiptProcessing
-	74,  // 13: sf.near.type.v1.StateChangeCause.postponed_receipt:type_name -> sf.near.type.v1.StateChangeCause.PostponedReceipt
-	75,  // 14: sf.near.type.v1.StateChangeCause.updated_delayed_receipts:type_name -> sf.near.type.v1.StateChangeCause.UpdatedDelayedReceipts
-	76,  // 15: sf.near.type.v1.StateChangeCause.validator_accounts_update:type_name -> sf.near.type.v1.StateChangeCause.ValidatorAccountsUpdate
-	77,  // 16: sf.near.type.v1.StateChangeCause.migration:type_name -> sf.near.type.v1.StateChangeCause.Migration
-	78,  // 17: sf.near.type.v1.StateChangeValue.account_update:type_name -> sf.near.type.v1.StateChangeValue.AccountUpdate
-	79,  // 18: sf.near.type.v1.StateChangeValue.account_deletion:type_name -> sf.near.type.v1.StateChangeValue.AccountDeletion
-	80,  // 19: sf.near.type.v1.StateChangeValue.access_key_update:type_name -> sf.near.type.v1.StateChangeValue.AccessKeyUpdate
-	81,  // 20: sf.near.type.v1.StateChangeValue.access_key_deletion:type_name -> sf.near.type.v1.StateChangeValue.AccessKeyDeletion
-	82,  // 21: sf.near.type.v1.StateChangeValue.data_update:type_name -> sf.near.type.v1.StateChangeValue.DataUpdate
-	83,  // 22: sf.near.type.v1.StateChangeValue.data_deletion:type_name -> sf.near.type.v1.StateChangeValue.DataDeletion
-	84,  // 23: sf.near.type.v1.StateChangeValue.contract_code_update:type_name -> sf.near.type.v1.StateChangeValue.ContractCodeUpdate
-	85,  // 24: sf.near.type.v1.StateChangeValue.contract_deletion:type_name -> sf.near.type.v1.StateChangeValue.ContractCodeDeletion
+	77,  // 7: sf.near.type.v1.StateChangeCause.not_writable_to_disk:type_name -> sf.near.type.v1.StateChangeCause.NotWritableToDisk
+	78,  // 8: sf.near.type.v1.StateChangeCause.initial_state:type_name -> sf.near.type.v1.StateChangeCause.InitialState
+	79,  // 9: sf.near.type.v1.StateChangeCause.transaction_processing:type_name -> sf.near.type.v1.StateChangeCause.TransactionProcessing
+	80,  // 10: sf.near.type.v1.StateChangeCause.action_receipt_processing_started:type_name -> sf.near.type.v1.StateChangeCause.ActionReceiptProcessingStarted
+	81,  // 11: sf.near.type.v1.StateChangeCause.action_receipt_gas_reward:type_name -> sf.near.type.v1.StateChangeCause.ActionReceiptGasReward
+	82,  // 12: sf.near.type.v1.StateChangeCause.receipt_processing:type_name -> sf.near.type.v1.StateChangeCause.ReceiptProcessing
+	83,  // 13: sf.near.type.v1.StateChangeCause.postponed_receipt:type_name -> sf.near.type.v1.StateChangeCause.PostponedReceipt
+	84,  // 14: sf.near.type.v1.StateChangeCause.updated_delayed_receipts:type_name -> sf.near.type.v1.StateChangeCause.UpdatedDelayedReceipts
+	85,  // 15: sf.near.type.v1.StateChangeCause.validator_accounts_update:type_name -> sf.near.type.v1.StateChangeCause.ValidatorAccountsUpdate
+	86,  // 16: sf.near.type.v1.StateChangeCause.migration:type_name -> sf.near.type.v1.StateChangeCause.Migration
+	87,  // 17: sf.near.type.v1.StateChangeValue.account_update:type_name -> sf.near.type.v1.StateChangeValue.AccountUpdate
+	88,  // 18: sf.near.type.v1.StateChangeValue.account_deletion:type_name -> sf.near.type.v1.StateChangeValue.AccountDeletion
+	89,  // 19: sf.near.type.v1.StateChangeValue.access_key_update:type_name -> sf.near.type.v1.StateChangeValue.AccessKeyUpdate
+	90,  // 20: sf.near.type.v1.StateChangeValue.access_key_deletion:type_name -> sf.near.type.v1.StateChangeValue.AccessKeyDeletion
+	91,  // 21: sf.near.type.v1.StateChangeValue.data_update:type_name -> sf.near.type.v1.StateChangeValue.DataUpdate
+	92,  // 22: sf.near.type.v1.StateChangeValue.data_deletion:type_name -> sf.near.type.v1.StateChangeValue.DataDeletion
+	93,  // 23: sf.near.type.v1.StateChangeValue.contract_code_update:type_name -> sf.near.type.v1.StateChangeValue.ContractCodeUpdate
+	94,  // 24: sf.near.type.v1.StateChangeValue.contract_deletion:type_name -> sf.near.type.v1.StateChangeValue.ContractCodeDeletion
 	13,  // 25: sf.near.type.v1.Account.amount:type_name -> sf.near.type.v1.BigInt
 	13,  // 26: sf.near.type.v1.Account.locked:type_name -> sf.near.type.v1.BigInt
 	14,  // 27: sf.near.type.v1.Account.code_hash:type_name -> sf.near.type.v1.CryptoHash
@@ -7021,7 +7857,7 @@ var file_sf_near_type_v1_type_proto_depIdxs = []int32{
 	24,  // 64: sf.near.type.v1.IndexerTransactionWithOutcome.transaction:type_name -> sf.near.type.v1.SignedTransaction
 	25,  // 65: sf.near.type.v1.IndexerTransactionWithOutcome.outcome:type_name -> sf.near.type.v1.IndexerExecutionOutcomeWithOptionalReceipt
 	16,  // 66: sf.near.type.v1.SignedTransaction.public_key:type_name -> sf.near.type.v1.PublicKey
-	55,  // 67: sf.near.type.v1.SignedTransaction.actions:type_name -> sf.near.type.v1.Action
+	62,  // 67: sf.near.type.v1.SignedTransaction.actions:type_name -> sf.near.type.v1.Action
 	15,  // 68: sf.near.type.v1.SignedTransaction.signature:type_name -> sf.near.type.v1.Signature
 	14,  // 69: sf.near.type.v1.SignedTransaction.hash:type_name -> sf.near.type.v1.CryptoHash
 	30,  // 70: sf.near.type.v1.IndexerExecutionOutcomeWithOptionalReceipt.execution_outcome:type_name -> sf.near.type.v1.ExecutionOutcomeWithId
@@ -7034,84 +7870,101 @@ var file_sf_near_type_v1_type_proto_depIdxs = []int32{
 	13,  // 77: sf.near.type.v1.ReceiptAction.gas_price:type_name -> sf.near.type.v1.BigInt
 	29,  // 78: sf.near.type.v1.ReceiptAction.output_data_receivers:type_name -> sf.near.type.v1.DataReceiver
 	14,  // 79: sf.near.type.v1.ReceiptAction.input_data_ids:type_name -> sf.near.type.v1.CryptoHash
-	55,  // 80: sf.near.type.v1.ReceiptAction.actions:type_name -> sf.near.type.v1.Action
+	62,  // 80: sf.near.type.v1.ReceiptAction.actions:type_name -> sf.near.type.v1.Action
 	14,  // 81: sf.near.type.v1.DataReceiver.data_id:type_name -> sf.near.type.v1.CryptoHash
-	53,  // 82: sf.near.type.v1.ExecutionOutcomeWithId.proof:type_name -> sf.near.type.v1.MerklePath
+	60,  // 82: sf.near.type.v1.ExecutionOutcomeWithId.proof:type_name -> sf.near.type.v1.MerklePath
 	14,  // 83: sf.near.type.v1.ExecutionOutcomeWithId.block_hash:type_name -> sf.near.type.v1.CryptoHash
 	14,  // 84: sf.near.type.v1.ExecutionOutcomeWithId.id:type_name -> sf.near.type.v1.CryptoHash
 	31,  // 85: sf.near.type.v1.ExecutionOutcomeWithId.outcome:type_name -> sf.near.type.v1.ExecutionOutcome
-	14,  // 86: sf.near.type.v1.ExecutionOutcome.receipt_ids:type_name -> sf.near.type.v1.CryptoHash
-	13,  // 87: sf.near.type.v1.ExecutionOutcome.tokens_burnt:type_name -> sf.near.type.v1.BigInt
-	34,  // 88: sf.near.type.v1.ExecutionOutcome.unknown:type_name -> sf.near.type.v1.UnknownExecutionStatus
-	35,  // 89: sf.near.type.v1.ExecutionOutcome.failure:type_name -> sf.near.type.v1.FailureExecutionStatus
-	32,  // 90: sf.near.type.v1.ExecutionOutcome.success_value:type_name -> sf.near.type.v1.SuccessValueExecutionStatus
-	33,  // 91: sf.near.type.v1.ExecutionOutcome.success_receipt_id:type_name -> sf.near.type.v1.SuccessReceiptIdExecutionStatus
-	1,   // 92: sf.near.type.v1.ExecutionOutcome.metadata:type_name -> sf.near.type.v1.ExecutionMetadata
-	14,  // 93: sf.near.type.v1.SuccessReceiptIdExecutionStatus.id:type_name -> sf.near.type.v1.CryptoHash
-	36,  // 94: sf.near.type.v1.FailureExecutionStatus.action_error:type_name -> sf.near.type.v1.ActionError
-	4,   // 95: sf.near.type.v1.FailureExecutionStatus.invalid_tx_error:type_name -> sf.near.type.v1.InvalidTxError
-	37,  // 96: sf.near.type.v1.ActionError.account_already_exist:type_name -> sf.near.type.v1.AccountAlreadyExistsErrorKind
-	38,  // 97: sf.near.type.v1.ActionError.account_does_not_exist:type_name -> sf.near.type.v1.AccountDoesNotExistErrorKind
-	39,  // 98: sf.near.type.v1.ActionError.create_account_only_by_registrar:type_name -> sf.near.type.v1.CreateAccountOnlyByRegistrarErrorKind
-	40,  // 99: sf.near.type.v1.ActionError.create_account_not_allowed:type_name -> sf.near.type.v1.CreateAccountNotAllowedErrorKind
-	41,  // 100: sf.near.type.v1.ActionError.actor_no_permission:type_name -> sf.near.type.v1.ActorNoPermissionErrorKind
-	42,  // 101: sf.near.type.v1.ActionError.delete_key_does_not_exist:type_name -> sf.near.type.v1.DeleteKeyDoesNotExistErrorKind
-	43,  // 102: sf.near.type.v1.ActionError.add_key_already_exists:type_name -> sf.near.type.v1.AddKeyAlreadyExistsErrorKind
-	44,  // 103: sf.near.type.v1.ActionError.delete_account_staking:type_name -> sf.near.type.v1.DeleteAccountStakingErrorKind
-	45,  // 104: sf.near.type.v1.ActionError.lack_balance_for_state:type_name -> sf.near.type.v1.LackBalanceForStateErrorKind
-	46,  // 105: sf.near.type.v1.ActionError.tries_to_unstake:type_name -> sf.near.type.v1.TriesToUnstakeErrorKind
-	47,  // 106: sf.near.type.v1.ActionError.tries_to_stake:type_name -> sf.near.type.v1.TriesToStakeErrorKind
-	48,  // 107: sf.near.type.v1.ActionError.insufficient_stake:type_name -> sf.near.type.v1.InsufficientStakeErrorKind
-	49,  // 108: sf.near.type.v1.ActionError.function_call:type_name -> sf.near.type.v1.FunctionCallErrorKind
-	50,  // 109: sf.near.type.v1.ActionError.new_receipt_validation:type_name -> sf.near.type.v1.NewReceiptValidationErrorKind
-	51,  // 110: sf.near.type.v1.ActionError.only_implicit_account_creation_allowed:type_name -> sf.near.type.v1.OnlyImplicitAccountCreationAllowedErrorKind
-	52,  // 111: sf.near.type.v1.ActionError.delete_account_with_large_state:type_name -> sf.near.type.v1.DeleteAccountWithLargeStateErrorKind
-	16,  // 112: sf.near.type.v1.DeleteKeyDoesNotExistErrorKind.public_key:type_name -> sf.near.type.v1.PublicKey
-	16,  // 113: sf.near.type.v1.AddKeyAlreadyExistsErrorKind.public_key:type_name -> sf.near.type.v1.PublicKey
-	13,  // 114: sf.near.type.v1.LackBalanceForStateErrorKind.balance:type_name -> sf.near.type.v1.BigInt
-	13,  // 115: sf.near.type.v1.TriesToStakeErrorKind.stake:type_name -> sf.near.type.v1.BigInt
-	13,  // 116: sf.near.type.v1.TriesToStakeErrorKind.locked:type_name -> sf.near.type.v1.BigInt
-	13,  // 117: sf.near.type.v1.TriesToStakeErrorKind.balance:type_name -> sf.near.type.v1.BigInt
-	13,  // 118: sf.near.type.v1.InsufficientStakeErrorKind.stake:type_name -> sf.near.type.v1.BigInt
-	13,  // 119: sf.near.type.v1.InsufficientStakeErrorKind.minimum_stake:type_name -> sf.near.type.v1.BigInt
-	2,   // 120: sf.near.type.v1.FunctionCallErrorKind.error:type_name -> sf.near.type.v1.FunctionCallErrorSer
-	3,   // 121: sf.near.type.v1.NewReceiptValidationErrorKind.error:type_name -> sf.near.type.v1.ReceiptValidationError
-	54,  // 122: sf.near.type.v1.MerklePath.path:type_name -> sf.near.type.v1.MerklePathItem
-	14,  // 123: sf.near.type.v1.MerklePathItem.hash:type_name -> sf.near.type.v1.CryptoHash
-	5,   // 124: sf.near.type.v1.MerklePathItem.direction:type_name -> sf.near.type.v1.Direction
-	56,  // 125: sf.near.type.v1.Action.create_account:type_name -> sf.near.type.v1.CreateAccountAction
-	57,  // 126: sf.near.type.v1.Action.deploy_contract:type_name -> sf.near.type.v1.DeployContractAction
-	58,  // 127: sf.near.type.v1.Action.function_call:type_name -> sf.near.type.v1.FunctionCallAction
-	59,  // 128: sf.near.type.v1.Action.transfer:type_name -> sf.near.type.v1.TransferAction
-	60,  // 129: sf.near.type.v1.Action.stake:type_name -> sf.near.type.v1.StakeAction
-	61,  // 130: sf.near.type.v1.Action.add_key:type_name -> sf.near.type.v1.AddKeyAction
-	62,  // 131: sf.near.type.v1.Action.delete_key:type_name -> sf.near.type.v1.DeleteKeyAction
-	63,  // 132: sf.near.type.v1.Action.delete_account:type_name -> sf.near.type.v1.DeleteAccountAction
-	13,  // 133: sf.near.type.v1.FunctionCallAction.deposit:type_name -> sf.near.type.v1.BigInt
-	13,  // 134: sf.near.type.v1.TransferAction.deposit:type_name -> sf.near.type.v1.BigInt
-	13,  // 135: sf.near.type.v1.StakeAction.stake:type_name -> sf.near.type.v1.BigInt
-	16,  // 136: sf.near.type.v1.StakeAction.public_key:type_name -> sf.near.type.v1.PublicKey
-	16,  // 137: sf.near.type.v1.AddKeyAction.public_key:type_name -> sf.near.type.v1.PublicKey
-	64,  // 138: sf.near.type.v1.AddKeyAction.access_key:type_name -> sf.near.type.v1.AccessKey
-	16,  // 139: sf.near.type.v1.DeleteKeyAction.public_key:type_name -> sf.near.type.v1.PublicKey
-	65,  // 140: sf.near.type.v1.AccessKey.permission:type_name -> sf.near.type.v1.AccessKeyPermission
-	66,  // 141: sf.near.type.v1.AccessKeyPermission.function_call:type_name -> sf.near.type.v1.FunctionCallPermission
-	67,  // 142: sf.near.type.v1.AccessKeyPermission.full_access:type_name -> sf.near.type.v1.FullAccessPermission
-	13,  // 143: sf.near.type.v1.FunctionCallPermission.allowance:type_name -> sf.near.type.v1.BigInt
-	14,  // 144: sf.near.type.v1.StateChangeCause.TransactionProcessing.tx_hash:type_name -> sf.near.type.v1.CryptoHash
-	14,  // 145: sf.near.type.v1.StateChangeCause.ActionReceiptProcessingStarted.receipt_hash:type_name -> sf.near.type.v1.CryptoHash
-	14,  // 146: sf.near.type.v1.StateChangeCause.ActionReceiptGasReward.tx_hash:type_name -> sf.near.type.v1.CryptoHash
-	14,  // 147: sf.near.type.v1.StateChangeCause.ReceiptProcessing.tx_hash:type_name -> sf.near.type.v1.CryptoHash
-	14,  // 148: sf.near.type.v1.StateChangeCause.PostponedReceipt.tx_hash:type_name -> sf.near.type.v1.CryptoHash
-	11,  // 149: sf.near.type.v1.StateChangeValue.AccountUpdate.account:type_name -> sf.near.type.v1.Account
-	16,  // 150: sf.near.type.v1.StateChangeValue.AccessKeyUpdate.public_key:type_name -> sf.near.type.v1.PublicKey
-	64,  // 151: sf.near.type.v1.StateChangeValue.AccessKeyUpdate.access_key:type_name -> sf.near.type.v1.AccessKey
-	16,  // 152: sf.near.type.v1.StateChangeValue.AccessKeyDeletion.public_key:type_name -> sf.near.type.v1.PublicKey
-	153, // [153:153] is the sub-list for method output_type
-	153, // [153:153] is the sub-list for method input_type
-	153, // [153:153] is the sub-list for extension type_name
-	153, // [153:153] is the sub-list for extension extendee
-	0,   // [0:153] is the sub-list for field type_name
+	14,  // 86: sf.near.type.v1.ExecutionOutcomeWithId.proof_hash:type_name -> sf.near.type.v1.CryptoHash
+	14,  // 87: sf.near.type.v1.ExecutionOutcome.receipt_ids:type_name -> sf.near.type.v1.CryptoHash
+	13,  // 88: sf.near.type.v1.ExecutionOutcome.tokens_burnt:type_name -> sf.near.type.v1.BigInt
+	34,  // 89: sf.near.type.v1.ExecutionOutcome.unknown:type_name -> sf.near.type.v1.UnknownExecutionStatus
+	35,  // 90: sf.near.type.v1.ExecutionOutcome.failure:type_name -> sf.near.type.v1.FailureExecutionStatus
+	32,  // 91: sf.near.type.v1.ExecutionOutcome.success_value:type_name -> sf.near.type.v1.SuccessValueExecutionStatus
+	33,  // 92: sf.near.type.v1.ExecutionOutcome.success_receipt_id:type_name -> sf.near.type.v1.SuccessReceiptIdExecutionStatus
+	1,   // 93: sf.near.type.v1.ExecutionOutcome.metadata:type_name -> sf.near.type.v1.ExecutionMetadata
+	14,  // 94: sf.near.type.v1.SuccessReceiptIdExecutionStatus.id:type_name -> sf.near.type.v1.CryptoHash
+	36,  // 95: sf.near.type.v1.FailureExecutionStatus.action_error:type_name -> sf.near.type.v1.ActionError
+	4,   // 96: sf.near.type.v1.FailureExecutionStatus.invalid_tx_error:type_name -> sf.near.type.v1.InvalidTxError
+	37,  // 97: sf.near.type.v1.ActionError.account_already_exist:type_name -> sf.near.type.v1.AccountAlreadyExistsErrorKind
+	38,  // 98: sf.near.type.v1.ActionError.account_does_not_exist:type_name -> sf.near.type.v1.AccountDoesNotExistErrorKind
+	39,  // 99: sf.near.type.v1.ActionError.create_account_only_by_registrar:type_name -> sf.near.type.v1.CreateAccountOnlyByRegistrarErrorKind
+	40,  // 100: sf.near.type.v1.ActionError.create_account_not_allowed:type_name -> sf.near.type.v1.CreateAccountNotAllowedErrorKind
+	41,  // 101: sf.near.type.v1.ActionError.actor_no_permission:type_name -> sf.near.type.v1.ActorNoPermissionErrorKind
+	42,  // 102: sf.near.type.v1.ActionError.delete_key_does_not_exist:type_name -> sf.near.type.v1.DeleteKeyDoesNotExistErrorKind
+	43,  // 103: sf.near.type.v1.ActionError.add_key_already_exists:type_name -> sf.near.type.v1.AddKeyAlreadyExistsErrorKind
+	44,  // 104: sf.near.type.v1.ActionError.delete_account_staking:type_name -> sf.near.type.v1.DeleteAccountStakingErrorKind
+	45,  // 105: sf.near.type.v1.ActionError.lack_balance_for_state:type_name -> sf.near.type.v1.LackBalanceForStateErrorKind
+	46,  // 106: sf.near.type.v1.ActionError.tries_to_unstake:type_name -> sf.near.type.v1.TriesToUnstakeErrorKind
+	47,  // 107: sf.near.type.v1.ActionError.tries_to_stake:type_name -> sf.near.type.v1.TriesToStakeErrorKind
+	48,  // 108: sf.near.type.v1.ActionError.insufficient_stake:type_name -> sf.near.type.v1.InsufficientStakeErrorKind
+	49,  // 109: sf.near.type.v1.ActionError.function_call:type_name -> sf.near.type.v1.FunctionCallErrorKind
+	50,  // 110: sf.near.type.v1.ActionError.new_receipt_validation:type_name -> sf.near.type.v1.NewReceiptValidationErrorKind
+	51,  // 111: sf.near.type.v1.ActionError.only_implicit_account_creation_allowed:type_name -> sf.near.type.v1.OnlyImplicitAccountCreationAllowedErrorKind
+	52,  // 112: sf.near.type.v1.ActionError.delete_account_with_large_state:type_name -> sf.near.type.v1.DeleteAccountWithLargeStateErrorKind
+	53,  // 113: sf.near.type.v1.ActionError.delegate_action_invalid_signature:type_name -> sf.near.type.v1.DelegateActionInvalidSignatureKind
+	54,  // 114: sf.near.type.v1.ActionError.delegate_action_sender_does_not_match_tx_receiver:type_name -> sf.near.type.v1.DelegateActionSenderDoesNotMatchTxReceiverKind
+	55,  // 115: sf.near.type.v1.ActionError.delegate_action_expired:type_name -> sf.near.type.v1.DelegateActionExpiredKind
+	56,  // 116: sf.near.type.v1.ActionError.delegate_action_access_key_error:type_name -> sf.near.type.v1.DelegateActionAccessKeyErrorKind
+	57,  // 117: sf.near.type.v1.ActionError.delegate_action_invalid_nonce:type_name -> sf.near.type.v1.DelegateActionInvalidNonceKind
+	58,  // 118: sf.near.type.v1.ActionError.delegate_action_nonce_too_large:type_name -> sf.near.type.v1.DelegateActionNonceTooLargeKind
+	59,  // 119: sf.near.type.v1.ActionError.non_refundable_transfer_to_existing_account:type_name -> sf.near.type.v1.NonRefundableTransferToExistingAccountKind
+	16,  // 120: sf.near.type.v1.DeleteKeyDoesNotExistErrorKind.public_key:type_name -> sf.near.type.v1.PublicKey
+	16,  // 121: sf.near.type.v1.AddKeyAlreadyExistsErrorKind.public_key:type_name -> sf.near.type.v1.PublicKey
+	13,  // 122: sf.near.type.v1.LackBalanceForStateErrorKind.balance:type_name -> sf.near.type.v1.BigInt
+	13,  // 123: sf.near.type.v1.TriesToStakeErrorKind.stake:type_name -> sf.near.type.v1.BigInt
+	13,  // 124: sf.near.type.v1.TriesToStakeErrorKind.locked:type_name -> sf.near.type.v1.BigInt
+	13,  // 125: sf.near.type.v1.TriesToStakeErrorKind.balance:type_name -> sf.near.type.v1.BigInt
+	13,  // 126: sf.near.type.v1.InsufficientStakeErrorKind.stake:type_name -> sf.near.type.v1.BigInt
+	13,  // 127: sf.near.type.v1.InsufficientStakeErrorKind.minimum_stake:type_name -> sf.near.type.v1.BigInt
+	2,   // 128: sf.near.type.v1.FunctionCallErrorKind.error:type_name -> sf.near.type.v1.FunctionCallErrorSer
+	3,   // 129: sf.near.type.v1.NewReceiptValidationErrorKind.error:type_name -> sf.near.type.v1.ReceiptValidationError
+	4,   // 130: sf.near.type.v1.DelegateActionAccessKeyErrorKind.error:type_name -> sf.near.type.v1.InvalidTxError
+	61,  // 131: sf.near.type.v1.MerklePath.path:type_name -> sf.near.type.v1.MerklePathItem
+	14,  // 132: sf.near.type.v1.MerklePathItem.hash:type_name -> sf.near.type.v1.CryptoHash
+	5,   // 133: sf.near.type.v1.MerklePathItem.direction:type_name -> sf.near.type.v1.Direction
+	63,  // 134: sf.near.type.v1.Action.create_account:type_name -> sf.near.type.v1.CreateAccountAction
+	64,  // 135: sf.near.type.v1.Action.deploy_contract:type_name -> sf.near.type.v1.DeployContractAction
+	65,  // 136: sf.near.type.v1.Action.function_call:type_name -> sf.near.type.v1.FunctionCallAction
+	66,  // 137: sf.near.type.v1.Action.transfer:type_name -> sf.near.type.v1.TransferAction
+	67,  // 138: sf.near.type.v1.Action.stake:type_name -> sf.near.type.v1.StakeAction
+	68,  // 139: sf.near.type.v1.Action.add_key:type_name -> sf.near.type.v1.AddKeyAction
+	69,  // 140: sf.near.type.v1.Action.delete_key:type_name -> sf.near.type.v1.DeleteKeyAction
+	70,  // 141: sf.near.type.v1.Action.delete_account:type_name -> sf.near.type.v1.DeleteAccountAction
+	71,  // 142: sf.near.type.v1.Action.delegate:type_name -> sf.near.type.v1.SignedDelegateAction
+	14,  // 143: sf.near.type.v1.DeployContractAction.code_hash:type_name -> sf.near.type.v1.CryptoHash
+	13,  // 144: sf.near.type.v1.FunctionCallAction.deposit:type_name -> sf.near.type.v1.BigInt
+	14,  // 145: sf.near.type.v1.FunctionCallAction.args_hash:type_name -> sf.near.type.v1.CryptoHash
+	13,  // 146: sf.near.type.v1.TransferAction.deposit:type_name -> sf.near.type.v1.BigInt
+	13,  // 147: sf.near.type.v1.StakeAction.stake:type_name -> sf.near.type.v1.BigInt
+	16,  // 148: sf.near.type.v1.StakeAction.public_key:type_name -> sf.near.type.v1.PublicKey
+	16,  // 149: sf.near.type.v1.AddKeyAction.public_key:type_name -> sf.near.type.v1.PublicKey
+	73,  // 150: sf.near.type.v1.AddKeyAction.access_key:type_name -> sf.near.type.v1.AccessKey
+	16,  // 151: sf.near.type.v1.DeleteKeyAction.public_key:type_name -> sf.near.type.v1.PublicKey
+	15,  // 152: sf.near.type.v1.SignedDelegateAction.signature:type_name -> sf.near.type.v1.Signature
+	72,  // 153: sf.near.type.v1.SignedDelegateAction.delegate_action:type_name -> sf.near.type.v1.DelegateAction
+	62,  // 154: sf.near.type.v1.DelegateAction.actions:type_name -> sf.near.type.v1.Action
+	16,  // 155: sf.near.type.v1.DelegateAction.public_key:type_name -> sf.near.type.v1.PublicKey
+	74,  // 156: sf.near.type.v1.AccessKey.permission:type_name -> sf.near.type.v1.AccessKeyPermission
+	75,  // 157: sf.near.type.v1.AccessKeyPermission.function_call:type_name -> sf.near.type.v1.FunctionCallPermission
+	76,  // 158: sf.near.type.v1.AccessKeyPermission.full_access:type_name -> sf.near.type.v1.FullAccessPermission
+	13,  // 159: sf.near.type.v1.FunctionCallPermission.allowance:type_name -> sf.near.type.v1.BigInt
+	14,  // 160: sf.near.type.v1.StateChangeCause.TransactionProcessing.tx_hash:type_name -> sf.near.type.v1.CryptoHash
+	14,  // 161: sf.near.type.v1.StateChangeCause.ActionReceiptProcessingStarted.receipt_hash:type_name -> sf.near.type.v1.CryptoHash
+	14,  // 162: sf.near.type.v1.StateChangeCause.ActionReceiptGasReward.tx_hash:type_name -> sf.near.type.v1.CryptoHash
+	14,  // 163: sf.near.type.v1.StateChangeCause.ReceiptProcessing.tx_hash:type_name -> sf.near.type.v1.CryptoHash
+	14,  // 164: sf.near.type.v1.StateChangeCause.PostponedReceipt.tx_hash:type_name -> sf.near.type.v1.CryptoHash
+	11,  // 165: sf.near.type.v1.StateChangeValue.AccountUpdate.account:type_name -> sf.near.type.v1.Account
+	16,  // 166: sf.near.type.v1.StateChangeValue.AccessKeyUpdate.public_key:type_name -> sf.near.type.v1.PublicKey
+	73,  // 167: sf.near.type.v1.StateChangeValue.AccessKeyUpdate.access_key:type_name -> sf.near.type.v1.AccessKey
+	16,  // 168: sf.near.type.v1.StateChangeValue.AccessKeyDeletion.public_key:type_name -> sf.near.type.v1.PublicKey
+	14,  // 169: sf.near.type.v1.StateChangeValue.ContractCodeUpdate.code_hash:type_name -> sf.near.type.v1.CryptoHash
+	170, // [170:170] is the sub-list for method output_type
+	170, // [170:170] is the sub-list for method input_type
+	170, // [170:170] is the sub-list for extension type_name
+	170, // [170:170] is the sub-list for extension extendee
+	0,   // [0:170] is the sub-list for field type_name
 }
 
 func init() { file_sf_near_type_v1_type_proto_init() }
@@ -7685,7 +8538,7 @@ func file_sf_near_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_near_type_v1_type_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MerklePath); i {
+			switch v := v.(*DelegateActionInvalidSignatureKind); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7697,7 +8550,7 @@ func file_sf_near_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_near_type_v1_type_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MerklePathItem); i {
+			switch v := v.(*DelegateActionSenderDoesNotMatchTxReceiverKind); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7709,7 +8562,7 @@ func file_sf_near_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_near_type_v1_type_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Action); i {
+			switch v := v.(*DelegateActionExpiredKind); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7721,7 +8574,7 @@ func file_sf_near_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_near_type_v1_type_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateAccountAction); i {
+			switch v := v.(*DelegateActionAccessKeyErrorKind); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7733,7 +8586,7 @@ func file_sf_near_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_near_type_v1_type_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeployContractAction); i {
+			switch v := v.(*DelegateActionInvalidNonceKind); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7745,7 +8598,7 @@ func file_sf_near_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_near_type_v1_type_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FunctionCallAction); i {
+			switch v := v.(*DelegateActionNonceTooLargeKind); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7757,7 +8610,7 @@ func file_sf_near_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_near_type_v1_type_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransferAction); i {
+			switch v := v.(*NonRefundableTransferToExistingAccountKind); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7769,7 +8622,7 @@ func file_sf_near_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_near_type_v1_type_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StakeAction); i {
+			switch v := v.(*MerklePath); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7781,7 +8634,7 @@ func file_sf_near_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_near_type_v1_type_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddKeyAction); i {
+			switch v := v.(*MerklePathItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7793,7 +8646,7 @@ func file_sf_near_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_near_type_v1_type_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteKeyAction); i {
+			switch v := v.(*Action); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7805,7 +8658,7 @@ func file_sf_near_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_near_type_v1_type_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteAccountAction); i {
+			switch v := v.(*CreateAccountAction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7817,7 +8670,7 @@ func file_sf_near_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_near_type_v1_type_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccessKey); i {
+			switch v := v.(*DeployContractAction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7829,7 +8682,7 @@ func file_sf_near_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_near_type_v1_type_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccessKeyPermission); i {
+			switch v := v.(*FunctionCallAction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7841,7 +8694,7 @@ func file_sf_near_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_near_type_v1_type_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FunctionCallPermission); i {
+			switch v := v.(*TransferAction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7853,7 +8706,7 @@ func file_sf_near_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_near_type_v1_type_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FullAccessPermission); i {
+			switch v := v.(*StakeAction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7865,7 +8718,7 @@ func file_sf_near_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_near_type_v1_type_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StateChangeCause_NotWritableToDisk); i {
+			switch v := v.(*AddKeyAction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7877,7 +8730,7 @@ func file_sf_near_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_near_type_v1_type_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StateChangeCause_InitialState); i {
+			switch v := v.(*DeleteKeyAction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7889,7 +8742,7 @@ func file_sf_near_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_near_type_v1_type_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StateChangeCause_TransactionProcessing); i {
+			switch v := v.(*DeleteAccountAction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7901,7 +8754,7 @@ func file_sf_near_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_near_type_v1_type_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StateChangeCause_ActionReceiptProcessingStarted); i {
+			switch v := v.(*SignedDelegateAction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7913,7 +8766,7 @@ func file_sf_near_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_near_type_v1_type_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StateChangeCause_ActionReceiptGasReward); i {
+			switch v := v.(*DelegateAction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7925,7 +8778,7 @@ func file_sf_near_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_near_type_v1_type_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StateChangeCause_ReceiptProcessing); i {
+			switch v := v.(*AccessKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7937,7 +8790,7 @@ func file_sf_near_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_near_type_v1_type_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StateChangeCause_PostponedReceipt); i {
+			switch v := v.(*AccessKeyPermission); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7949,7 +8802,7 @@ func file_sf_near_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_near_type_v1_type_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StateChangeCause_UpdatedDelayedReceipts); i {
+			switch v := v.(*FunctionCallPermission); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7961,7 +8814,7 @@ func file_sf_near_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_near_type_v1_type_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StateChangeCause_ValidatorAccountsUpdate); i {
+			switch v := v.(*FullAccessPermission); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7973,7 +8826,7 @@ func file_sf_near_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_near_type_v1_type_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StateChangeCause_Migration); i {
+			switch v := v.(*StateChangeCause_NotWritableToDisk); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7985,7 +8838,7 @@ func file_sf_near_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_near_type_v1_type_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StateChangeValue_AccountUpdate); i {
+			switch v := v.(*StateChangeCause_InitialState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7997,7 +8850,7 @@ func file_sf_near_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_near_type_v1_type_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StateChangeValue_AccountDeletion); i {
+			switch v := v.(*StateChangeCause_TransactionProcessing); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8009,7 +8862,7 @@ func file_sf_near_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_near_type_v1_type_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StateChangeValue_AccessKeyUpdate); i {
+			switch v := v.(*StateChangeCause_ActionReceiptProcessingStarted); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8021,7 +8874,7 @@ func file_sf_near_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_near_type_v1_type_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StateChangeValue_AccessKeyDeletion); i {
+			switch v := v.(*StateChangeCause_ActionReceiptGasReward); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8033,7 +8886,7 @@ func file_sf_near_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_near_type_v1_type_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StateChangeValue_DataUpdate); i {
+			switch v := v.(*StateChangeCause_ReceiptProcessing); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8045,7 +8898,7 @@ func file_sf_near_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_near_type_v1_type_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StateChangeValue_DataDeletion); i {
+			switch v := v.(*StateChangeCause_PostponedReceipt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8057,7 +8910,7 @@ func file_sf_near_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_near_type_v1_type_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StateChangeValue_ContractCodeUpdate); i {
+			switch v := v.(*StateChangeCause_UpdatedDelayedReceipts); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8069,6 +8922,114 @@ func file_sf_near_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_near_type_v1_type_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateChangeCause_ValidatorAccountsUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_near_type_v1_type_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateChangeCause_Migration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_near_type_v1_type_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateChangeValue_AccountUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_near_type_v1_type_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateChangeValue_AccountDeletion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_near_type_v1_type_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateChangeValue_AccessKeyUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_near_type_v1_type_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateChangeValue_AccessKeyDeletion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_near_type_v1_type_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateChangeValue_DataUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_near_type_v1_type_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateChangeValue_DataDeletion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_near_type_v1_type_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateChangeValue_ContractCodeUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_near_type_v1_type_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StateChangeValue_ContractCodeDeletion); i {
 			case 0:
 				return &v.state
@@ -8134,8 +9095,15 @@ func file_sf_near_type_v1_type_proto_init() {
 		(*ActionError_NewReceiptValidation)(nil),
 		(*ActionError_OnlyImplicitAccountCreationAllowed)(nil),
 		(*ActionError_DeleteAccountWithLargeState)(nil),
-	}
-	file_sf_near_type_v1_type_proto_msgTypes[49].OneofWrappers = []interface{}{
+		(*ActionError_DelegateActionInvalidSignature)(nil),
+		(*ActionError_DelegateActionSenderDoesNotMatchTxReceiver)(nil),
+		(*ActionError_DelegateActionExpired)(nil),
+		(*ActionError_DelegateActionAccessKeyError)(nil),
+		(*ActionError_DelegateActionInvalidNonce)(nil),
+		(*ActionError_DelegateActionNonceTooLarge)(nil),
+		(*ActionError_NonRefundableTransferToExistingAccount)(nil),
+	}
+	file_sf_near_type_v1_type_proto_msgTypes[56].OneofWrappers = []interface{}{
 		(*Action_CreateAccount)(nil),
 		(*Action_DeployContract)(nil),
 		(*Action_FunctionCall)(nil),
@@ -8144,8 +9112,9 @@ func file_sf_near_type_v1_type_proto_init() {
 		(*Action_AddKey)(nil),
 		(*Action_DeleteKey)(nil),
 		(*Action_DeleteAccount)(nil),
+		(*Action_Delegate)(nil),
 	}
-	file_sf_near_type_v1_type_proto_msgTypes[59].OneofWrappers = []interface{}{
+	file_sf_near_type_v1_type_proto_msgTypes[68].OneofWrappers = []interface{}{
 		(*AccessKeyPermission_FunctionCall)(nil),
 		(*AccessKeyPermission_FullAccess)(nil),
 	}
@@ -8155,7 +9124,7 @@ func file_sf_near_type_v1_type_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_sf_near_type_v1_type_proto_rawDesc,
 			NumEnums:      6,
-			NumMessages:   80,
+			NumMessages:   89,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
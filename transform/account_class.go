@@ -0,0 +1,177 @@
+package transform
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/dstore"
+	firecore "github.com/streamingfast/firehose-core"
+	pbtransform "github.com/streamingfast/firehose-near/pb/sf/near/transform/v1"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+)
+
+// AccountClassIndexShortName is the index recording account classes. It is kept apart from the
+// `rcptaddr` index so that `rcptaddr` files built before account classes existed are never used
+// to answer class predicates, and so that class keys never show up in account lookups.
+const AccountClassIndexShortName = "acctclass"
+
+// AccountClassIndexKey returns the `acctclass` index key under which blocks containing an action
+// receipt whose account playing `role` is of `class` are recorded, ex: `receiver:implicit`.
+func AccountClassIndexKey(role pbtransform.AccountClassPredicate_Role, class pbnear.AccountClass) string {
+	return strings.ToLower(role.String()) + ":" + class.String()
+}
+
+var _ firecore.BlockIndexer[*pbnear.Block] = (*NearAccountClassIndexer)(nil)
+
+// NearAccountClassIndexer records, for each block, the implicit and ETH-implicit account classes
+// of the receivers, signers and predecessors of its action receipts.
+type NearAccountClassIndexer struct {
+	BlockIndexer blockIndexer
+}
+
+func NewNearAccountClassIndexer(indexStore dstore.Store, indexSize uint64) (firecore.BlockIndexer[*pbnear.Block], error) {
+	bi := transform.NewBlockIndexer(indexStore, indexSize, AccountClassIndexShortName)
+
+	return &NearAccountClassIndexer{
+		BlockIndexer: bi,
+	}, nil
+}
+
+func (i *NearAccountClassIndexer) ProcessBlock(blk *pbnear.Block) error {
+	keyMap := make(map[string]bool)
+	for _, shard := range blk.Shards {
+		for _, outcome := range shard.ReceiptExecutionOutcomes {
+			for _, key := range accountClassIndexKeys(outcome.Receipt) {
+				keyMap[key] = true
+			}
+		}
+	}
+
+	var keys []string
+	for key := range keyMap {
+		keys = append(keys, key)
+	}
+
+	i.BlockIndexer.Add(keys, blk.Num())
+	return nil
+}
+
+// NewNearAccountClassIndexProvider returns the provider of the blocks matching any of the
+// predicates according to the `acctclass` index, predicates must be indexable
+func NewNearAccountClassIndexProvider(store dstore.Store, possibleIndexSizes []uint64, predicates []*AccountClassPredicate) *transform.GenericBlockIndexProvider {
+	return transform.NewGenericBlockIndexProvider(
+		store,
+		AccountClassIndexShortName,
+		possibleIndexSizes,
+		getAccountClassFilterFunc(predicates),
+	)
+}
+
+func getAccountClassFilterFunc(predicates []*AccountClassPredicate) func(transform.BitmapGetter) []uint64 {
+	return func(bitmaps transform.BitmapGetter) []uint64 {
+		out := roaring64.NewBitmap()
+		for _, predicate := range predicates {
+			if bm := bitmaps.Get(predicate.IndexKey()); bm != nil {
+				out.Or(bm)
+			}
+		}
+		return nilIfEmpty(out.ToArray())
+	}
+}
+
+// AccountClassPredicate is the compiled form of a [pbtransform.AccountClassPredicate]
+type AccountClassPredicate struct {
+	Role  pbtransform.AccountClassPredicate_Role
+	Class pbnear.AccountClass
+}
+
+func NewAccountClassPredicate(in *pbtransform.AccountClassPredicate) (*AccountClassPredicate, error) {
+	if _, found := pbtransform.AccountClassPredicate_Role_name[int32(in.Role)]; !found {
+		return nil, fmt.Errorf("unknown role %d", in.Role)
+	}
+
+	var class pbnear.AccountClass
+	switch in.Class {
+	case pbtransform.AccountClassPredicate_NAMED:
+		class = pbnear.NamedAccount
+	case pbtransform.AccountClassPredicate_IMPLICIT:
+		class = pbnear.ImplicitAccount
+	case pbtransform.AccountClassPredicate_ETH_IMPLICIT:
+		class = pbnear.EthImplicitAccount
+	default:
+		return nil, fmt.Errorf("unknown class %d", in.Class)
+	}
+
+	return &AccountClassPredicate{Role: in.Role, Class: class}, nil
+}
+
+func (p *AccountClassPredicate) String() string {
+	return fmt.Sprintf("%s is %s", strings.ToLower(p.Role.String()), p.Class)
+}
+
+// Matches returns true if the receipt is an action receipt whose account playing the
+// predicate's role is of the predicate's class.
+func (p *AccountClassPredicate) Matches(receipt *pbnear.Receipt) bool {
+	accountID, found := receiptAccountForRole(receipt, p.Role)
+	return found && pbnear.ClassifyAccountID(accountID) == p.Class
+}
+
+// IsIndexable returns true if the `acctclass` index records this predicate, only
+// implicit and ETH-implicit classes are indexed.
+func (p *AccountClassPredicate) IsIndexable() bool {
+	return p.Class != pbnear.NamedAccount
+}
+
+func (p *AccountClassPredicate) IndexKey() string {
+	return AccountClassIndexKey(p.Role, p.Class)
+}
+
+func receiptAccountForRole(receipt *pbnear.Receipt, role pbtransform.AccountClassPredicate_Role) (string, bool) {
+	action := receipt.GetAction()
+	if action == nil {
+		return "", false
+	}
+
+	switch role {
+	case pbtransform.AccountClassPredicate_RECEIVER:
+		return receipt.ReceiverId, true
+	case pbtransform.AccountClassPredicate_SIGNER:
+		return action.SignerId, true
+	case pbtransform.AccountClassPredicate_PREDECESSOR:
+		return receipt.PredecessorId, true
+	}
+
+	return "", false
+}
+
+// accountClassIndexKeys returns the account class keys to index for the receipt, one for
+// each role whose account is implicit or ETH-implicit.
+func accountClassIndexKeys(receipt *pbnear.Receipt) (out []string) {
+	for _, role := range []pbtransform.AccountClassPredicate_Role{
+		pbtransform.AccountClassPredicate_RECEIVER,
+		pbtransform.AccountClassPredicate_SIGNER,
+		pbtransform.AccountClassPredicate_PREDECESSOR,
+	} {
+		accountID, found := receiptAccountForRole(receipt, role)
+		if !found {
+			continue
+		}
+
+		if class := pbnear.ClassifyAccountID(accountID); class != pbnear.NamedAccount {
+			out = append(out, AccountClassIndexKey(role, class))
+		}
+	}
+
+	return
+}
+
+func matchesAnyAccountClassPredicate(receipt *pbnear.Receipt, predicates []*AccountClassPredicate) bool {
+	for _, predicate := range predicates {
+		if predicate.Matches(receipt) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,131 @@
+package transform
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/streamingfast/bstream/transform"
+	pbtransform "github.com/streamingfast/firehose-near/pb/sf/near/transform/v1"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testImplicitAccount    = strings.Repeat("ab", 32)
+	testEthImplicitAccount = "0x" + strings.Repeat("cd", 20)
+)
+
+func actionReceipt(predecessorID, signerID, receiverID string) *pbnear.Receipt {
+	return &pbnear.Receipt{
+		PredecessorId: predecessorID,
+		ReceiverId:    receiverID,
+		Receipt:       &pbnear.Receipt_Action{Action: &pbnear.ReceiptAction{SignerId: signerID}},
+	}
+}
+
+func TestAccountClassPredicate_Matches(t *testing.T) {
+	receipt := actionReceipt("relayer.near", testEthImplicitAccount, testImplicitAccount)
+
+	tests := []struct {
+		role     pbtransform.AccountClassPredicate_Role
+		class    pbtransform.AccountClassPredicate_Class
+		expected bool
+	}{
+		{pbtransform.AccountClassPredicate_RECEIVER, pbtransform.AccountClassPredicate_IMPLICIT, true},
+		{pbtransform.AccountClassPredicate_RECEIVER, pbtransform.AccountClassPredicate_ETH_IMPLICIT, false},
+		{pbtransform.AccountClassPredicate_SIGNER, pbtransform.AccountClassPredicate_ETH_IMPLICIT, true},
+		{pbtransform.AccountClassPredicate_SIGNER, pbtransform.AccountClassPredicate_NAMED, false},
+		{pbtransform.AccountClassPredicate_PREDECESSOR, pbtransform.AccountClassPredicate_NAMED, true},
+		{pbtransform.AccountClassPredicate_PREDECESSOR, pbtransform.AccountClassPredicate_IMPLICIT, false},
+	}
+
+	for _, test := range tests {
+		predicate, err := NewAccountClassPredicate(&pbtransform.AccountClassPredicate{Role: test.role, Class: test.class})
+		require.NoError(t, err)
+
+		assert.Equal(t, test.expected, predicate.Matches(receipt), predicate.String())
+	}
+
+	predicate, err := NewAccountClassPredicate(&pbtransform.AccountClassPredicate{Role: pbtransform.AccountClassPredicate_RECEIVER, Class: pbtransform.AccountClassPredicate_IMPLICIT})
+	require.NoError(t, err)
+	assert.False(t, predicate.Matches(&pbnear.Receipt{ReceiverId: testImplicitAccount, Receipt: &pbnear.Receipt_Data{}}), "data receipts never match")
+}
+
+func TestAccountClass_Classify(t *testing.T) {
+	assert.Equal(t, pbnear.ImplicitAccount, pbnear.ClassifyAccountID(testImplicitAccount))
+	assert.Equal(t, pbnear.EthImplicitAccount, pbnear.ClassifyAccountID(testEthImplicitAccount))
+	assert.Equal(t, pbnear.NamedAccount, pbnear.ClassifyAccountID("alice.near"))
+	assert.Equal(t, pbnear.NamedAccount, pbnear.ClassifyAccountID(strings.Repeat("ab", 31)), "too short to be implicit")
+	assert.Equal(t, pbnear.NamedAccount, pbnear.ClassifyAccountID("0x"+strings.Repeat("cd", 21)), "too long to be ETH-implicit")
+}
+
+type testBlockIndexer struct {
+	keys map[uint64][]string
+}
+
+func (i *testBlockIndexer) Add(keys []string, blockNum uint64) {
+	sort.Strings(keys)
+	i.keys[blockNum] = keys
+}
+
+func TestNearAccountClassIndexer_ProcessBlock(t *testing.T) {
+	indexer := &testBlockIndexer{keys: map[uint64][]string{}}
+	classIndexer := &NearAccountClassIndexer{BlockIndexer: indexer}
+	receiverIndexer := &testBlockIndexer{keys: map[uint64][]string{}}
+
+	block := &pbnear.Block{
+		Header: &pbnear.BlockHeader{Height: 10},
+		Shards: []*pbnear.IndexerShard{
+			{ReceiptExecutionOutcomes: []*pbnear.IndexerExecutionOutcomeWithReceipt{
+				{Receipt: actionReceipt("alice.near", "alice.near", testImplicitAccount)},
+				{Receipt: actionReceipt(testEthImplicitAccount, testEthImplicitAccount, "contract.near")},
+			}},
+		},
+	}
+	require.NoError(t, classIndexer.ProcessBlock(block))
+	require.NoError(t, (&NearBlockIndexer{BlockIndexer: receiverIndexer}).ProcessBlock(block))
+
+	assert.Equal(t, []string{
+		"predecessor:eth-implicit",
+		"receiver:implicit",
+		"signer:eth-implicit",
+	}, indexer.keys[10])
+
+	// The `rcptaddr` index only records receivers
+	assert.Equal(t, []string{testImplicitAccount, "contract.near"}, receiverIndexer.keys[10])
+}
+
+func TestNearAccountClassIndexProvider(t *testing.T) {
+	index := transform.NewTestBlockIndex(0, 10, map[string]*roaring64.Bitmap{
+		"receiver:implicit":   roaring64.BitmapOf(1, 3),
+		"signer:eth-implicit": roaring64.BitmapOf(2),
+	})
+
+	predicate, err := NewAccountClassPredicate(&pbtransform.AccountClassPredicate{Role: pbtransform.AccountClassPredicate_RECEIVER, Class: pbtransform.AccountClassPredicate_IMPLICIT})
+	require.NoError(t, err)
+
+	assert.Equal(t, []uint64{1, 3}, getAccountClassFilterFunc([]*AccountClassPredicate{predicate})(index))
+
+	predicate, err = NewAccountClassPredicate(&pbtransform.AccountClassPredicate{Role: pbtransform.AccountClassPredicate_RECEIVER, Class: pbtransform.AccountClassPredicate_ETH_IMPLICIT})
+	require.NoError(t, err)
+	assert.Nil(t, getAccountClassFilterFunc([]*AccountClassPredicate{predicate})(index))
+}
+
+type testBlockIndexProvider []uint64
+
+func (p testBlockIndexProvider) BlocksInRange(_, _ uint64) ([]uint64, error) {
+	return p, nil
+}
+
+func TestUnionBlockIndexProvider(t *testing.T) {
+	blocks, err := unionBlockIndexProvider{testBlockIndexProvider{3, 1}, testBlockIndexProvider{2, 3}, testBlockIndexProvider(nil)}.BlocksInRange(0, 100)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1, 2, 3}, blocks)
+
+	blocks, err = unionBlockIndexProvider{testBlockIndexProvider(nil)}.BlocksInRange(0, 100)
+	require.NoError(t, err)
+	assert.Nil(t, blocks)
+}
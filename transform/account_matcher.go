@@ -0,0 +1,236 @@
+package transform
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/streamingfast/bstream/transform"
+	pbtransform "github.com/streamingfast/firehose-near/pb/sf/near/transform/v1"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+)
+
+// AccountMatcher is the compiled form of a [pbtransform.AccountMatcher], matching account IDs
+// segment by segment instead of raw string prefix/suffix.
+type AccountMatcher struct {
+	kind    accountMatcherKind
+	pattern string
+
+	// segments is the dot-separated glob pattern, only set for glob matchers
+	segments []string
+}
+
+type accountMatcherKind int
+
+const (
+	accountMatcherExact accountMatcherKind = iota
+	accountMatcherSubaccountOf
+	accountMatcherDirectChildOf
+	accountMatcherGlob
+)
+
+func (k accountMatcherKind) String() string {
+	switch k {
+	case accountMatcherExact:
+		return "exact"
+	case accountMatcherSubaccountOf:
+		return "subaccount_of"
+	case accountMatcherDirectChildOf:
+		return "direct_child_of"
+	case accountMatcherGlob:
+		return "glob"
+	}
+
+	return fmt.Sprintf("unknown(%d)", int(k))
+}
+
+func NewAccountMatcher(in *pbtransform.AccountMatcher) (*AccountMatcher, error) {
+	switch m := in.Matcher.(type) {
+	case *pbtransform.AccountMatcher_Exact:
+		return newAccountIDMatcher(accountMatcherExact, m.Exact)
+	case *pbtransform.AccountMatcher_SubaccountOf:
+		return newAccountIDMatcher(accountMatcherSubaccountOf, m.SubaccountOf)
+	case *pbtransform.AccountMatcher_DirectChildOf:
+		return newAccountIDMatcher(accountMatcherDirectChildOf, m.DirectChildOf)
+	case *pbtransform.AccountMatcher_Glob:
+		return newGlobAccountMatcher(m.Glob)
+	}
+
+	return nil, fmt.Errorf("account matcher must have one of exact, subaccount_of, direct_child_of or glob set")
+}
+
+func newAccountIDMatcher(kind accountMatcherKind, accountID string) (*AccountMatcher, error) {
+	if err := pbnear.ValidateAccountID(accountID); err != nil {
+		return nil, fmt.Errorf("%s: %w", kind, err)
+	}
+
+	return &AccountMatcher{kind: kind, pattern: accountID}, nil
+}
+
+func newGlobAccountMatcher(pattern string) (*AccountMatcher, error) {
+	if pattern == "" {
+		return nil, fmt.Errorf("glob: pattern must be non-empty")
+	}
+
+	if len(pattern) > pbnear.MaxAccountIDLen {
+		return nil, fmt.Errorf("glob: invalid pattern %q: length must be at most %d characters", pattern, pbnear.MaxAccountIDLen)
+	}
+
+	segments := strings.Split(pattern, ".")
+	for _, segment := range segments {
+		if segment == "**" {
+			continue
+		}
+
+		if !isGlobSegment(segment) {
+			if !pbnear.IsValidAccountIDSegment(segment) {
+				return nil, fmt.Errorf("glob: invalid pattern %q: segment %q is not a valid account ID segment", pattern, segment)
+			}
+			continue
+		}
+
+		// Replacing wildcards by a valid character makes sure the rest of the segment respects account ID rules
+		literal := strings.NewReplacer("*", "a", "?", "a").Replace(segment)
+		if strings.Contains(segment, "**") || !pbnear.IsValidAccountIDSegment(literal) {
+			return nil, fmt.Errorf("glob: invalid pattern %q: segment %q is not a valid account ID segment pattern", pattern, segment)
+		}
+	}
+
+	return &AccountMatcher{kind: accountMatcherGlob, pattern: pattern, segments: segments}, nil
+}
+
+func (m *AccountMatcher) String() string {
+	return fmt.Sprintf("%s=%s", m.kind, m.pattern)
+}
+
+func (m *AccountMatcher) Matches(accountID string) bool {
+	switch m.kind {
+	case accountMatcherExact:
+		return accountID == m.pattern
+	case accountMatcherSubaccountOf:
+		return accountID == m.pattern || strings.HasSuffix(accountID, "."+m.pattern)
+	case accountMatcherDirectChildOf:
+		child, found := strings.CutSuffix(accountID, "."+m.pattern)
+		return found && child != "" && !strings.Contains(child, ".")
+	case accountMatcherGlob:
+		return matchSegments(m.segments, strings.Split(accountID, "."))
+	}
+
+	return false
+}
+
+// IsIndexable returns true if the rcptaddr index can be used to find blocks matching this matcher, which
+// is not the case for glob matchers without any literal leading or trailing segment (ex: "*" or "*.*").
+func (m *AccountMatcher) IsIndexable() bool {
+	if m.kind != accountMatcherGlob {
+		return true
+	}
+
+	prefix, suffix := m.globLiteralPrefixSuffix()
+	return prefix != "" || suffix != ""
+}
+
+// IndexBitmap returns the blocks possibly containing an account matching this matcher according to
+// the index. Blocks are a superset of the exact matches, the [BasicReceiptFilter] transform refines
+// them. The matcher must be [AccountMatcher.IsIndexable], nil is returned otherwise.
+func (m *AccountMatcher) IndexBitmap(bitmaps transform.BitmapGetter) *roaring64.Bitmap {
+	switch m.kind {
+	case accountMatcherExact:
+		return bitmaps.Get(m.pattern)
+
+	case accountMatcherSubaccountOf:
+		out := roaring64.NewBitmap()
+		if bm := bitmaps.Get(m.pattern); bm != nil {
+			out.Or(bm)
+		}
+		if bm := bitmaps.GetByPrefixAndSuffix("", "."+m.pattern); bm != nil {
+			out.Or(bm)
+		}
+		return out
+
+	case accountMatcherDirectChildOf:
+		return bitmaps.GetByPrefixAndSuffix("", "."+m.pattern)
+
+	case accountMatcherGlob:
+		prefix, suffix := m.globLiteralPrefixSuffix()
+		if prefix == "" && suffix == "" {
+			return nil
+		}
+
+		if prefix == m.pattern {
+			// Glob without any wildcard
+			return bitmaps.Get(m.pattern)
+		}
+
+		return bitmaps.GetByPrefixAndSuffix(prefix, suffix)
+	}
+
+	return nil
+}
+
+// globLiteralPrefixSuffix returns the literal segments at the start (with trailing dot) and at the end
+// (with leading dot) of the glob pattern. When the pattern has no wildcard at all, the prefix is the full pattern.
+func (m *AccountMatcher) globLiteralPrefixSuffix() (prefix, suffix string) {
+	first := 0
+	for first < len(m.segments) && !isGlobSegment(m.segments[first]) && m.segments[first] != "**" {
+		first++
+	}
+
+	if first == len(m.segments) {
+		return m.pattern, ""
+	}
+
+	last := len(m.segments)
+	for last > first && !isGlobSegment(m.segments[last-1]) && m.segments[last-1] != "**" {
+		last--
+	}
+
+	if first > 0 {
+		prefix = strings.Join(m.segments[:first], ".") + "."
+	}
+	if last < len(m.segments) {
+		suffix = "." + strings.Join(m.segments[last:], ".")
+	}
+
+	return
+}
+
+func matchSegments(patterns []string, segments []string) bool {
+	if len(patterns) == 0 {
+		return len(segments) == 0
+	}
+
+	if patterns[0] == "**" {
+		// `**` consumes one or more segments
+		for i := 1; i <= len(segments); i++ {
+			if matchSegments(patterns[1:], segments[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(segments) == 0 {
+		return false
+	}
+
+	if matched, _ := path.Match(patterns[0], segments[0]); !matched {
+		return false
+	}
+
+	return matchSegments(patterns[1:], segments[1:])
+}
+
+func isGlobSegment(segment string) bool {
+	return strings.ContainsAny(segment, "*?")
+}
+
+func matchesAnyAccountMatcher(accountID string, matchers []*AccountMatcher) bool {
+	for _, matcher := range matchers {
+		if matcher.Matches(accountID) {
+			return true
+		}
+	}
+	return false
+}
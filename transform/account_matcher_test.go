@@ -0,0 +1,116 @@
+package transform
+
+import (
+	"testing"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/streamingfast/bstream/transform"
+	pbtransform "github.com/streamingfast/firehose-near/pb/sf/near/transform/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func exactMatcher(account string) *pbtransform.AccountMatcher {
+	return &pbtransform.AccountMatcher{Matcher: &pbtransform.AccountMatcher_Exact{Exact: account}}
+}
+
+func subaccountOfMatcher(account string) *pbtransform.AccountMatcher {
+	return &pbtransform.AccountMatcher{Matcher: &pbtransform.AccountMatcher_SubaccountOf{SubaccountOf: account}}
+}
+
+func directChildOfMatcher(account string) *pbtransform.AccountMatcher {
+	return &pbtransform.AccountMatcher{Matcher: &pbtransform.AccountMatcher_DirectChildOf{DirectChildOf: account}}
+}
+
+func globMatcher(pattern string) *pbtransform.AccountMatcher {
+	return &pbtransform.AccountMatcher{Matcher: &pbtransform.AccountMatcher_Glob{Glob: pattern}}
+}
+
+func TestAccountMatcher_Matches(t *testing.T) {
+	tests := []struct {
+		name       string
+		matcher    *pbtransform.AccountMatcher
+		matching   []string
+		unmatching []string
+	}{
+		{"exact", exactMatcher("foo.near"), []string{"foo.near"}, []string{"a.foo.near", "foo", "barfoo.near"}},
+		{"subaccount of", subaccountOfMatcher("near"), []string{"near", "foo.near", "a.foo.near"}, []string{"foonear", "near.foo"}},
+		{"subaccount of nested", subaccountOfMatcher("foo.near"), []string{"foo.near", "app.foo.near", "v1.app.foo.near"}, []string{"barfoo.near", "near"}},
+		{"direct child of", directChildOfMatcher("near"), []string{"foo.near", "foo-bar.near"}, []string{"near", "a.foo.near", "foonear", ".near"}},
+		{"glob single segment", globMatcher("app.*.near"), []string{"app.foo.near"}, []string{"app.near", "app.foo.bar.near", "app.foonear"}},
+		{"glob partial segment", globMatcher("pool-*.near"), []string{"pool-1.near", "pool-abc.near"}, []string{"pool.near", "a.pool-1.near"}},
+		{"glob any depth", globMatcher("**.sweat"), []string{"foo.sweat", "a.b.sweat"}, []string{"sweat", "foosweat"}},
+		{"glob single character", globMatcher("v?.near"), []string{"v1.near", "v2.near"}, []string{"v10.near", "v.near"}},
+		{"glob without wildcard", globMatcher("foo.near"), []string{"foo.near"}, []string{"a.foo.near"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			matcher, err := NewAccountMatcher(test.matcher)
+			require.NoError(t, err)
+
+			for _, account := range test.matching {
+				assert.True(t, matcher.Matches(account), "expected %q to match %s", account, matcher)
+			}
+			for _, account := range test.unmatching {
+				assert.False(t, matcher.Matches(account), "expected %q to not match %s", account, matcher)
+			}
+		})
+	}
+}
+
+func TestAccountMatcher_Invalid(t *testing.T) {
+	for _, in := range []*pbtransform.AccountMatcher{
+		{},
+		exactMatcher("Foo.near"),
+		exactMatcher("a"),
+		subaccountOfMatcher("foo..near"),
+		directChildOfMatcher(".near"),
+		globMatcher(""),
+		globMatcher("foo.*-.near"),
+		globMatcher("foo.***.near"),
+		globMatcher("foo.[a-z].near"),
+	} {
+		_, err := NewAccountMatcher(in)
+		assert.Error(t, err, "expected %v to be invalid", in)
+	}
+}
+
+func TestAccountMatcher_IndexBitmap(t *testing.T) {
+	index := transform.NewTestBlockIndex(0, 10, map[string]*roaring64.Bitmap{
+		"near":         roaring64.BitmapOf(1),
+		"foo.near":     roaring64.BitmapOf(2),
+		"app.foo.near": roaring64.BitmapOf(3),
+		"foonear":      roaring64.BitmapOf(4),
+		"app.bar.near": roaring64.BitmapOf(5),
+	})
+
+	tests := []struct {
+		matcher   *pbtransform.AccountMatcher
+		indexable bool
+		expected  []uint64
+	}{
+		{exactMatcher("foo.near"), true, []uint64{2}},
+		{subaccountOfMatcher("near"), true, []uint64{1, 2, 3, 5}},
+		{subaccountOfMatcher("foo.near"), true, []uint64{2, 3}},
+		{directChildOfMatcher("foo.near"), true, []uint64{3}},
+		{globMatcher("app.*.near"), true, []uint64{3, 5}},
+		{globMatcher("*.foo.near"), true, []uint64{3}},
+		{globMatcher("*"), false, nil},
+		{globMatcher("*.*"), false, nil},
+	}
+
+	for _, test := range tests {
+		matcher, err := NewAccountMatcher(test.matcher)
+		require.NoError(t, err)
+
+		require.Equal(t, test.indexable, matcher.IsIndexable(), matcher.String())
+		if !test.indexable {
+			continue
+		}
+
+		bitmap := matcher.IndexBitmap(index)
+		require.NotNil(t, bitmap, matcher.String())
+		assert.Equal(t, test.expected, bitmap.ToArray(), matcher.String())
+	}
+}
@@ -2,6 +2,7 @@ package transform
 
 import (
 	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/streamingfast/bstream"
 	"github.com/streamingfast/bstream/transform"
 	"github.com/streamingfast/dstore"
 	pbtransform "github.com/streamingfast/firehose-near/pb/sf/near/transform/v1"
@@ -14,34 +15,62 @@ func NewNearBlockIndexProvider(
 	possibleIndexSizes []uint64,
 	addresses map[string]bool,
 	prefixSuffixPairs []*pbtransform.PrefixSuffixPair,
+	accountMatchers []*AccountMatcher,
 ) *transform.GenericBlockIndexProvider {
 	return transform.NewGenericBlockIndexProvider(
 		store,
 		ReceiptAddressIndexShortName,
 		possibleIndexSizes,
-		getFilterFunc(addresses, prefixSuffixPairs),
+		getFilterFunc(addresses, prefixSuffixPairs, accountMatchers),
 	)
 }
 
-func getFilterFunc(accounts map[string]bool, prefixSuffixPairs []*pbtransform.PrefixSuffixPair) func(transform.BitmapGetter) []uint64 {
+func getFilterFunc(accounts map[string]bool, prefixSuffixPairs []*pbtransform.PrefixSuffixPair, accountMatchers []*AccountMatcher) func(transform.BitmapGetter) []uint64 {
 	return func(bitmaps transform.BitmapGetter) (matchingBlocks []uint64) {
 		out := roaring64.NewBitmap()
-		for a := range accounts {
-			if bm := bitmaps.Get(a); bm != nil {
-				out.Or(bm)
-			}
+		orAccountsBitmaps(out, bitmaps, accounts, prefixSuffixPairs, accountMatchers)
+
+		return nilIfEmpty(out.ToArray())
+	}
+}
+
+func orAccountsBitmaps(out *roaring64.Bitmap, bitmaps transform.BitmapGetter, accounts map[string]bool, prefixSuffixPairs []*pbtransform.PrefixSuffixPair, accountMatchers []*AccountMatcher) {
+	for a := range accounts {
+		if bm := bitmaps.Get(a); bm != nil {
+			out.Or(bm)
 		}
+	}
 
-		for _, pair := range prefixSuffixPairs {
-			if bm := bitmaps.GetByPrefixAndSuffix(pair.Prefix, pair.Suffix); bm != nil {
-				out.Or(bm)
-			}
+	for _, pair := range prefixSuffixPairs {
+		if bm := bitmaps.GetByPrefixAndSuffix(pair.Prefix, pair.Suffix); bm != nil {
+			out.Or(bm)
 		}
+	}
 
-		return nilIfEmpty(out.ToArray())
+	for _, matcher := range accountMatchers {
+		if bm := matcher.IndexBitmap(bitmaps); bm != nil {
+			out.Or(bm)
+		}
 	}
 }
 
+// unionBlockIndexProvider returns the blocks returned by any of its providers, each one reading
+// a different index. When one of them fails, the union fails so that every block is inspected.
+type unionBlockIndexProvider []bstream.BlockIndexProvider
+
+func (u unionBlockIndexProvider) BlocksInRange(baseBlockNum, bundleSize uint64) ([]uint64, error) {
+	out := roaring64.NewBitmap()
+	for _, provider := range u {
+		blocks, err := provider.BlocksInRange(baseBlockNum, bundleSize)
+		if err != nil {
+			return nil, err
+		}
+		out.AddMany(blocks)
+	}
+
+	return nilIfEmpty(out.ToArray()), nil
+}
+
 // nilIfEmpty is a convenience method which returns nil if the provided slice is empty
 func nilIfEmpty(in []uint64) []uint64 {
 	if len(in) == 0 {
@@ -42,3 +42,35 @@ func (i *NearBlockIndexer) ProcessBlock(blk *pbnear.Block) error {
 	i.BlockIndexer.Add(keys, blk.Num())
 	return nil
 }
+
+var _ firecore.BlockIndexer[*pbnear.Block] = (multiBlockIndexer)(nil)
+
+type multiBlockIndexer []firecore.BlockIndexer[*pbnear.Block]
+
+// NewMultiBlockIndexerFactory returns a factory feeding every block to each of the indexers
+// created by `factories`. The `index-builder` app of firehose-core accepts a single indexer
+// per chain, this is how more than one index is built in the same pass.
+func NewMultiBlockIndexerFactory(factories ...firecore.BlockIndexerFactory[*pbnear.Block]) firecore.BlockIndexerFactory[*pbnear.Block] {
+	return func(indexStore dstore.Store, indexSize uint64) (firecore.BlockIndexer[*pbnear.Block], error) {
+		indexers := make(multiBlockIndexer, len(factories))
+		for i, factory := range factories {
+			indexer, err := factory(indexStore, indexSize)
+			if err != nil {
+				return nil, err
+			}
+
+			indexers[i] = indexer
+		}
+
+		return indexers, nil
+	}
+}
+
+func (m multiBlockIndexer) ProcessBlock(blk *pbnear.Block) error {
+	for _, indexer := range m {
+		if err := indexer.ProcessBlock(blk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package transform
+
+import (
+	"testing"
+
+	"github.com/streamingfast/dstore"
+	firecore "github.com/streamingfast/firehose-core"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiBlockIndexerFactory(t *testing.T) {
+	var processed []string
+	newFactory := func(name string) firecore.BlockIndexerFactory[*pbnear.Block] {
+		return func(_ dstore.Store, _ uint64) (firecore.BlockIndexer[*pbnear.Block], error) {
+			return testIndexerFunc(func(blk *pbnear.Block) error {
+				processed = append(processed, name)
+				return nil
+			}), nil
+		}
+	}
+
+	indexer, err := NewMultiBlockIndexerFactory(newFactory("first"), newFactory("second"))(nil, 1000)
+	require.NoError(t, err)
+
+	require.NoError(t, indexer.ProcessBlock(&pbnear.Block{Header: &pbnear.BlockHeader{Height: 10}}))
+	assert.Equal(t, []string{"first", "second"}, processed)
+}
+
+type testIndexerFunc func(blk *pbnear.Block) error
+
+func (f testIndexerFunc) ProcessBlock(blk *pbnear.Block) error {
+	return f(blk)
+}
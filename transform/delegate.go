@@ -0,0 +1,150 @@
+package transform
+
+import (
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/dstore"
+	firecore "github.com/streamingfast/firehose-core"
+	pbtransform "github.com/streamingfast/firehose-near/pb/sf/near/transform/v1"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+)
+
+// DelegateIndexShortName is the index recording the inner sender and receiver of NEP-366 delegate
+// actions. It is kept apart from the `rcptaddr` index so that `rcptaddr` files built before
+// delegate actions were indexed are never used to answer delegate lookups, and so that delegate
+// keys never show up in receiver prefix and suffix lookups.
+const DelegateIndexShortName = "dlgtaddr"
+
+const (
+	// DelegateReceiverIndexKeyPrefix prefixes the `dlgtaddr` index keys recording the inner receiver
+	// of NEP-366 delegate actions, ex: `receiver:contract.near`.
+	DelegateReceiverIndexKeyPrefix = "receiver:"
+
+	// DelegateSenderIndexKeyPrefix prefixes the `dlgtaddr` index keys recording the inner sender of
+	// NEP-366 delegate actions, ex: `sender:alice.near`.
+	DelegateSenderIndexKeyPrefix = "sender:"
+)
+
+var _ firecore.BlockIndexer[*pbnear.Block] = (*NearDelegateIndexer)(nil)
+
+// NearDelegateIndexer records, for each block, the inner senders and receivers of the NEP-366
+// delegate actions of its action receipts.
+type NearDelegateIndexer struct {
+	BlockIndexer blockIndexer
+}
+
+func NewNearDelegateIndexer(indexStore dstore.Store, indexSize uint64) (firecore.BlockIndexer[*pbnear.Block], error) {
+	bi := transform.NewBlockIndexer(indexStore, indexSize, DelegateIndexShortName)
+
+	return &NearDelegateIndexer{
+		BlockIndexer: bi,
+	}, nil
+}
+
+func (i *NearDelegateIndexer) ProcessBlock(blk *pbnear.Block) error {
+	keyMap := make(map[string]bool)
+	for _, shard := range blk.Shards {
+		for _, outcome := range shard.ReceiptExecutionOutcomes {
+			for _, key := range delegateIndexKeys(outcome.Receipt) {
+				keyMap[key] = true
+			}
+		}
+	}
+
+	var keys []string
+	for key := range keyMap {
+		keys = append(keys, key)
+	}
+
+	i.BlockIndexer.Add(keys, blk.Num())
+	return nil
+}
+
+// NewNearDelegateIndexProvider returns the provider of the blocks whose delegate actions have an
+// inner receiver matching the accounts, pairs or matchers, or an inner sender among senders,
+// according to the `dlgtaddr` index
+func NewNearDelegateIndexProvider(
+	store dstore.Store,
+	possibleIndexSizes []uint64,
+	receivers map[string]bool,
+	receiverPrefixSuffixPairs []*pbtransform.PrefixSuffixPair,
+	receiverMatchers []*AccountMatcher,
+	senders map[string]bool,
+) *transform.GenericBlockIndexProvider {
+	return transform.NewGenericBlockIndexProvider(
+		store,
+		DelegateIndexShortName,
+		possibleIndexSizes,
+		getDelegateFilterFunc(receivers, receiverPrefixSuffixPairs, receiverMatchers, senders),
+	)
+}
+
+func getDelegateFilterFunc(receivers map[string]bool, receiverPrefixSuffixPairs []*pbtransform.PrefixSuffixPair, receiverMatchers []*AccountMatcher, senders map[string]bool) func(transform.BitmapGetter) []uint64 {
+	return func(bitmaps transform.BitmapGetter) []uint64 {
+		out := roaring64.NewBitmap()
+		orAccountsBitmaps(out, &prefixedBitmapGetter{bitmaps, DelegateReceiverIndexKeyPrefix}, receivers, receiverPrefixSuffixPairs, receiverMatchers)
+		orAccountsBitmaps(out, &prefixedBitmapGetter{bitmaps, DelegateSenderIndexKeyPrefix}, senders, nil, nil)
+
+		return nilIfEmpty(out.ToArray())
+	}
+}
+
+// receiptDelegateActions returns the NEP-366 delegate actions found in an action receipt, the
+// ones nested inside other delegate actions included.
+func receiptDelegateActions(receipt *pbnear.Receipt) []*pbnear.DelegateAction {
+	action := receipt.GetAction()
+	if action == nil {
+		return nil
+	}
+
+	return appendDelegateActions(nil, action.Actions)
+}
+
+func appendDelegateActions(out []*pbnear.DelegateAction, actions []*pbnear.Action) []*pbnear.DelegateAction {
+	for _, action := range actions {
+		if delegateAction := action.GetDelegate().GetDelegateAction(); delegateAction != nil {
+			out = append(out, delegateAction)
+			out = appendDelegateActions(out, delegateAction.Actions)
+		}
+	}
+	return out
+}
+
+// delegateIndexKeys returns the inner sender and receiver keys to index for the receipt's delegate actions
+func delegateIndexKeys(receipt *pbnear.Receipt) (out []string) {
+	for _, delegateAction := range receiptDelegateActions(receipt) {
+		out = append(out,
+			DelegateSenderIndexKeyPrefix+delegateAction.SenderId,
+			DelegateReceiverIndexKeyPrefix+delegateAction.ReceiverId,
+		)
+	}
+	return
+}
+
+// prefixedBitmapGetter is a [transform.BitmapGetter] looking up keys in a namespace of the index, so that
+// account lookups can be performed against delegate receivers or senders as they would be against receivers.
+type prefixedBitmapGetter struct {
+	transform.BitmapGetter
+	prefix string
+}
+
+func (g *prefixedBitmapGetter) Get(key string) *roaring64.Bitmap {
+	return g.BitmapGetter.Get(g.prefix + key)
+}
+
+func (g *prefixedBitmapGetter) GetByPrefixAndSuffix(prefix string, suffix string) *roaring64.Bitmap {
+	return g.BitmapGetter.GetByPrefixAndSuffix(g.prefix+prefix, suffix)
+}
+
+func matchesAnyDelegateSender(receipt *pbnear.Receipt, senders map[string]bool) bool {
+	if len(senders) == 0 {
+		return false
+	}
+
+	for _, delegateAction := range receiptDelegateActions(receipt) {
+		if senders[delegateAction.SenderId] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,114 @@
+package transform
+
+import (
+	"testing"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/streamingfast/bstream/transform"
+	pbtransform "github.com/streamingfast/firehose-near/pb/sf/near/transform/v1"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func delegateReceipt(relayerID, senderID, innerReceiverID string) *pbnear.Receipt {
+	return &pbnear.Receipt{
+		PredecessorId: relayerID,
+		ReceiverId:    senderID,
+		Receipt: &pbnear.Receipt_Action{Action: &pbnear.ReceiptAction{
+			SignerId: relayerID,
+			Actions: []*pbnear.Action{
+				{Action: &pbnear.Action_Delegate{Delegate: &pbnear.SignedDelegateAction{
+					DelegateAction: &pbnear.DelegateAction{
+						SenderId:   senderID,
+						ReceiverId: innerReceiverID,
+						Actions: []*pbnear.Action{
+							{Action: &pbnear.Action_FunctionCall{FunctionCall: &pbnear.FunctionCallAction{MethodName: "ft_transfer"}}},
+						},
+					},
+				}}},
+			},
+		}},
+	}
+}
+
+func TestBasicReceiptFilter_UnwrapDelegateActions(t *testing.T) {
+	receipt := delegateReceipt("relayer.near", "alice.near", "token.sweat")
+
+	filter := &BasicReceiptFilter{Accounts: map[string]bool{"token.sweat": true}}
+	assert.False(t, filter.matches(receipt))
+
+	filter.UnwrapDelegates = true
+	assert.True(t, filter.matches(receipt))
+
+	matcher, err := NewAccountMatcher(subaccountOfMatcher("sweat"))
+	require.NoError(t, err)
+
+	filter = &BasicReceiptFilter{AccountMatchers: []*AccountMatcher{matcher}, UnwrapDelegates: true}
+	assert.True(t, filter.matches(receipt))
+	assert.False(t, filter.matches(delegateReceipt("relayer.near", "alice.near", "token.near")))
+}
+
+func TestBasicReceiptFilter_DelegateSenders(t *testing.T) {
+	receipt := delegateReceipt("relayer.near", "alice.near", "token.sweat")
+
+	filter := &BasicReceiptFilter{DelegateSenders: map[string]bool{"alice.near": true}}
+	assert.True(t, filter.matches(receipt))
+	assert.False(t, filter.matches(delegateReceipt("relayer.near", "bob.near", "token.sweat")))
+
+	// A plain receipt to the sender is not a delegate action it signed
+	assert.False(t, filter.matches(actionReceipt("relayer.near", "relayer.near", "alice.near")))
+}
+
+func TestNearDelegateIndexer_ProcessBlock(t *testing.T) {
+	indexer := &testBlockIndexer{keys: map[uint64][]string{}}
+	delegateIndexer := &NearDelegateIndexer{BlockIndexer: indexer}
+	receiverIndexer := &testBlockIndexer{keys: map[uint64][]string{}}
+
+	block := &pbnear.Block{
+		Header: &pbnear.BlockHeader{Height: 10},
+		Shards: []*pbnear.IndexerShard{
+			{ReceiptExecutionOutcomes: []*pbnear.IndexerExecutionOutcomeWithReceipt{
+				{Receipt: delegateReceipt("relayer.near", "alice.near", "token.sweat")},
+			}},
+		},
+	}
+	require.NoError(t, delegateIndexer.ProcessBlock(block))
+	require.NoError(t, (&NearBlockIndexer{BlockIndexer: receiverIndexer}).ProcessBlock(block))
+
+	assert.Equal(t, []string{
+		"receiver:token.sweat",
+		"sender:alice.near",
+	}, indexer.keys[10])
+
+	// The `rcptaddr` index only records receivers
+	assert.Equal(t, []string{"alice.near"}, receiverIndexer.keys[10])
+}
+
+func TestNearDelegateIndexProvider(t *testing.T) {
+	receiverIndex := transform.NewTestBlockIndex(0, 10, map[string]*roaring64.Bitmap{
+		"token.sweat": roaring64.BitmapOf(1),
+		"alice.near":  roaring64.BitmapOf(2),
+	})
+	delegateIndex := transform.NewTestBlockIndex(0, 10, map[string]*roaring64.Bitmap{
+		"sender:alice.near":    roaring64.BitmapOf(2),
+		"receiver:token.sweat": roaring64.BitmapOf(2),
+		"receiver:app.sweat":   roaring64.BitmapOf(3),
+	})
+
+	matcher, err := NewAccountMatcher(subaccountOfMatcher("sweat"))
+	require.NoError(t, err)
+
+	accounts := map[string]bool{"token.sweat": true}
+	suffix := []*pbtransform.PrefixSuffixPair{{Suffix: ".sweat"}}
+
+	assert.Equal(t, []uint64{1}, getFilterFunc(accounts, nil, nil)(receiverIndex))
+	assert.Equal(t, []uint64{2}, getDelegateFilterFunc(accounts, nil, nil, nil)(delegateIndex))
+	assert.Equal(t, []uint64{2, 3}, getDelegateFilterFunc(nil, nil, []*AccountMatcher{matcher}, nil)(delegateIndex))
+	assert.Equal(t, []uint64{2, 3}, getDelegateFilterFunc(nil, suffix, nil, nil)(delegateIndex))
+	assert.Equal(t, []uint64{2}, getDelegateFilterFunc(nil, nil, nil, map[string]bool{"alice.near": true})(delegateIndex))
+	assert.Nil(t, getDelegateFilterFunc(nil, nil, nil, map[string]bool{"token.sweat": true})(delegateIndex))
+
+	// Delegate keys never match receiver suffix lookups
+	assert.Equal(t, []uint64{1}, getFilterFunc(nil, suffix, nil)(receiverIndex))
+}
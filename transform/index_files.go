@@ -0,0 +1,41 @@
+package transform
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MaxIndexSize is the largest number of blocks covered by an index file among the sizes looked
+// up by default by bstream's index providers, used to bound walks of the index store.
+const MaxIndexSize uint64 = 100_000
+
+// IndexFilename returns the filename of the shortName index file covering indexSize blocks from
+// baseBlockNum, as written by bstream's block indexers, ex: `0100000000.10000.trxid.idx`.
+func IndexFilename(baseBlockNum, indexSize uint64, shortName string) string {
+	return fmt.Sprintf("%010d.%d.%s.idx", baseBlockNum, indexSize, shortName)
+}
+
+// ParseIndexFilename parses a filename written by bstream's block indexers, see [IndexFilename].
+func ParseIndexFilename(filename string) (baseBlockNum, indexSize uint64, shortName string, err error) {
+	parts := strings.Split(filename, ".")
+	if len(parts) != 4 || parts[3] != "idx" {
+		return 0, 0, "", fmt.Errorf("invalid index filename %q, expected <base>.<size>.<short name>.idx", filename)
+	}
+
+	if baseBlockNum, err = strconv.ParseUint(parts[0], 10, 64); err != nil {
+		return 0, 0, "", fmt.Errorf("invalid index filename %q base: %w", filename, err)
+	}
+
+	if indexSize, err = strconv.ParseUint(parts[1], 10, 64); err != nil || indexSize == 0 {
+		return 0, 0, "", fmt.Errorf("invalid index filename %q size %q", filename, parts[1])
+	}
+
+	return baseBlockNum, indexSize, parts[2], nil
+}
+
+// IndexWalkStartingPoint returns the starting point from which to walk an index store so that
+// all the index files covering blockNum or later blocks are visited.
+func IndexWalkStartingPoint(blockNum uint64) string {
+	return fmt.Sprintf("%010d", blockNum-blockNum%MaxIndexSize)
+}
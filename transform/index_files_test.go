@@ -0,0 +1,23 @@
+package transform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIndexFilename(t *testing.T) {
+	base, size, shortName, err := ParseIndexFilename(IndexFilename(120_000, 1000, TransactionIndexShortName))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(120_000), base)
+	assert.Equal(t, uint64(1000), size)
+	assert.Equal(t, TransactionIndexShortName, shortName)
+
+	for _, filename := range []string{"0000120000.1000.trxid", "0000120000.trxid.idx", "abc.1000.trxid.idx", "0000120000.0.trxid.idx"} {
+		_, _, _, err := ParseIndexFilename(filename)
+		assert.Error(t, err, filename)
+	}
+
+	assert.Equal(t, "0000100000", IndexWalkStartingPoint(199_999))
+}
@@ -0,0 +1,27 @@
+package transform
+
+import (
+	"fmt"
+
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/bstream/transform"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+)
+
+// blockFromInput returns the block to work on for this transform. When a previous transform
+// in the chain already produced a [pbnear.Block], that one is used so that transforms compose,
+// otherwise the full block is decoded from the read-only block's payload.
+func blockFromInput(readOnlyBlk *pbbstream.Block, in transform.Input) (*pbnear.Block, error) {
+	if in != nil {
+		if block, ok := in.Obj().(*pbnear.Block); ok && block != nil {
+			return block, nil
+		}
+	}
+
+	fullBlock := &pbnear.Block{}
+	if err := readOnlyBlk.Payload.UnmarshalTo(fullBlock); err != nil {
+		return nil, fmt.Errorf("unmarshalling block: %w", err)
+	}
+
+	return fullBlock, nil
+}
@@ -0,0 +1,136 @@
+package transform
+
+import (
+	"fmt"
+
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/dstore"
+	pbtransform "github.com/streamingfast/firehose-near/pb/sf/near/transform/v1"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+var LightBlockMessageName = proto.MessageName(&pbtransform.LightBlock{})
+
+func NewLightBlockTransformFactory(_ dstore.Store, _ []uint64) (*transform.Factory, error) {
+	return &transform.Factory{
+		Obj: &pbtransform.LightBlock{},
+		NewFunc: func(message *anypb.Any) (transform.Transform, error) {
+			mname := message.MessageName()
+			if mname != LightBlockMessageName {
+				return nil, fmt.Errorf("expected type url %q, received %q ", LightBlockMessageName, message.TypeUrl)
+			}
+
+			filter := &pbtransform.LightBlock{}
+			err := proto.Unmarshal(message.Value, filter)
+			if err != nil {
+				return nil, fmt.Errorf("unexpected unmarshall error: %w", err)
+			}
+			return &LightBlockFilter{}, nil
+		},
+	}, nil
+}
+
+type LightBlockFilter struct{}
+
+func (p *LightBlockFilter) String() string {
+	return "light block filter"
+}
+
+func (p *LightBlockFilter) Transform(readOnlyBlk *pbbstream.Block, in transform.Input) (transform.Output, error) {
+	fullBlock, err := blockFromInput(readOnlyBlk, in)
+	if err != nil {
+		return nil, err
+	}
+
+	zlog.Debug("running light block transformer",
+		zap.String("hash", readOnlyBlk.GetFirehoseBlockID()),
+		zap.Uint64("num", readOnlyBlk.GetFirehoseBlockNumber()),
+	)
+
+	shards := make([]*pbnear.IndexerShard, len(fullBlock.Shards))
+	for i, shard := range fullBlock.Shards {
+		shards[i] = lightShard(shard)
+	}
+
+	return &pbnear.Block{
+		Author:       fullBlock.Author,
+		Header:       fullBlock.Header,
+		ChunkHeaders: fullBlock.ChunkHeaders,
+		Shards:       shards,
+	}, nil
+}
+
+func lightShard(shard *pbnear.IndexerShard) *pbnear.IndexerShard {
+	out := &pbnear.IndexerShard{
+		ShardId: shard.ShardId,
+	}
+
+	if chunk := shard.Chunk; chunk != nil {
+		out.Chunk = &pbnear.IndexerChunk{}
+
+		for _, trx := range chunk.Transactions {
+			lightTrx := &pbnear.IndexerTransactionWithOutcome{}
+			if trx.Transaction != nil {
+				lightTrx.Transaction = &pbnear.SignedTransaction{
+					SignerId:   trx.Transaction.SignerId,
+					ReceiverId: trx.Transaction.ReceiverId,
+					Hash:       trx.Transaction.Hash,
+				}
+			}
+			if trx.Outcome != nil {
+				lightTrx.Outcome = &pbnear.IndexerExecutionOutcomeWithOptionalReceipt{
+					ExecutionOutcome: lightExecutionOutcome(trx.Outcome.ExecutionOutcome),
+				}
+			}
+
+			out.Chunk.Transactions = append(out.Chunk.Transactions, lightTrx)
+		}
+
+		for _, receipt := range chunk.Receipts {
+			out.Chunk.Receipts = append(out.Chunk.Receipts, lightReceipt(receipt))
+		}
+	}
+
+	for _, outcome := range shard.ReceiptExecutionOutcomes {
+		out.ReceiptExecutionOutcomes = append(out.ReceiptExecutionOutcomes, &pbnear.IndexerExecutionOutcomeWithReceipt{
+			ExecutionOutcome: lightExecutionOutcome(outcome.ExecutionOutcome),
+			Receipt:          lightReceipt(outcome.Receipt),
+		})
+	}
+
+	return out
+}
+
+func lightReceipt(receipt *pbnear.Receipt) *pbnear.Receipt {
+	if receipt == nil {
+		return nil
+	}
+
+	return &pbnear.Receipt{
+		ReceiptId:  receipt.ReceiptId,
+		ReceiverId: receipt.ReceiverId,
+	}
+}
+
+func lightExecutionOutcome(outcome *pbnear.ExecutionOutcomeWithId) *pbnear.ExecutionOutcomeWithId {
+	if outcome == nil {
+		return nil
+	}
+
+	out := &pbnear.ExecutionOutcomeWithId{
+		Id: outcome.Id,
+	}
+
+	if outcome.Outcome != nil {
+		out.Outcome = &pbnear.ExecutionOutcome{
+			Status: outcome.Outcome.Status,
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,180 @@
+package transform
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/bstream/transform"
+	pbtransform "github.com/streamingfast/firehose-near/pb/sf/near/transform/v1"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func lightBlockTransform(t *testing.T) *anypb.Any {
+	transform := &pbtransform.LightBlock{}
+	a, err := anypb.New(transform)
+	require.NoError(t, err)
+	return a
+}
+
+func TestLightBlock_Transform(t *testing.T) {
+	lightBlock, err := NewLightBlockTransformFactory(nil, nil)
+	require.NoError(t, err)
+
+	transformReg := transform.NewRegistry()
+	transformReg.Register(lightBlock)
+
+	transforms := []*anypb.Any{lightBlockTransform(t)}
+
+	preprocFunc, x, _, err := transformReg.BuildFromTransforms(transforms)
+	require.NoError(t, err)
+	require.Nil(t, x)
+
+	header := &pbnear.BlockHeader{
+		Height:     160,
+		PrevHeight: 158,
+		Hash:       &pbnear.CryptoHash{Bytes: []byte{0x00, 0xa0}},
+		PrevHash:   &pbnear.CryptoHash{Bytes: []byte{0x00, 0x9e}},
+	}
+
+	success := &pbnear.ExecutionOutcome_SuccessValue{SuccessValue: &pbnear.SuccessValueExecutionStatus{Value: []byte("true")}}
+
+	block := &pbnear.Block{
+		Header: header,
+		Author: "someone",
+		ChunkHeaders: []*pbnear.ChunkHeader{
+			{ChunkHash: []byte{0x01}, ShardId: 1},
+		},
+		Shards: []*pbnear.IndexerShard{
+			{
+				ShardId: 1,
+				Chunk: &pbnear.IndexerChunk{
+					Author: "someone",
+					Transactions: []*pbnear.IndexerTransactionWithOutcome{
+						{
+							Transaction: &pbnear.SignedTransaction{
+								SignerId:   "alice.near",
+								ReceiverId: "contract.near",
+								Nonce:      12,
+								Hash:       &pbnear.CryptoHash{Bytes: []byte{0x10}},
+								Actions: []*pbnear.Action{
+									{Action: &pbnear.Action_FunctionCall{FunctionCall: &pbnear.FunctionCallAction{MethodName: "ft_transfer", Args: []byte("{}")}}},
+								},
+							},
+							Outcome: &pbnear.IndexerExecutionOutcomeWithOptionalReceipt{
+								ExecutionOutcome: &pbnear.ExecutionOutcomeWithId{
+									Id:    &pbnear.CryptoHash{Bytes: []byte{0x10}},
+									Proof: &pbnear.MerklePath{Path: []*pbnear.MerklePathItem{{Hash: &pbnear.CryptoHash{Bytes: []byte{0xff}}}}},
+									Outcome: &pbnear.ExecutionOutcome{
+										Logs:     []string{"a log"},
+										GasBurnt: 100,
+										Status:   success,
+									},
+								},
+							},
+						},
+					},
+					Receipts: []*pbnear.Receipt{
+						{
+							PredecessorId: "alice.near",
+							ReceiverId:    "contract.near",
+							ReceiptId:     &pbnear.CryptoHash{Bytes: []byte{0x20}},
+							Receipt:       &pbnear.Receipt_Action{Action: &pbnear.ReceiptAction{SignerId: "alice.near"}},
+						},
+					},
+				},
+				ReceiptExecutionOutcomes: []*pbnear.IndexerExecutionOutcomeWithReceipt{
+					{
+						ExecutionOutcome: &pbnear.ExecutionOutcomeWithId{
+							Id: &pbnear.CryptoHash{Bytes: []byte{0x20}},
+							Outcome: &pbnear.ExecutionOutcome{
+								Logs:   []string{"another log"},
+								Status: success,
+							},
+						},
+						Receipt: &pbnear.Receipt{
+							PredecessorId: "alice.near",
+							ReceiverId:    "contract.near",
+							ReceiptId:     &pbnear.CryptoHash{Bytes: []byte{0x20}},
+							Receipt:       &pbnear.Receipt_Action{Action: &pbnear.ReceiptAction{SignerId: "alice.near"}},
+						},
+					},
+				},
+			},
+		},
+		StateChanges: []*pbnear.StateChangeWithCause{
+			{
+				Value: &pbnear.StateChangeValue{
+					Value: &pbnear.StateChangeValue_AccessKeyUpdate_{},
+				},
+			},
+		},
+	}
+	payload, err := proto.Marshal(block)
+	require.NoError(t, err)
+
+	blk := &pbbstream.Block{
+		Number:    block.Num(),
+		Id:        block.ID(),
+		LibNum:    block.LIBNum(),
+		ParentNum: block.GetFirehoseBlockParentNumber(),
+		ParentId:  block.PreviousID(),
+		Timestamp: timestamppb.New(block.GetFirehoseBlockTime()),
+		Payload:   &anypb.Any{TypeUrl: "sf.near.type.v1.Block", Value: payload},
+	}
+
+	output, err := preprocFunc(blk)
+	require.NoError(t, err)
+
+	assertProtoEqual(t, &pbnear.Block{
+		Header: header,
+		Author: "someone",
+		ChunkHeaders: []*pbnear.ChunkHeader{
+			{ChunkHash: []byte{0x01}, ShardId: 1},
+		},
+		Shards: []*pbnear.IndexerShard{
+			{
+				ShardId: 1,
+				Chunk: &pbnear.IndexerChunk{
+					Transactions: []*pbnear.IndexerTransactionWithOutcome{
+						{
+							Transaction: &pbnear.SignedTransaction{
+								SignerId:   "alice.near",
+								ReceiverId: "contract.near",
+								Hash:       &pbnear.CryptoHash{Bytes: []byte{0x10}},
+							},
+							Outcome: &pbnear.IndexerExecutionOutcomeWithOptionalReceipt{
+								ExecutionOutcome: &pbnear.ExecutionOutcomeWithId{
+									Id:      &pbnear.CryptoHash{Bytes: []byte{0x10}},
+									Outcome: &pbnear.ExecutionOutcome{Status: success},
+								},
+							},
+						},
+					},
+					Receipts: []*pbnear.Receipt{
+						{
+							ReceiverId: "contract.near",
+							ReceiptId:  &pbnear.CryptoHash{Bytes: []byte{0x20}},
+						},
+					},
+				},
+				ReceiptExecutionOutcomes: []*pbnear.IndexerExecutionOutcomeWithReceipt{
+					{
+						ExecutionOutcome: &pbnear.ExecutionOutcomeWithId{
+							Id:      &pbnear.CryptoHash{Bytes: []byte{0x20}},
+							Outcome: &pbnear.ExecutionOutcome{Status: success},
+						},
+						Receipt: &pbnear.Receipt{
+							ReceiverId: "contract.near",
+							ReceiptId:  &pbnear.CryptoHash{Bytes: []byte{0x20}},
+						},
+					},
+				},
+			},
+		},
+	}, output.(*pbnear.Block))
+}
@@ -5,9 +5,9 @@ import (
 	"strings"
 
 	"github.com/streamingfast/bstream"
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
 	"github.com/streamingfast/bstream/transform"
 	"github.com/streamingfast/dstore"
-	firecore "github.com/streamingfast/firehose-core"
 	pbtransform "github.com/streamingfast/firehose-near/pb/sf/near/transform/v1"
 	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
 	"google.golang.org/protobuf/proto"
@@ -31,12 +31,15 @@ func BasicReceiptFilterFactory(indexStore dstore.Store, possibleIndexSizes []uin
 				return nil, fmt.Errorf("unexpected unmarshall error: %w", err)
 			}
 
-			if len(filter.Accounts) == 0 && len(filter.PrefixAndSuffixPairs) == 0 {
-				return nil, fmt.Errorf("a basic account filter requires at least one account or one prefix/suffix pair")
+			if len(filter.Accounts) == 0 && len(filter.PrefixAndSuffixPairs) == 0 && len(filter.AccountMatchers) == 0 && len(filter.AccountClassPredicates) == 0 && len(filter.DelegateSenders) == 0 {
+				return nil, fmt.Errorf("a basic account filter requires at least one account, one prefix/suffix pair, one account matcher, one account class predicate or one delegate sender")
 			}
 
 			accountMap := make(map[string]bool)
 			for _, acc := range filter.Accounts {
+				if err := pbnear.ValidateAccountID(acc); err != nil {
+					return nil, fmt.Errorf("invalid accounts: %w", err)
+				}
 				accountMap[acc] = true
 			}
 			for _, pair := range filter.PrefixAndSuffixPairs {
@@ -44,9 +47,36 @@ func BasicReceiptFilterFactory(indexStore dstore.Store, possibleIndexSizes []uin
 					return nil, fmt.Errorf("invalid prefix_and_suffix_pairs: either prefix or suffix must be non-empty")
 				}
 			}
+			var matchers []*AccountMatcher
+			for _, in := range filter.AccountMatchers {
+				matcher, err := NewAccountMatcher(in)
+				if err != nil {
+					return nil, fmt.Errorf("invalid account_matchers: %w", err)
+				}
+				matchers = append(matchers, matcher)
+			}
+			var predicates []*AccountClassPredicate
+			for _, in := range filter.AccountClassPredicates {
+				predicate, err := NewAccountClassPredicate(in)
+				if err != nil {
+					return nil, fmt.Errorf("invalid account_class_predicates: %w", err)
+				}
+				predicates = append(predicates, predicate)
+			}
+			delegateSenders := make(map[string]bool)
+			for _, sender := range filter.DelegateSenders {
+				if err := pbnear.ValidateAccountID(sender); err != nil {
+					return nil, fmt.Errorf("invalid delegate_senders: %w", err)
+				}
+				delegateSenders[sender] = true
+			}
 			f := &BasicReceiptFilter{
 				Accounts:           accountMap,
 				PrefixSuffixPairs:  filter.PrefixAndSuffixPairs,
+				AccountMatchers:    matchers,
+				AccountClasses:     predicates,
+				UnwrapDelegates:    filter.UnwrapDelegateActions,
+				DelegateSenders:    delegateSenders,
 				possibleIndexSizes: possibleIndexSizes,
 				indexStore:         indexStore,
 			}
@@ -58,13 +88,17 @@ func BasicReceiptFilterFactory(indexStore dstore.Store, possibleIndexSizes []uin
 type BasicReceiptFilter struct {
 	Accounts          map[string]bool
 	PrefixSuffixPairs []*pbtransform.PrefixSuffixPair
+	AccountMatchers   []*AccountMatcher
+	AccountClasses    []*AccountClassPredicate
+	UnwrapDelegates   bool
+	DelegateSenders   map[string]bool
 
 	indexStore         dstore.Store
 	possibleIndexSizes []uint64
 }
 
 func (p *BasicReceiptFilter) String() string {
-	return fmt.Sprintf("accounts: %v, prefix/suffix: %v", p.Accounts, p.PrefixSuffixPairs)
+	return fmt.Sprintf("accounts: %v, prefix/suffix: %v, matchers: %v, account classes: %v, unwrap delegates: %t, delegate senders: %v", p.Accounts, p.PrefixSuffixPairs, p.AccountMatchers, p.AccountClasses, p.UnwrapDelegates, p.DelegateSenders)
 }
 
 func matchesPrefixSuffix(receiverID string, prefixSuffixPairs []*pbtransform.PrefixSuffixPair) bool {
@@ -82,14 +116,40 @@ func matchesPrefixSuffix(receiverID string, prefixSuffixPairs []*pbtransform.Pre
 	return false
 }
 
-func (p *BasicReceiptFilter) Transform(readOnlyBlk firecore.Block, in transform.Input) (transform.Output, error) {
-	nearBlock := readOnlyBlk.(*pbnear.Block)
+func (p *BasicReceiptFilter) matches(receipt *pbnear.Receipt) bool {
+	if p.matchesAccount(receipt.ReceiverId) || matchesAnyAccountClassPredicate(receipt, p.AccountClasses) || matchesAnyDelegateSender(receipt, p.DelegateSenders) {
+		return true
+	}
+
+	if p.UnwrapDelegates {
+		for _, delegateAction := range receiptDelegateActions(receipt) {
+			if p.matchesAccount(delegateAction.ReceiverId) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (p *BasicReceiptFilter) matchesAccount(accountID string) bool {
+	return p.Accounts[accountID] ||
+		matchesPrefixSuffix(accountID, p.PrefixSuffixPairs) ||
+		matchesAnyAccountMatcher(accountID, p.AccountMatchers)
+}
+
+func (p *BasicReceiptFilter) Transform(readOnlyBlk *pbbstream.Block, in transform.Input) (transform.Output, error) {
+	nearBlock, err := blockFromInput(readOnlyBlk, in)
+	if err != nil {
+		return nil, err
+	}
+
 	var outShards []*pbnear.IndexerShard
 	for _, shard := range nearBlock.Shards {
 		var outcomes []*pbnear.IndexerExecutionOutcomeWithReceipt
 		for _, outcome := range shard.ReceiptExecutionOutcomes {
 			if outcome.Receipt.GetAction() != nil {
-				if p.Accounts[outcome.Receipt.ReceiverId] || matchesPrefixSuffix(outcome.Receipt.ReceiverId, p.PrefixSuffixPairs) {
+				if p.matches(outcome.Receipt) {
 					outcomes = append(outcomes, outcome)
 				}
 			}
@@ -108,14 +168,52 @@ func (p *BasicReceiptFilter) GetIndexProvider() bstream.BlockIndexProvider {
 		return nil
 	}
 
-	if len(p.Accounts) == 0 && len(p.PrefixSuffixPairs) == 0 {
+	if len(p.Accounts) == 0 && len(p.PrefixSuffixPairs) == 0 && len(p.AccountMatchers) == 0 && len(p.AccountClasses) == 0 && len(p.DelegateSenders) == 0 {
 		return nil
 	}
 
-	return NewNearBlockIndexProvider(
-		p.indexStore,
-		p.possibleIndexSizes,
-		p.Accounts,
-		p.PrefixSuffixPairs,
-	)
+	for _, matcher := range p.AccountMatchers {
+		if !matcher.IsIndexable() {
+			// A single matcher that cannot use the index means every block must be inspected
+			return nil
+		}
+	}
+
+	for _, predicate := range p.AccountClasses {
+		if !predicate.IsIndexable() {
+			return nil
+		}
+	}
+
+	var providers unionBlockIndexProvider
+	hasAccounts := len(p.Accounts) != 0 || len(p.PrefixSuffixPairs) != 0 || len(p.AccountMatchers) != 0
+	if hasAccounts {
+		providers = append(providers, NewNearBlockIndexProvider(
+			p.indexStore,
+			p.possibleIndexSizes,
+			p.Accounts,
+			p.PrefixSuffixPairs,
+			p.AccountMatchers,
+		))
+	}
+
+	if (hasAccounts && p.UnwrapDelegates) || len(p.DelegateSenders) != 0 {
+		var receivers map[string]bool
+		var receiverPairs []*pbtransform.PrefixSuffixPair
+		var receiverMatchers []*AccountMatcher
+		if p.UnwrapDelegates {
+			receivers, receiverPairs, receiverMatchers = p.Accounts, p.PrefixSuffixPairs, p.AccountMatchers
+		}
+
+		providers = append(providers, NewNearDelegateIndexProvider(p.indexStore, p.possibleIndexSizes, receivers, receiverPairs, receiverMatchers, p.DelegateSenders))
+	}
+
+	if len(p.AccountClasses) != 0 {
+		providers = append(providers, NewNearAccountClassIndexProvider(p.indexStore, p.possibleIndexSizes, p.AccountClasses))
+	}
+
+	if len(providers) == 1 {
+		return providers[0]
+	}
+	return providers
 }
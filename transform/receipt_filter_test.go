@@ -0,0 +1,121 @@
+package transform
+
+import (
+	"testing"
+
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/bstream/transform"
+	pbtransform "github.com/streamingfast/firehose-near/pb/sf/near/transform/v1"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func receiptFilterTransform(t *testing.T, filter *pbtransform.BasicReceiptFilter) *anypb.Any {
+	a, err := anypb.New(filter)
+	require.NoError(t, err)
+	return a
+}
+
+func receiptOutcome(receiverID string) *pbnear.IndexerExecutionOutcomeWithReceipt {
+	return &pbnear.IndexerExecutionOutcomeWithReceipt{
+		ExecutionOutcome: &pbnear.ExecutionOutcomeWithId{Outcome: &pbnear.ExecutionOutcome{ExecutorId: receiverID}},
+		Receipt: &pbnear.Receipt{
+			ReceiverId: receiverID,
+			Receipt:    &pbnear.Receipt_Action{Action: &pbnear.ReceiptAction{}},
+		},
+	}
+}
+
+func TestBasicReceiptFilter_Transform(t *testing.T) {
+	tests := []struct {
+		name      string
+		filter    *pbtransform.BasicReceiptFilter
+		expectErr bool
+		expected  []*pbnear.IndexerShard
+	}{
+		{
+			name:      "empty filter",
+			filter:    &pbtransform.BasicReceiptFilter{},
+			expectErr: true,
+		},
+		{
+			name:      "invalid account",
+			filter:    &pbtransform.BasicReceiptFilter{Accounts: []string{"Foo.near"}},
+			expectErr: true,
+		},
+		{
+			name:      "invalid delegate sender",
+			filter:    &pbtransform.BasicReceiptFilter{DelegateSenders: []string{"Alice.near"}},
+			expectErr: true,
+		},
+		{
+			name:   "accounts",
+			filter: &pbtransform.BasicReceiptFilter{Accounts: []string{"foo.near"}},
+			expected: []*pbnear.IndexerShard{
+				{ShardId: 0, ReceiptExecutionOutcomes: []*pbnear.IndexerExecutionOutcomeWithReceipt{receiptOutcome("foo.near")}},
+			},
+		},
+		{
+			name:   "raw suffix",
+			filter: &pbtransform.BasicReceiptFilter{PrefixAndSuffixPairs: []*pbtransform.PrefixSuffixPair{{Suffix: "near"}}},
+			expected: []*pbnear.IndexerShard{
+				{ShardId: 0, ReceiptExecutionOutcomes: []*pbnear.IndexerExecutionOutcomeWithReceipt{receiptOutcome("foo.near"), receiptOutcome("foonear")}},
+				{ShardId: 1, ReceiptExecutionOutcomes: []*pbnear.IndexerExecutionOutcomeWithReceipt{receiptOutcome("app.foo.near")}},
+			},
+		},
+		{
+			name:   "subaccount of",
+			filter: &pbtransform.BasicReceiptFilter{AccountMatchers: []*pbtransform.AccountMatcher{subaccountOfMatcher("foo.near")}},
+			expected: []*pbnear.IndexerShard{
+				{ShardId: 0, ReceiptExecutionOutcomes: []*pbnear.IndexerExecutionOutcomeWithReceipt{receiptOutcome("foo.near")}},
+				{ShardId: 1, ReceiptExecutionOutcomes: []*pbnear.IndexerExecutionOutcomeWithReceipt{receiptOutcome("app.foo.near")}},
+			},
+		},
+		{
+			name:   "direct child of",
+			filter: &pbtransform.BasicReceiptFilter{AccountMatchers: []*pbtransform.AccountMatcher{directChildOfMatcher("near")}},
+			expected: []*pbnear.IndexerShard{
+				{ShardId: 0, ReceiptExecutionOutcomes: []*pbnear.IndexerExecutionOutcomeWithReceipt{receiptOutcome("foo.near")}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			factory, err := BasicReceiptFilterFactory(nil, nil)
+			require.NoError(t, err)
+
+			transformReg := transform.NewRegistry()
+			transformReg.Register(factory)
+
+			preprocFunc, _, _, err := transformReg.BuildFromTransforms([]*anypb.Any{receiptFilterTransform(t, test.filter)})
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			block := &pbnear.Block{
+				Header: &pbnear.BlockHeader{Height: 160},
+				Shards: []*pbnear.IndexerShard{
+					{ShardId: 0, ReceiptExecutionOutcomes: []*pbnear.IndexerExecutionOutcomeWithReceipt{receiptOutcome("foo.near"), receiptOutcome("foonear")}},
+					{ShardId: 1, ReceiptExecutionOutcomes: []*pbnear.IndexerExecutionOutcomeWithReceipt{receiptOutcome("app.foo.near")}},
+				},
+			}
+			payload, err := proto.Marshal(block)
+			require.NoError(t, err)
+
+			output, err := preprocFunc(&pbbstream.Block{
+				Payload: &anypb.Any{TypeUrl: "sf.near.type.v1.Block", Value: payload},
+			})
+			require.NoError(t, err)
+
+			assertProtoEqual(t, &pbnear.Block{
+				Header: &pbnear.BlockHeader{Height: 160},
+				Shards: test.expected,
+			}, output.(*pbnear.Block))
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package transform
+
+import (
+	"fmt"
+	"sort"
+
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/dstore"
+	pbtransform "github.com/streamingfast/firehose-near/pb/sf/near/transform/v1"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+var ShardFilterMessageName = proto.MessageName(&pbtransform.ShardFilter{})
+
+func NewShardFilterTransformFactory(_ dstore.Store, _ []uint64) (*transform.Factory, error) {
+	return &transform.Factory{
+		Obj: &pbtransform.ShardFilter{},
+		NewFunc: func(message *anypb.Any) (transform.Transform, error) {
+			mname := message.MessageName()
+			if mname != ShardFilterMessageName {
+				return nil, fmt.Errorf("expected type url %q, received %q ", ShardFilterMessageName, message.TypeUrl)
+			}
+
+			filter := &pbtransform.ShardFilter{}
+			err := proto.Unmarshal(message.Value, filter)
+			if err != nil {
+				return nil, fmt.Errorf("unexpected unmarshall error: %w", err)
+			}
+
+			if len(filter.ShardIds) == 0 {
+				return nil, fmt.Errorf("a shard filter requires at least one shard id")
+			}
+
+			shardIDs := make(map[uint64]bool)
+			for _, shardID := range filter.ShardIds {
+				shardIDs[shardID] = true
+			}
+
+			return &ShardFilter{
+				ShardIDs:           shardIDs,
+				FilterStateChanges: filter.FilterStateChanges,
+			}, nil
+		},
+	}, nil
+}
+
+type ShardFilter struct {
+	ShardIDs           map[uint64]bool
+	FilterStateChanges bool
+}
+
+func (p *ShardFilter) String() string {
+	shardIDs := make([]uint64, 0, len(p.ShardIDs))
+	for shardID := range p.ShardIDs {
+		shardIDs = append(shardIDs, shardID)
+	}
+	sort.Slice(shardIDs, func(i, j int) bool { return shardIDs[i] < shardIDs[j] })
+
+	return fmt.Sprintf("shards: %v, filter state changes: %t", shardIDs, p.FilterStateChanges)
+}
+
+func (p *ShardFilter) Transform(readOnlyBlk *pbbstream.Block, in transform.Input) (transform.Output, error) {
+	block, err := blockFromInput(readOnlyBlk, in)
+	if err != nil {
+		return nil, err
+	}
+
+	zlog.Debug("running shard filter transformer",
+		zap.String("hash", readOnlyBlk.GetFirehoseBlockID()),
+		zap.Uint64("num", readOnlyBlk.GetFirehoseBlockNumber()),
+	)
+
+	var shards []*pbnear.IndexerShard
+	for _, shard := range block.Shards {
+		if p.ShardIDs[shard.ShardId] {
+			shards = append(shards, shard)
+		}
+	}
+
+	// The chunk mask is positional, each entry is for the chunk header at the same index
+	filterChunkMask := block.Header != nil && len(block.Header.ChunkMask) == len(block.ChunkHeaders)
+
+	var chunkHeaders []*pbnear.ChunkHeader
+	var chunkMask []bool
+	var chunksIncluded uint64
+	for i, chunkHeader := range block.ChunkHeaders {
+		if !p.ShardIDs[chunkHeader.ShardId] {
+			continue
+		}
+
+		chunkHeaders = append(chunkHeaders, chunkHeader)
+		if filterChunkMask {
+			chunkMask = append(chunkMask, block.Header.ChunkMask[i])
+			if block.Header.ChunkMask[i] {
+				chunksIncluded++
+			}
+		}
+	}
+
+	if filterChunkMask {
+		block.Header.ChunkMask = chunkMask
+		block.Header.ChunksIncluded = chunksIncluded
+	}
+
+	if p.FilterStateChanges {
+		block.StateChanges = filterStateChangesByCause(block.StateChanges, shardCauseHashes(shards))
+	}
+
+	block.Shards = shards
+	block.ChunkHeaders = chunkHeaders
+
+	return block, nil
+}
+
+// shardCauseHashes returns the hashes of the transactions and receipts of the shards, as found in state change causes
+func shardCauseHashes(shards []*pbnear.IndexerShard) map[string]bool {
+	out := make(map[string]bool)
+	add := func(hash *pbnear.CryptoHash) {
+		if hash != nil {
+			out[string(hash.Bytes)] = true
+		}
+	}
+
+	for _, shard := range shards {
+		if chunk := shard.Chunk; chunk != nil {
+			for _, trx := range chunk.Transactions {
+				add(trx.Transaction.GetHash())
+			}
+			for _, receipt := range chunk.Receipts {
+				add(receipt.ReceiptId)
+			}
+		}
+
+		for _, outcome := range shard.ReceiptExecutionOutcomes {
+			add(outcome.Receipt.GetReceiptId())
+			add(outcome.ExecutionOutcome.GetId())
+		}
+	}
+
+	return out
+}
+
+func filterStateChangesByCause(changes []*pbnear.StateChangeWithCause, hashes map[string]bool) (out []*pbnear.StateChangeWithCause) {
+	for _, change := range changes {
+		hash := change.Cause.CausingHash()
+		if hash != nil && hashes[string(hash.Bytes)] {
+			out = append(out, change)
+		}
+	}
+	return
+}
@@ -0,0 +1,156 @@
+package transform
+
+import (
+	"testing"
+
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/bstream/transform"
+	pbtransform "github.com/streamingfast/firehose-near/pb/sf/near/transform/v1"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func hash(b byte) *pbnear.CryptoHash {
+	return &pbnear.CryptoHash{Bytes: []byte{b}}
+}
+
+func shardFilterTestBlock() *pbnear.Block {
+	receiptOutcome := func(id byte, receiverID string) *pbnear.IndexerExecutionOutcomeWithReceipt {
+		out := receiptOutcome(receiverID)
+		out.Receipt.ReceiptId = hash(id)
+		return out
+	}
+
+	processing := func(receiptID byte, accountID string) *pbnear.StateChangeWithCause {
+		return &pbnear.StateChangeWithCause{
+			Cause: &pbnear.StateChangeCause{Cause: &pbnear.StateChangeCause_ReceiptProcessing_{ReceiptProcessing: &pbnear.StateChangeCause_ReceiptProcessing{TxHash: hash(receiptID)}}},
+			Value: &pbnear.StateChangeValue{Value: &pbnear.StateChangeValue_AccountDeletion_{AccountDeletion: &pbnear.StateChangeValue_AccountDeletion{AccountId: accountID}}},
+		}
+	}
+
+	return &pbnear.Block{
+		Header: &pbnear.BlockHeader{Height: 160, ChunkMask: []bool{true, false, true}, ChunksIncluded: 2},
+		ChunkHeaders: []*pbnear.ChunkHeader{
+			{ShardId: 0},
+			{ShardId: 1},
+			{ShardId: 2},
+		},
+		Shards: []*pbnear.IndexerShard{
+			{ShardId: 0, ReceiptExecutionOutcomes: []*pbnear.IndexerExecutionOutcomeWithReceipt{receiptOutcome(0x10, "foo.near")}},
+			{ShardId: 1},
+			{ShardId: 2, ReceiptExecutionOutcomes: []*pbnear.IndexerExecutionOutcomeWithReceipt{receiptOutcome(0x20, "aurora"), receiptOutcome(0x21, "bar.aurora")}},
+		},
+		StateChanges: []*pbnear.StateChangeWithCause{
+			processing(0x10, "foo.near"),
+			processing(0x20, "aurora"),
+			processing(0x21, "bar.aurora"),
+			{
+				Cause: &pbnear.StateChangeCause{Cause: &pbnear.StateChangeCause_ValidatorAccountsUpdate_{}},
+				Value: &pbnear.StateChangeValue{Value: &pbnear.StateChangeValue_AccountDeletion_{AccountDeletion: &pbnear.StateChangeValue_AccountDeletion{AccountId: "validator.near"}}},
+			},
+		},
+	}
+}
+
+func runTransforms(t *testing.T, block *pbnear.Block, factories []*transform.Factory, messages ...proto.Message) *pbnear.Block {
+	t.Helper()
+
+	transformReg := transform.NewRegistry()
+	for _, factory := range factories {
+		transformReg.Register(factory)
+	}
+
+	var transforms []*anypb.Any
+	for _, message := range messages {
+		a, err := anypb.New(message)
+		require.NoError(t, err)
+		transforms = append(transforms, a)
+	}
+
+	preprocFunc, _, _, err := transformReg.BuildFromTransforms(transforms)
+	require.NoError(t, err)
+
+	payload, err := proto.Marshal(block)
+	require.NoError(t, err)
+
+	output, err := preprocFunc(&pbbstream.Block{
+		Payload: &anypb.Any{TypeUrl: "sf.near.type.v1.Block", Value: payload},
+	})
+	require.NoError(t, err)
+
+	return output.(*pbnear.Block)
+}
+
+func TestShardFilter_Transform(t *testing.T) {
+	shardFilter, err := NewShardFilterTransformFactory(nil, nil)
+	require.NoError(t, err)
+
+	receiptFilter, err := BasicReceiptFilterFactory(nil, nil)
+	require.NoError(t, err)
+
+	factories := []*transform.Factory{shardFilter, receiptFilter}
+
+	t.Run("invalid", func(t *testing.T) {
+		transformReg := transform.NewRegistry()
+		transformReg.Register(shardFilter)
+
+		a, err := anypb.New(&pbtransform.ShardFilter{})
+		require.NoError(t, err)
+
+		_, _, _, err = transformReg.BuildFromTransforms([]*anypb.Any{a})
+		require.Error(t, err)
+	})
+
+	t.Run("shards only", func(t *testing.T) {
+		input := shardFilterTestBlock()
+		expected := shardFilterTestBlock()
+		expected.Header.ChunkMask = []bool{false, true}
+		expected.Header.ChunksIncluded = 1
+		expected.ChunkHeaders = expected.ChunkHeaders[1:]
+		expected.Shards = expected.Shards[1:]
+
+		output := runTransforms(t, input, factories, &pbtransform.ShardFilter{ShardIds: []uint64{1, 2}})
+		assertProtoEqual(t, expected, output)
+	})
+
+	t.Run("with state changes", func(t *testing.T) {
+		input := shardFilterTestBlock()
+		expected := shardFilterTestBlock()
+		expected.Header.ChunkMask = []bool{true}
+		expected.Header.ChunksIncluded = 1
+		expected.ChunkHeaders = expected.ChunkHeaders[2:]
+		expected.Shards = expected.Shards[2:]
+		expected.StateChanges = expected.StateChanges[1:3]
+
+		output := runTransforms(t, input, factories, &pbtransform.ShardFilter{ShardIds: []uint64{2}, FilterStateChanges: true})
+		assertProtoEqual(t, expected, output)
+	})
+
+	receiptFilterMessage := &pbtransform.BasicReceiptFilter{Accounts: []string{"aurora"}}
+	shardFilterMessage := &pbtransform.ShardFilter{ShardIds: []uint64{2}, FilterStateChanges: true}
+
+	expected := shardFilterTestBlock()
+	expected.Header.ChunkMask = []bool{true}
+	expected.Header.ChunksIncluded = 1
+	expected.ChunkHeaders = expected.ChunkHeaders[2:]
+	expected.Shards = expected.Shards[2:]
+	expected.Shards[0].ReceiptExecutionOutcomes = expected.Shards[0].ReceiptExecutionOutcomes[0:1]
+
+	t.Run("receipt filter then shard filter", func(t *testing.T) {
+		expected := proto.Clone(expected).(*pbnear.Block)
+		expected.StateChanges = expected.StateChanges[1:2]
+
+		output := runTransforms(t, shardFilterTestBlock(), factories, receiptFilterMessage, shardFilterMessage)
+		assertProtoEqual(t, expected, output)
+	})
+
+	t.Run("shard filter then receipt filter", func(t *testing.T) {
+		expected := proto.Clone(expected).(*pbnear.Block)
+		expected.StateChanges = expected.StateChanges[1:3]
+
+		output := runTransforms(t, shardFilterTestBlock(), factories, shardFilterMessage, receiptFilterMessage)
+		assertProtoEqual(t, expected, output)
+	})
+}
@@ -0,0 +1,163 @@
+package transform
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/dstore"
+	pbtransform "github.com/streamingfast/firehose-near/pb/sf/near/transform/v1"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+var StripPayloadsMessageName = proto.MessageName(&pbtransform.StripPayloads{})
+
+func NewStripPayloadsTransformFactory(_ dstore.Store, _ []uint64) (*transform.Factory, error) {
+	return &transform.Factory{
+		Obj: &pbtransform.StripPayloads{},
+		NewFunc: func(message *anypb.Any) (transform.Transform, error) {
+			mname := message.MessageName()
+			if mname != StripPayloadsMessageName {
+				return nil, fmt.Errorf("expected type url %q, received %q ", StripPayloadsMessageName, message.TypeUrl)
+			}
+
+			filter := &pbtransform.StripPayloads{}
+			err := proto.Unmarshal(message.Value, filter)
+			if err != nil {
+				return nil, fmt.Errorf("unexpected unmarshall error: %w", err)
+			}
+
+			if !filter.ContractCode && !filter.FunctionCallArgs && !filter.Proofs {
+				return nil, fmt.Errorf("a strip payloads transform requires at least one of contract_code, function_call_args or proofs to be set")
+			}
+
+			return &StripPayloadsFilter{
+				ContractCode:     filter.ContractCode,
+				FunctionCallArgs: filter.FunctionCallArgs,
+				Proofs:           filter.Proofs,
+			}, nil
+		},
+	}, nil
+}
+
+type StripPayloadsFilter struct {
+	ContractCode     bool
+	FunctionCallArgs bool
+	Proofs           bool
+}
+
+func (p *StripPayloadsFilter) String() string {
+	var kinds []string
+	if p.ContractCode {
+		kinds = append(kinds, "contract_code")
+	}
+	if p.FunctionCallArgs {
+		kinds = append(kinds, "function_call_args")
+	}
+	if p.Proofs {
+		kinds = append(kinds, "proofs")
+	}
+
+	return fmt.Sprintf("strip payloads filter: %s", strings.Join(kinds, ", "))
+}
+
+func (p *StripPayloadsFilter) Transform(readOnlyBlk *pbbstream.Block, in transform.Input) (transform.Output, error) {
+	block, err := blockFromInput(readOnlyBlk, in)
+	if err != nil {
+		return nil, err
+	}
+
+	zlog.Debug("running strip payloads transformer",
+		zap.String("hash", readOnlyBlk.GetFirehoseBlockID()),
+		zap.Uint64("num", readOnlyBlk.GetFirehoseBlockNumber()),
+	)
+
+	for _, shard := range block.Shards {
+		if chunk := shard.Chunk; chunk != nil {
+			for _, trx := range chunk.Transactions {
+				if trx.Transaction != nil {
+					p.stripActions(trx.Transaction.Actions)
+				}
+				if trx.Outcome != nil {
+					p.stripOutcome(trx.Outcome.ExecutionOutcome)
+					p.stripReceipt(trx.Outcome.Receipt)
+				}
+			}
+
+			for _, receipt := range chunk.Receipts {
+				p.stripReceipt(receipt)
+			}
+		}
+
+		for _, outcome := range shard.ReceiptExecutionOutcomes {
+			p.stripOutcome(outcome.ExecutionOutcome)
+			p.stripReceipt(outcome.Receipt)
+		}
+	}
+
+	if p.ContractCode {
+		for _, change := range block.StateChanges {
+			if update := change.GetValue().GetContractCodeUpdate(); update != nil && update.Code != nil {
+				update.CodeHash, update.CodeSize = contentHashAndSize(update.Code)
+				update.Code = nil
+			}
+		}
+	}
+
+	return block, nil
+}
+
+func (p *StripPayloadsFilter) stripReceipt(receipt *pbnear.Receipt) {
+	if action := receipt.GetAction(); action != nil {
+		p.stripActions(action.Actions)
+	}
+}
+
+func (p *StripPayloadsFilter) stripActions(actions []*pbnear.Action) {
+	for _, action := range actions {
+		switch a := action.Action.(type) {
+		case *pbnear.Action_DeployContract:
+			if p.ContractCode && a.DeployContract.Code != nil {
+				a.DeployContract.CodeHash, a.DeployContract.CodeSize = contentHashAndSize(a.DeployContract.Code)
+				a.DeployContract.Code = nil
+			}
+		case *pbnear.Action_FunctionCall:
+			if p.FunctionCallArgs && a.FunctionCall.Args != nil {
+				a.FunctionCall.ArgsHash, a.FunctionCall.ArgsSize = contentHashAndSize(a.FunctionCall.Args)
+				a.FunctionCall.Args = nil
+			}
+		case *pbnear.Action_Delegate:
+			if delegateAction := a.Delegate.GetDelegateAction(); delegateAction != nil {
+				p.stripActions(delegateAction.Actions)
+			}
+		}
+	}
+}
+
+func (p *StripPayloadsFilter) stripOutcome(outcome *pbnear.ExecutionOutcomeWithId) {
+	if !p.Proofs || outcome == nil || outcome.Proof == nil {
+		return
+	}
+
+	// Deterministic so that the same proof always leads to the same hash
+	content, err := proto.MarshalOptions{Deterministic: true}.Marshal(outcome.Proof)
+	if err != nil {
+		// Marshalling an in-memory well formed message cannot fail, keep the proof if it happens anyway
+		zlog.Warn("unable to marshal execution outcome proof, keeping it", zap.Error(err))
+		return
+	}
+
+	outcome.ProofHash, outcome.ProofSize = contentHashAndSize(content)
+	outcome.Proof = nil
+}
+
+func contentHashAndSize(content []byte) (*pbnear.CryptoHash, uint64) {
+	hash := sha256.Sum256(content)
+	return &pbnear.CryptoHash{Bytes: hash[:]}, uint64(len(content))
+}
@@ -0,0 +1,163 @@
+package transform
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/bstream/transform"
+	pbtransform "github.com/streamingfast/firehose-near/pb/sf/near/transform/v1"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func TestStripPayloads_Transform(t *testing.T) {
+	code := []byte("\x00asm some wasm code")
+	args := []byte(`{"receiver_id":"bob.near"}`)
+	proof := &pbnear.MerklePath{Path: []*pbnear.MerklePathItem{{Hash: &pbnear.CryptoHash{Bytes: []byte{0xff}}, Direction: pbnear.Direction_right}}}
+
+	proofContent, err := proto.MarshalOptions{Deterministic: true}.Marshal(proof)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		filter    *pbtransform.StripPayloads
+		expectErr bool
+		expected  func() *pbnear.Block
+	}{
+		{
+			name:      "nothing selected",
+			filter:    &pbtransform.StripPayloads{},
+			expectErr: true,
+		},
+		{
+			name:   "contract code",
+			filter: &pbtransform.StripPayloads{ContractCode: true},
+			expected: func() *pbnear.Block {
+				blk := stripPayloadsTestBlock(code, args, proof)
+				deploy := blk.Shards[0].Chunk.Transactions[0].Transaction.Actions[0].GetDeployContract()
+				deploy.Code, deploy.CodeHash, deploy.CodeSize = nil, sha256Hash(code), uint64(len(code))
+				update := blk.StateChanges[0].Value.GetContractCodeUpdate()
+				update.Code, update.CodeHash, update.CodeSize = nil, sha256Hash(code), uint64(len(code))
+				return blk
+			},
+		},
+		{
+			name:   "function call args including delegated ones",
+			filter: &pbtransform.StripPayloads{FunctionCallArgs: true},
+			expected: func() *pbnear.Block {
+				blk := stripPayloadsTestBlock(code, args, proof)
+				call := blk.Shards[0].ReceiptExecutionOutcomes[0].Receipt.GetAction().Actions[0].GetFunctionCall()
+				call.Args, call.ArgsHash, call.ArgsSize = nil, sha256Hash(args), uint64(len(args))
+				delegated := blk.Shards[0].ReceiptExecutionOutcomes[0].Receipt.GetAction().Actions[1].GetDelegate().DelegateAction.Actions[0].GetFunctionCall()
+				delegated.Args, delegated.ArgsHash, delegated.ArgsSize = nil, sha256Hash(args), uint64(len(args))
+				return blk
+			},
+		},
+		{
+			name:   "proofs",
+			filter: &pbtransform.StripPayloads{Proofs: true},
+			expected: func() *pbnear.Block {
+				blk := stripPayloadsTestBlock(code, args, proof)
+				outcome := blk.Shards[0].ReceiptExecutionOutcomes[0].ExecutionOutcome
+				outcome.Proof, outcome.ProofHash, outcome.ProofSize = nil, sha256Hash(proofContent), uint64(len(proofContent))
+				return blk
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			factory, err := NewStripPayloadsTransformFactory(nil, nil)
+			require.NoError(t, err)
+
+			transformReg := transform.NewRegistry()
+			transformReg.Register(factory)
+
+			message, err := anypb.New(test.filter)
+			require.NoError(t, err)
+
+			preprocFunc, _, _, err := transformReg.BuildFromTransforms([]*anypb.Any{message})
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			payload, err := proto.Marshal(stripPayloadsTestBlock(code, args, proof))
+			require.NoError(t, err)
+
+			output, err := preprocFunc(&pbbstream.Block{
+				Payload: &anypb.Any{TypeUrl: "sf.near.type.v1.Block", Value: payload},
+			})
+			require.NoError(t, err)
+
+			assertProtoEqual(t, test.expected(), output.(*pbnear.Block))
+		})
+	}
+}
+
+func stripPayloadsTestBlock(code, args []byte, proof *pbnear.MerklePath) *pbnear.Block {
+	return &pbnear.Block{
+		Header: &pbnear.BlockHeader{Height: 160},
+		Shards: []*pbnear.IndexerShard{
+			{
+				Chunk: &pbnear.IndexerChunk{
+					Transactions: []*pbnear.IndexerTransactionWithOutcome{
+						{
+							Transaction: &pbnear.SignedTransaction{
+								SignerId:   "alice.near",
+								ReceiverId: "alice.near",
+								Actions: []*pbnear.Action{
+									{Action: &pbnear.Action_DeployContract{DeployContract: &pbnear.DeployContractAction{Code: code}}},
+								},
+							},
+						},
+					},
+				},
+				ReceiptExecutionOutcomes: []*pbnear.IndexerExecutionOutcomeWithReceipt{
+					{
+						ExecutionOutcome: &pbnear.ExecutionOutcomeWithId{
+							Proof:   proof,
+							Outcome: &pbnear.ExecutionOutcome{Logs: []string{"a log"}},
+						},
+						Receipt: &pbnear.Receipt{
+							ReceiverId: "contract.near",
+							Receipt: &pbnear.Receipt_Action{Action: &pbnear.ReceiptAction{
+								Actions: []*pbnear.Action{
+									{Action: &pbnear.Action_FunctionCall{FunctionCall: &pbnear.FunctionCallAction{MethodName: "ft_transfer", Args: args}}},
+									{Action: &pbnear.Action_Delegate{Delegate: &pbnear.SignedDelegateAction{
+										DelegateAction: &pbnear.DelegateAction{
+											SenderId:   "alice.near",
+											ReceiverId: "contract.near",
+											Actions: []*pbnear.Action{
+												{Action: &pbnear.Action_FunctionCall{FunctionCall: &pbnear.FunctionCallAction{MethodName: "ft_transfer", Args: args}}},
+											},
+										},
+									}}},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+		StateChanges: []*pbnear.StateChangeWithCause{
+			{
+				Value: &pbnear.StateChangeValue{
+					Value: &pbnear.StateChangeValue_ContractCodeUpdate_{ContractCodeUpdate: &pbnear.StateChangeValue_ContractCodeUpdate{
+						AccountId: "alice.near",
+						Code:      code,
+					}},
+				},
+			},
+		},
+	}
+}
+
+func sha256Hash(content []byte) *pbnear.CryptoHash {
+	hash := sha256.Sum256(content)
+	return &pbnear.CryptoHash{Bytes: hash[:]}
+}
@@ -0,0 +1,53 @@
+package transform
+
+import (
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/dstore"
+	firecore "github.com/streamingfast/firehose-core"
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+)
+
+const TransactionIndexShortName = "trxid"
+
+var _ firecore.BlockIndexer[*pbnear.Block] = (*NearTransactionIndexer)(nil)
+
+// NearTransactionIndexer records, for each block, the base58 hashes of the transactions it
+// includes and the base58 IDs of the receipts it executes so that they can be looked up by hash.
+type NearTransactionIndexer struct {
+	BlockIndexer blockIndexer
+}
+
+func NewNearTransactionIndexer(indexStore dstore.Store, indexSize uint64) (firecore.BlockIndexer[*pbnear.Block], error) {
+	bi := transform.NewBlockIndexer(indexStore, indexSize, TransactionIndexShortName)
+
+	return &NearTransactionIndexer{
+		BlockIndexer: bi,
+	}, nil
+}
+
+func (i *NearTransactionIndexer) ProcessBlock(blk *pbnear.Block) error {
+	keyMap := make(map[string]bool)
+	for _, shard := range blk.Shards {
+		if shard.Chunk != nil {
+			for _, trx := range shard.Chunk.Transactions {
+				if hash := trx.Transaction.GetHash(); len(hash.GetBytes()) > 0 {
+					keyMap[hash.AsBase58String()] = true
+				}
+			}
+		}
+
+		for _, outcome := range shard.ReceiptExecutionOutcomes {
+			if receiptID := outcome.Receipt.GetReceiptId(); len(receiptID.GetBytes()) > 0 {
+				keyMap[receiptID.AsBase58String()] = true
+			}
+		}
+	}
+
+	var keys []string
+	for key := range keyMap {
+		keys = append(keys, key)
+	}
+
+	i.BlockIndexer.Add(keys, blk.Num())
+	return nil
+}
@@ -0,0 +1,32 @@
+package transform
+
+import (
+	"testing"
+
+	pbnear "github.com/streamingfast/firehose-near/pb/sf/near/type/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNearTransactionIndexer_ProcessBlock(t *testing.T) {
+	indexer := &testBlockIndexer{keys: map[uint64][]string{}}
+	blockIndexer := &NearTransactionIndexer{BlockIndexer: indexer}
+
+	outcome := receiptOutcome("foo.near")
+	outcome.Receipt.ReceiptId = hash(0x02)
+
+	err := blockIndexer.ProcessBlock(&pbnear.Block{
+		Header: &pbnear.BlockHeader{Height: 10},
+		Shards: []*pbnear.IndexerShard{
+			{
+				Chunk: &pbnear.IndexerChunk{Transactions: []*pbnear.IndexerTransactionWithOutcome{
+					{Transaction: &pbnear.SignedTransaction{Hash: hash(0x01)}},
+				}},
+				ReceiptExecutionOutcomes: []*pbnear.IndexerExecutionOutcomeWithReceipt{outcome},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{hash(0x01).AsBase58String(), hash(0x02).AsBase58String()}, indexer.keys[10])
+}